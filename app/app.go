@@ -193,6 +193,8 @@ func NewIxoApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 	// init params keeper and subspaces (for custom ixo modules)
 	paymentsSubspace := app.paramsKeeper.Subspace(payments.DefaultParamspace)
 	projectSubspace := app.paramsKeeper.Subspace(project.DefaultParamspace)
+	didSubspace := app.paramsKeeper.Subspace(did.DefaultParamspace)
+	oraclesSubspace := app.paramsKeeper.Subspace(oracles.DefaultParamspace)
 
 	// add keepers (for standard Cosmos modules)
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
@@ -227,14 +229,14 @@ func NewIxoApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 	)
 
 	// add keepers (for custom ixo modules)
-	app.didKeeper = did.NewKeeper(app.cdc, keys[did.StoreKey])
+	app.didKeeper = did.NewKeeper(app.cdc, keys[did.StoreKey], didSubspace)
+	app.oraclesKeeper = oracles.NewKeeper(app.cdc, keys[oracles.StoreKey], oraclesSubspace)
 	app.paymentsKeeper = payments.NewKeeper(app.cdc, keys[payments.StoreKey], paymentsSubspace,
-		app.bankKeeper, app.didKeeper, paymentsReservedIdPrefixes)
+		app.bankKeeper, app.didKeeper, app.oraclesKeeper, paymentsReservedIdPrefixes)
 	app.projectKeeper = project.NewKeeper(app.cdc, keys[project.StoreKey], projectSubspace,
 		app.accountKeeper, app.didKeeper, app.paymentsKeeper)
 	app.bondsKeeper = bonds.NewKeeper(app.bankKeeper, app.supplyKeeper, app.accountKeeper,
 		app.stakingKeeper, app.didKeeper, keys[bonds.StoreKey], app.cdc)
-	app.oraclesKeeper = oracles.NewKeeper(app.cdc, keys[oracles.StoreKey])
 	app.treasuryKeeper = treasury.NewKeeper(app.cdc, keys[treasury.StoreKey], app.bankKeeper,
 		app.oraclesKeeper, app.supplyKeeper, app.didKeeper)
 
@@ -255,7 +257,7 @@ func NewIxoApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 		staking.NewAppModule(app.stakingKeeper, app.distrKeeper, app.accountKeeper, app.supplyKeeper),
 
 		// Custom ixo AppModules
-		did.NewAppModule(app.didKeeper),
+		did.NewAppModule(app.didKeeper, app.accountKeeper, app.bankKeeper),
 		payments.NewAppModule(app.paymentsKeeper, app.bankKeeper),
 		project.NewAppModule(app.projectKeeper, app.paymentsKeeper, app.bankKeeper),
 		bonds.NewAppModule(app.bondsKeeper, app.accountKeeper),
@@ -363,7 +365,7 @@ func NewIxoAnteHandler(app *ixoApp) sdk.AnteHandler {
 
 	projectCreationAnteHandler := project.NewProjectCreationAnteHandler(
 		app.accountKeeper, app.supplyKeeper, app.bankKeeper,
-		app.didKeeper, projectPubKeyGetter)
+		app.didKeeper, app.projectKeeper, projectPubKeyGetter)
 
 	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (_ sdk.Context, _ sdk.Result, abort bool) {
 		// Route message based on ixo module router key
@@ -385,6 +387,8 @@ func NewIxoAnteHandler(app *ixoApp) sdk.AnteHandler {
 			return defaultIxoAnteHandler(ctx, tx, simulate)
 		case payments.RouterKey:
 			return defaultIxoAnteHandler(ctx, tx, simulate)
+		case oracles.RouterKey:
+			return defaultIxoAnteHandler(ctx, tx, simulate)
 		default:
 			return cosmosAnteHandler(ctx, tx, simulate)
 		}
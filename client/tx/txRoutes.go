@@ -12,6 +12,7 @@ import (
 	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
 	"github.com/ixofoundation/ixo-blockchain/x/ixo"
 	"github.com/ixofoundation/ixo-blockchain/x/project"
+	projectclient "github.com/ixofoundation/ixo-blockchain/x/project/client"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -23,8 +24,9 @@ func RegisterTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 }
 
 type SignDataReq struct {
-	Msg    string `json:"msg" yaml:"msg"`
-	PubKey string `json:"pub_key" yaml:"pub_key"`
+	Msg        string `json:"msg" yaml:"msg"`
+	PubKey     string `json:"pub_key" yaml:"pub_key"`
+	PubKeyType string `json:"pub_key_type" yaml:"pub_key_type"`
 }
 
 type SignDataResponse struct {
@@ -73,11 +75,16 @@ func SignDataRequest(cliCtx context.CLIContext) http.HandlerFunc {
 		var stdSignMsg auth.StdSignMsg
 		switch ixoMsg.Type() {
 		case project.TypeMsgCreateProject:
+			params, err := projectclient.QueryParams(cliCtx)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
 			stdSignMsg = ixoMsg.(project.MsgCreateProject).ToStdSignMsg(
-				project.MsgCreateProjectFee)
+				params.ProjectCreationFee)
 		default:
 			// Deduce and set signer address
-			signerAddress := exported.VerifyKeyToAddr(req.PubKey)
+			signerAddress := exported.TypedVerifyKeyToAddr(req.PubKeyType, req.PubKey)
 			cliCtx = cliCtx.WithFromAddress(signerAddress)
 
 			txBldr, err := utils.PrepareTxBuilder(auth.NewTxBuilderFromCLI(), cliCtx)
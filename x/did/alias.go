@@ -12,7 +12,12 @@ const (
 	RouterKey    = types.RouterKey
 	StoreKey     = types.StoreKey
 
+	DefaultParamspace = types.DefaultParamspace
+
 	DefaultCodespace = types.DefaultCodespace
+
+	PubKeyTypeEd25519   = exported.PubKeyTypeEd25519
+	PubKeyTypeSecp256k1 = exported.PubKeyTypeSecp256k1
 )
 
 type (
@@ -23,8 +28,35 @@ type (
 	DidDoc = exported.DidDoc
 	IxoDid = exported.IxoDid
 
+	BaseDidDoc = types.BaseDidDoc
+
 	MsgAddDid        = types.MsgAddDid
 	MsgAddCredential = types.MsgAddCredential
+	MsgRotateDidKey  = types.MsgRotateDidKey
+	MsgDeactivateDid = types.MsgDeactivateDid
+	MsgUpdateDidDoc  = types.MsgUpdateDidDoc
+
+	MsgRevokeCredential = types.MsgRevokeCredential
+	MsgSetDidMultisig   = types.MsgSetDidMultisig
+
+	PubKeyRecord       = types.PubKeyRecord
+	DidDocumentData    = types.DidDocumentData
+	VerificationMethod = types.VerificationMethod
+	Service            = types.Service
+	DidDocument        = types.DidDocument
+	DidResolution      = types.DidResolution
+
+	QueryDidsResponse    = types.QueryDidsResponse
+	QueryDidDocsResponse = types.QueryDidDocsResponse
+
+	DidCredential        = exported.DidCredential
+	CredentialRevocation = types.CredentialRevocation
+	CredentialStatus     = types.CredentialStatus
+	CredentialFilter     = types.CredentialFilter
+
+	Params         = types.Params
+	TrustedIssuer  = types.TrustedIssuer
+	TrustedIssuers = types.TrustedIssuers
 )
 
 var (
@@ -37,12 +69,36 @@ var (
 	DefaultGenesisState = types.DefaultGenesisState
 	ValidateGenesis     = types.ValidateGenesis
 
+	NewBaseDidDoc       = types.NewBaseDidDoc
+	NewMsgRotateDidKey  = types.NewMsgRotateDidKey
+	NewMsgDeactivateDid = types.NewMsgDeactivateDid
+	NewPubKeyRecord     = types.NewPubKeyRecord
+	NewMsgUpdateDidDoc  = types.NewMsgUpdateDidDoc
+	NewDidResolution    = types.NewDidResolution
+
+	NewDidCredential           = types.NewDidCredential
+	NewMsgAddGenericCredential = types.NewMsgAddGenericCredential
+	NewMsgRevokeCredential     = types.NewMsgRevokeCredential
+	NewCredentialRevocation    = types.NewCredentialRevocation
+	ValidateCredential         = types.ValidateCredential
+
+	NewParams        = types.NewParams
+	DefaultParams    = types.DefaultParams
+	ValidateParams   = types.ValidateParams
+	NewTrustedIssuer = types.NewTrustedIssuer
+
+	NewMsgSetDidMultisig = types.NewMsgSetDidMultisig
+	ValidateMultisig     = types.ValidateMultisig
+
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
 
-	ErrorInvalidDid = types.ErrorInvalidDid
+	ErrorInvalidDid     = types.ErrorInvalidDid
+	ErrorDidDeactivated = types.ErrorDidDeactivated
 
-	IsValidDid      = types.IsValidDid
-	IsValidPubKey   = types.IsValidPubKey
-	UnmarshalIxoDid = types.UnmarshalIxoDid
+	IsValidDid         = types.IsValidDid
+	IsValidPubKey      = types.IsValidPubKey
+	IsValidPubKeyType  = types.IsValidPubKeyType
+	IsValidTypedPubKey = types.IsValidTypedPubKey
+	UnmarshalIxoDid    = types.UnmarshalIxoDid
 )
@@ -1,29 +1,47 @@
 package did
 
 import (
-	"github.com/btcsuite/btcutil/base58"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
 	"github.com/ixofoundation/ixo-blockchain/x/ixo"
 	"github.com/tendermint/tendermint/crypto"
-	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
 func GetPubKeyGetter(keeper Keeper) ixo.PubKeyGetter {
 	return func(ctx sdk.Context, msg ixo.IxoMsg) (pubKey crypto.PubKey, res sdk.Result) {
 
 		// Get signer PubKey
-		var pubKeyEd25519 ed25519.PubKeyEd25519
 		switch msg := msg.(type) {
 		case MsgAddDid:
-			copy(pubKeyEd25519[:], base58.Decode(msg.PubKey))
+			return exported.TypedVerifyKeyToPubKey(msg.PubKeyType, msg.PubKey), sdk.Result{}
 		default:
 			// For the remaining messages, the did is the signer
 			didDoc, _ := keeper.GetDidDoc(ctx, msg.GetSignerDid())
 			if didDoc == nil {
 				return pubKey, sdk.ErrUnauthorized("Issuer did not found").Result()
+			} else if didDoc.IsDeactivated() {
+				return pubKey, ErrorDidDeactivated(DefaultCodespace, didDoc.GetDid()).Result()
 			}
-			copy(pubKeyEd25519[:], base58.Decode(didDoc.GetPubKey()))
+
+			// Key rotation and deactivation can be signed by the recovery key
+			if signedByRecoveryKey(msg) {
+				if didDoc.GetRecoveryPubKey() == "" {
+					return pubKey, sdk.ErrUnauthorized("did has no recovery pubKey").Result()
+				}
+				return exported.VerifyKeyToPubKey(didDoc.GetRecoveryPubKey()), sdk.Result{}
+			}
+			return didDoc.GetSignerPubKey(), sdk.Result{}
 		}
-		return pubKeyEd25519, sdk.Result{}
+	}
+}
+
+func signedByRecoveryKey(msg ixo.IxoMsg) bool {
+	switch msg := msg.(type) {
+	case MsgRotateDidKey:
+		return msg.SignedByRecoveryKey
+	case MsgDeactivateDid:
+		return msg.SignedByRecoveryKey
+	default:
+		return false
 	}
 }
@@ -12,20 +12,25 @@ import (
 )
 
 func GetCmdAddressFromBase58Pubkey() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "get-address-from-pubkey [base-58-encoded-pubkey]",
-		Short: "Get the address for a base-58 encoded ed25519 public key",
+		Short: "Get the address for a base-58 encoded ed25519 or secp256k1 public key",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if !types.IsValidPubKey(args[0]) {
+			pubKeyType, _ := cmd.Flags().GetString(FlagPubKeyType)
+			if !types.IsValidTypedPubKey(pubKeyType, args[0]) {
 				return errors.New("input is not a valid base-58 encoded pubKey")
 			}
 
-			accAddress := exported.VerifyKeyToAddr(args[0])
+			accAddress := exported.TypedVerifyKeyToAddr(pubKeyType, args[0])
 			fmt.Println(accAddress.String())
 			return nil
 		},
 	}
+
+	cmd.Flags().String(FlagPubKeyType, exported.PubKeyTypeEd25519, "Type of the pubKey (ed25519 or secp256k1)")
+
+	return cmd
 }
 
 func GetCmdAddressFromDid(cdc *codec.Codec) *cobra.Command {
@@ -100,26 +105,34 @@ func GetCmdDidDoc(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+const (
+	FlagStart = "start"
+	FlagLimit = "limit"
+)
+
 func GetCmdAllDids(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "get-all-dids",
-		Short: "Query all DIDs",
+		Short: "Query a page of DIDs, starting from the --start DID",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
-				keeper.QueryAllDids, "ALL"), nil)
+			start, _ := cmd.Flags().GetString(FlagStart)
+			limit, _ := cmd.Flags().GetUint64(FlagLimit)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%d", types.QuerierRoute,
+				keeper.QueryAllDids, start, limit), nil)
 			if err != nil {
 				return err
 			}
 
-			var didDids []exported.Did
-			err = cdc.UnmarshalJSON(res, &didDids)
+			var page types.QueryDidsResponse
+			err = cdc.UnmarshalJSON(res, &page)
 			if err != nil {
 				return err
 			}
 
-			output, err := cdc.MarshalJSONIndent(didDids, "", "  ")
+			output, err := cdc.MarshalJSONIndent(page, "", "  ")
 			if err != nil {
 				return err
 			}
@@ -128,28 +141,263 @@ func GetCmdAllDids(cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().String(FlagStart, "", "DID to start the page from (nextStartDid of the previous page)")
+	cmd.Flags().Uint64(FlagLimit, keeper.DefaultQueryPageLimit, "Maximum number of DIDs to return")
+
+	return cmd
 }
 
 func GetCmdAllDidDocs(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "get-all-did-docs",
-		Short: "Query all DID documents",
+		Short: "Query a page of DID documents, starting from the --start DID",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			start, _ := cmd.Flags().GetString(FlagStart)
+			limit, _ := cmd.Flags().GetUint64(FlagLimit)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%d", types.QuerierRoute,
+				keeper.QueryAllDidDocs, start, limit), nil)
+			if err != nil {
+				return err
+			}
+
+			var page types.QueryDidDocsResponse
+			err = cdc.UnmarshalJSON(res, &page)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(page, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagStart, "", "DID to start the page from (nextStartDid of the previous page)")
+	cmd.Flags().Uint64(FlagLimit, keeper.DefaultQueryPageLimit, "Maximum number of DID documents to return")
+
+	return cmd
+}
+
+func GetCmdDidsByAddress(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-dids-by-address [address]",
+		Short: "Query the DIDs whose signer key has the address",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
-				keeper.QueryAllDidDocs, "ALL"), nil)
+				keeper.QueryDidsByAddress, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var dids []exported.Did
+			err = cdc.UnmarshalJSON(res, &dids)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(dids, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdDidsByIssuer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-dids-by-issuer [issuer-did]",
+		Short: "Query the DIDs that hold credentials issued by the issuer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryDidsByIssuer, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var dids []exported.Did
+			err = cdc.UnmarshalJSON(res, &dids)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(dids, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdDidKeyHistory(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-did-key-history [did]",
+		Short: "Query the past pubKeys of a DID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryDidKeyHistory, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var records []types.PubKeyRecord
+			err = cdc.UnmarshalJSON(res, &records)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(records, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdResolveDid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "resolve-did [did]",
+		Short: "Resolve a DID to its W3C DID Core JSON-LD document",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryResolveDid, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+const (
+	FlagCredentialType = "type"
+	FlagIssuer         = "issuer"
+	FlagValid          = "valid"
+)
+
+func GetCmdDidCredentials(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-did-credentials [did]",
+		Short: "Query the credentials of a DID, optionally filtered by type, issuer and validity",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			credType, _ := cmd.Flags().GetString(FlagCredentialType)
+			issuer, _ := cmd.Flags().GetString(FlagIssuer)
+			valid, _ := cmd.Flags().GetString(FlagValid)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryDidCredentials, args[0], credType, issuer, valid), nil)
+			if err != nil {
+				return err
+			}
+
+			var credentials []types.CredentialStatus
+			err = cdc.UnmarshalJSON(res, &credentials)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(credentials, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagCredentialType, "", "Only include credentials with this type")
+	cmd.Flags().String(FlagIssuer, "", "Only include credentials issued by this DID")
+	cmd.Flags().String(FlagValid, "", "Only include valid (true) or invalid (false) credentials")
+
+	return cmd
+}
+
+func GetCmdCredentialRevocations(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-credential-revocations [issuer-did]",
+		Short: "Query the credentials revoked by an issuer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryCredentialRevocations, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var revocations []types.CredentialRevocation
+			err = cdc.UnmarshalJSON(res, &revocations)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(revocations, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query params, including the trusted credential issuers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
+				keeper.QueryParams), nil)
 			if err != nil {
 				return err
 			}
 
-			var didDocs []types.BaseDidDoc
-			err = cdc.UnmarshalJSON(res, &didDocs)
+			var params types.Params
+			err = cdc.UnmarshalJSON(res, &params)
 			if err != nil {
 				return err
 			}
 
-			output, err := cdc.MarshalJSONIndent(didDocs, "", "  ")
+			output, err := cdc.MarshalJSONIndent(params, "", "  ")
 			if err != nil {
 				return err
 			}
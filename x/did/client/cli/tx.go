@@ -1,14 +1,24 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
 	"github.com/ixofoundation/ixo-blockchain/x/ixo"
+	"io/ioutil"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+	"github.com/ixofoundation/ixo-blockchain/x/did/internal/keeper"
 	"github.com/ixofoundation/ixo-blockchain/x/did/internal/types"
 )
 
@@ -26,7 +36,7 @@ func GetCmdAddDidDoc(cdc *codec.Codec) *cobra.Command {
 			cliCtx := context.NewCLIContext().WithCodec(cdc).
 				WithFromAddress(ixoDid.Address())
 
-			msg := types.NewMsgAddDid(ixoDid.Did, ixoDid.VerifyKey)
+			msg := types.NewMsgAddDid(ixoDid.Did, ixoDid.VerifyKey, ixoDid.PubKeyType)
 			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
 		},
 	}
@@ -58,3 +68,321 @@ func GetCmdAddCredential(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+const (
+	FlagRecovery          = "recovery"
+	FlagNewRecoveryPubKey = "new-recovery-pub-key"
+	FlagPubKeyType        = "pub-key-type"
+	FlagPubKeyTypes       = "pub-key-types"
+)
+
+func GetCmdRotateDidKey(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-did-key [did] [new-ixo-did] [signer-ixo-did]",
+		Short: "Replace the pubKey of a DID with the key of new-ixo-did, signed by its current key or its recovery key",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			did := args[0]
+			newIxoDid, err := types.UnmarshalIxoDid(args[1])
+			if err != nil {
+				return err
+			}
+			ixoDid, err := types.UnmarshalIxoDid(args[2])
+			if err != nil {
+				return err
+			}
+
+			recovery, _ := cmd.Flags().GetBool(FlagRecovery)
+			newRecoveryPubKey, _ := cmd.Flags().GetString(FlagNewRecoveryPubKey)
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg, err := types.NewSignedMsgRotateDidKey(did, newIxoDid, newRecoveryPubKey, recovery)
+			if err != nil {
+				return err
+			}
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+
+	cmd.Flags().Bool(FlagRecovery, false, "Whether the signer is the recovery key of the DID")
+	cmd.Flags().String(FlagNewRecoveryPubKey, "", "New recovery pubKey for the DID")
+
+	return cmd
+}
+
+func GetCmdDeactivateDid(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deactivate-did [did] [signer-ixo-did]",
+		Short: "Deactivate a DID, signed by its current key or its recovery key",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			did := args[0]
+			ixoDid, err := types.UnmarshalIxoDid(args[1])
+			if err != nil {
+				return err
+			}
+
+			recovery, _ := cmd.Flags().GetBool(FlagRecovery)
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgDeactivateDid(did, recovery)
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+
+	cmd.Flags().Bool(FlagRecovery, false, "Whether the signer is the recovery key of the DID")
+
+	return cmd
+}
+
+func GetCmdUpdateDidDoc(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-did-doc [did-doc-data-json] [ixo-did]",
+		Short: "Replace the controllers, verification methods and services of a DID",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var data types.DidDocumentData
+			err := json.Unmarshal([]byte(args[0]), &data)
+			if err != nil {
+				return err
+			}
+
+			ixoDid, err := types.UnmarshalIxoDid(args[1])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgUpdateDidDoc(ixoDid.Did, data)
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+}
+
+func GetCmdAddGenericCredential(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-credential [credential-json] [signer-did-doc]",
+		Short: "Add a new credential for a Did by the signer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var credential exported.DidCredential
+			err := json.Unmarshal([]byte(args[0]), &credential)
+			if err != nil {
+				return err
+			}
+
+			ixoDid, err := types.UnmarshalIxoDid(args[1])
+			if err != nil {
+				return err
+			}
+
+			// The signer is the issuer, and the issue date defaults to now
+			credential.Issuer = ixoDid.Did
+			if credential.Issued == "" {
+				credential.Issued = time.Now().Format(time.RFC3339)
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgAddGenericCredential(credential)
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+}
+
+func GetCmdRevokeCredential(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-credential [subject-did] [credential-id] [reason] [signer-did-doc]",
+		Short: "Revoke a credential that was issued by the signer",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			subjectDid := args[0]
+			credentialId := args[1]
+			reason := args[2]
+
+			ixoDid, err := types.UnmarshalIxoDid(args[3])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgRevokeCredential(ixoDid.Did, subjectDid, credentialId, reason)
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+}
+
+func GetCmdSetDidMultisig(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-did-multisig [threshold] [comma-separated-pub-keys] [ixo-did]",
+		Short: "Make a DID controlled by threshold of the pubKeys, or by its own pubKey if none are given",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			threshold, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pubKeys := []string{}
+			if strings.TrimSpace(args[1]) != "" {
+				pubKeys = strings.Split(args[1], ",")
+			}
+
+			var pubKeyTypes []string
+			pubKeyTypesStr, _ := cmd.Flags().GetString(FlagPubKeyTypes)
+			if strings.TrimSpace(pubKeyTypesStr) != "" {
+				pubKeyTypes = strings.Split(pubKeyTypesStr, ",")
+			}
+
+			ixoDid, err := types.UnmarshalIxoDid(args[2])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgSetDidMultisig(ixoDid.Did, threshold, pubKeys, pubKeyTypes)
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+
+	cmd.Flags().String(FlagPubKeyTypes, "", "Comma-separated types of the pubKeys (ed25519 or secp256k1), if not all ed25519")
+	return cmd
+}
+
+// getMultisigSignBytes returns the multisig DID's doc and the bytes that its
+// controllers sign for the tx, using the account number and sequence of the
+// DID's address unless these are specified using flags.
+func getMultisigSignBytes(cdc *codec.Codec, stdTx auth.StdTx,
+	did exported.Did) (types.BaseDidDoc, []byte, error) {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+		keeper.QueryDidDoc, did), nil)
+	if err != nil {
+		return types.BaseDidDoc{}, nil, err
+	}
+
+	var didDoc types.BaseDidDoc
+	err = cdc.UnmarshalJSON(res, &didDoc)
+	if err != nil {
+		return types.BaseDidDoc{}, nil, err
+	} else if !didDoc.IsMultisig() {
+		return types.BaseDidDoc{}, nil, fmt.Errorf("did %s is not a multisig did", did)
+	}
+
+	txBldr, err := utils.PrepareTxBuilder(
+		auth.NewTxBuilderFromCLI(), cliCtx.WithFromAddress(didDoc.Address()))
+	if err != nil {
+		return types.BaseDidDoc{}, nil, err
+	}
+
+	signBytes := ixo.MultisigSignBytes(
+		txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(), stdTx)
+	return didDoc, signBytes, nil
+}
+
+func GetCmdSignMultisigTx(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign-multisig-tx [tx-file] [multisig-did] [signer-ixo-did]",
+		Short: "Sign a tx generated with --generate-only on behalf of a multisig DID, printing the partial signature",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			ixoDid, err := types.UnmarshalIxoDid(args[2])
+			if err != nil {
+				return err
+			}
+
+			didDoc, signBytes, err := getMultisigSignBytes(cdc, stdTx, args[1])
+			if err != nil {
+				return err
+			}
+
+			isController := false
+			for _, pubKey := range didDoc.MultisigPubKeys {
+				isController = isController || pubKey == ixoDid.VerifyKey
+			}
+			if !isController {
+				return errors.New("signer is not a controller of the multisig did")
+			}
+
+			sig, err := ixo.SignBytesWithIxoDid(signBytes, ixoDid)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(sig, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdMultisignTx(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "multisign-tx [tx-file] [multisig-did] [signature-file]...",
+		Short: "Combine the partial signatures of a multisig DID's controllers into a signed tx",
+		Long: `Combine the partial signatures (produced using sign-multisig-tx) of at least
+threshold of the controllers of a multisig DID into a signed tx, which can then
+be broadcast using the broadcast command.`,
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			didDoc, signBytes, err := getMultisigSignBytes(cdc, stdTx, args[1])
+			if err != nil {
+				return err
+			}
+
+			var sigs []auth.StdSignature
+			for _, sigFile := range args[2:] {
+				bz, err := ioutil.ReadFile(sigFile)
+				if err != nil {
+					return err
+				}
+
+				var sig auth.StdSignature
+				err = cdc.UnmarshalJSON(bz, &sig)
+				if err != nil {
+					return err
+				}
+				sigs = append(sigs, sig)
+			}
+
+			signedTx, err := ixo.AssembleMultisigTx(stdTx, signBytes, didDoc.GetSignerPubKey(), sigs)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(signedTx, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
@@ -20,6 +20,15 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/did/{did}", queryDidDocRequestHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/did", queryAllDidsRequestHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/allDidDocs", queryAllDidDocsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/didKeyHistory/{did}", queryDidKeyHistoryRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/didCredentials/{did}", queryDidCredentialsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/credentialRevocations/{issuerDid}", queryCredentialRevocationsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/didParams", queryParamsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/didsByAddress/{address}", queryDidsByAddressRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/didsByIssuer/{issuerDid}", queryDidsByIssuerRequestHandler(cliCtx)).Methods("GET")
+
+	// Path expected by DID resolvers such as the Universal Resolver
+	r.HandleFunc("/1.0/identifiers/{did}", queryResolveDidRequestHandler(cliCtx)).Methods("GET")
 }
 
 func queryAddressFromBase58EncodedPubkeyRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -27,14 +36,15 @@ func queryAddressFromBase58EncodedPubkeyRequestHandler(cliCtx context.CLIContext
 
 		w.Header().Set("Content-Type", "application/json")
 		vars := mux.Vars(r)
+		pubKeyType := r.URL.Query().Get("pubKeyType")
 
-		if !types.IsValidPubKey(vars["pubKey"]) {
+		if !types.IsValidTypedPubKey(pubKeyType, vars["pubKey"]) {
 			w.WriteHeader(http.StatusInternalServerError)
 			_, _ = w.Write([]byte("input is not a valid base-58 encoded pubKey"))
 			return
 		}
 
-		accAddress := exported.VerifyKeyToAddr(vars["pubKey"])
+		accAddress := exported.TypedVerifyKeyToAddr(pubKeyType, vars["pubKey"])
 
 		rest.PostProcessResponse(w, cliCtx, accAddress)
 	}
@@ -99,8 +109,10 @@ func queryAllDidsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
 		w.Header().Set("Content-Type", "application/json")
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
-			keeper.QueryAllDids), nil)
+		start := r.URL.Query().Get("start")
+		limit := r.URL.Query().Get("limit")
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryAllDids, start, limit), nil)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			_, _ = w.Write([]byte(fmt.Sprintf("Could't query did. Error: %s", err.Error())))
@@ -112,10 +124,10 @@ func queryAllDidsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		var dids []exported.Did
-		cliCtx.Codec.MustUnmarshalJSON(res, &dids)
+		var page types.QueryDidsResponse
+		cliCtx.Codec.MustUnmarshalJSON(res, &page)
 
-		rest.PostProcessResponse(w, cliCtx, dids)
+		rest.PostProcessResponse(w, cliCtx, page)
 	}
 }
 
@@ -123,8 +135,10 @@ func queryAllDidDocsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
 		w.Header().Set("Content-Type", "application/json")
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
-			keeper.QueryAllDidDocs), nil)
+		start := r.URL.Query().Get("start")
+		limit := r.URL.Query().Get("limit")
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryAllDidDocs, start, limit), nil)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			_, _ = w.Write([]byte(fmt.Sprintf("Could't query did. Error: %s", err.Error())))
@@ -137,9 +151,149 @@ func queryAllDidDocsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		var didDocs []types.BaseDidDoc
-		cliCtx.Codec.MustUnmarshalJSON(res, &didDocs)
+		var page types.QueryDidDocsResponse
+		cliCtx.Codec.MustUnmarshalJSON(res, &page)
+
+		rest.PostProcessResponse(w, cliCtx, page)
+	}
+}
+
+func queryDidKeyHistoryRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryDidKeyHistory, vars["did"]), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query did key history. Error: %s", err.Error())))
+			return
+		}
+
+		var records []types.PubKeyRecord
+		cliCtx.Codec.MustUnmarshalJSON(res, &records)
+
+		rest.PostProcessResponse(w, cliCtx, records)
+	}
+}
+
+func queryResolveDidRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		vars := mux.Vars(r)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryResolveDid, vars["did"]), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't resolve did. Error: %s", err.Error())))
+			return
+		}
+
+		w.Header().Set("Content-Type", `application/ld+json;profile="https://w3id.org/did-resolution"`)
+		_, _ = w.Write(res)
+	}
+}
+
+func queryDidCredentialsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		credType := r.URL.Query().Get("type")
+		issuer := r.URL.Query().Get("issuer")
+		valid := r.URL.Query().Get("valid")
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryDidCredentials, vars["did"], credType, issuer, valid), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query did credentials. Error: %s", err.Error())))
+			return
+		}
+
+		var credentials []types.CredentialStatus
+		cliCtx.Codec.MustUnmarshalJSON(res, &credentials)
+
+		rest.PostProcessResponse(w, cliCtx, credentials)
+	}
+}
+
+func queryCredentialRevocationsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryCredentialRevocations, vars["issuerDid"]), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query credential revocations. Error: %s", err.Error())))
+			return
+		}
+
+		var revocations []types.CredentialRevocation
+		cliCtx.Codec.MustUnmarshalJSON(res, &revocations)
+
+		rest.PostProcessResponse(w, cliCtx, revocations)
+	}
+}
+
+func queryParamsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
 
-		rest.PostProcessResponse(w, cliCtx, didDocs)
+		w.Header().Set("Content-Type", "application/json")
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
+			keeper.QueryParams), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query params. Error: %s", err.Error())))
+			return
+		}
+
+		var params types.Params
+		cliCtx.Codec.MustUnmarshalJSON(res, &params)
+
+		rest.PostProcessResponse(w, cliCtx, params)
+	}
+}
+
+func queryDidsByAddressRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryDidsByAddress, vars["address"]), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query dids by address. Error: %s", err.Error())))
+			return
+		}
+
+		var dids []exported.Did
+		cliCtx.Codec.MustUnmarshalJSON(res, &dids)
+
+		rest.PostProcessResponse(w, cliCtx, dids)
+	}
+}
+
+func queryDidsByIssuerRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryDidsByIssuer, vars["issuerDid"]), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query dids by issuer. Error: %s", err.Error())))
+			return
+		}
+
+		var dids []exported.Did
+		cliCtx.Codec.MustUnmarshalJSON(res, &dids)
+
+		rest.PostProcessResponse(w, cliCtx, dids)
 	}
 }
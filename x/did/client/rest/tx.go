@@ -1,14 +1,18 @@
 package rest
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/gorilla/mux"
 
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
 	"github.com/ixofoundation/ixo-blockchain/x/did/internal/keeper"
 	"github.com/ixofoundation/ixo-blockchain/x/did/internal/types"
 	"github.com/ixofoundation/ixo-blockchain/x/ixo"
@@ -17,6 +21,12 @@ import (
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/did", createDidRequestHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/credential", addCredentialRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/rotateDidKey", rotateDidKeyRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/deactivateDid", deactivateDidRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/updateDidDoc", updateDidDocRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/genericCredential", addGenericCredentialRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/revokeCredential", revokeCredentialRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/setDidMultisig", setDidMultisigRequestHandler(cliCtx)).Methods("POST")
 }
 
 func createDidRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -34,7 +44,7 @@ func createDidRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgAddDid(ixoDid.Did, ixoDid.VerifyKey)
+		msg := types.NewMsgAddDid(ixoDid.Did, ixoDid.VerifyKey, ixoDid.PubKeyType)
 
 		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, ixoDid)
 		if err != nil {
@@ -88,3 +98,258 @@ func addCredentialRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, output)
 	}
 }
+
+func rotateDidKeyRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		did := r.URL.Query().Get("did")
+		newDidDocParam := r.URL.Query().Get("newDidDoc")
+		newRecoveryPubKey := r.URL.Query().Get("newRecoveryPubKey")
+		recoveryParam := r.URL.Query().Get("recovery")
+		didDocParam := r.URL.Query().Get("signerDidDoc")
+		mode := r.URL.Query().Get("mode")
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		recovery := false
+		if recoveryParam != "" {
+			var err error
+			recovery, err = strconv.ParseBool(recoveryParam)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(err.Error()))
+				return
+			}
+		}
+
+		ixoDid, err := types.UnmarshalIxoDid(didDocParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		newIxoDid, err := types.UnmarshalIxoDid(newDidDocParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		msg, err := types.NewSignedMsgRotateDidKey(did, newIxoDid, newRecoveryPubKey, recovery)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, ixoDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func deactivateDidRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		did := r.URL.Query().Get("did")
+		recoveryParam := r.URL.Query().Get("recovery")
+		didDocParam := r.URL.Query().Get("signerDidDoc")
+		mode := r.URL.Query().Get("mode")
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		recovery := false
+		if recoveryParam != "" {
+			var err error
+			recovery, err = strconv.ParseBool(recoveryParam)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(err.Error()))
+				return
+			}
+		}
+
+		ixoDid, err := types.UnmarshalIxoDid(didDocParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		msg := types.NewMsgDeactivateDid(did, recovery)
+
+		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, ixoDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func updateDidDocRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		dataParam := r.URL.Query().Get("data")
+		didDocParam := r.URL.Query().Get("didDoc")
+		mode := r.URL.Query().Get("mode")
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		var data types.DidDocumentData
+		err := json.Unmarshal([]byte(dataParam), &data)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		ixoDid, err := types.UnmarshalIxoDid(didDocParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		msg := types.NewMsgUpdateDidDoc(ixoDid.Did, data)
+
+		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, ixoDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func addGenericCredentialRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		credentialParam := r.URL.Query().Get("credential")
+		didDocParam := r.URL.Query().Get("signerDidDoc")
+		mode := r.URL.Query().Get("mode")
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		var credential exported.DidCredential
+		err := json.Unmarshal([]byte(credentialParam), &credential)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		ixoDid, err := types.UnmarshalIxoDid(didDocParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		// The signer is the issuer, and the issue date defaults to now
+		credential.Issuer = ixoDid.Did
+		if credential.Issued == "" {
+			credential.Issued = time.Now().Format(time.RFC3339)
+		}
+
+		msg := types.NewMsgAddGenericCredential(credential)
+
+		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, ixoDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func revokeCredentialRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		subjectDid := r.URL.Query().Get("did")
+		credentialId := r.URL.Query().Get("credentialId")
+		reason := r.URL.Query().Get("reason")
+		didDocParam := r.URL.Query().Get("signerDidDoc")
+		mode := r.URL.Query().Get("mode")
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		ixoDid, err := types.UnmarshalIxoDid(didDocParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		msg := types.NewMsgRevokeCredential(ixoDid.Did, subjectDid, credentialId, reason)
+
+		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, ixoDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func setDidMultisigRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		thresholdParam := r.URL.Query().Get("threshold")
+		pubKeysParam := r.URL.Query().Get("pubKeys")
+		pubKeyTypesParam := r.URL.Query().Get("pubKeyTypes")
+		didDocParam := r.URL.Query().Get("signerDidDoc")
+		mode := r.URL.Query().Get("mode")
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		threshold, err := strconv.ParseUint(thresholdParam, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		pubKeys := []string{}
+		if strings.TrimSpace(pubKeysParam) != "" {
+			pubKeys = strings.Split(pubKeysParam, ",")
+		}
+
+		var pubKeyTypes []string
+		if strings.TrimSpace(pubKeyTypesParam) != "" {
+			pubKeyTypes = strings.Split(pubKeyTypesParam, ",")
+		}
+
+		ixoDid, err := types.UnmarshalIxoDid(didDocParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		msg := types.NewMsgSetDidMultisig(ixoDid.Did, threshold, pubKeys, pubKeyTypes)
+
+		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, ixoDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
@@ -5,16 +5,30 @@ import (
 	"fmt"
 	"github.com/btcsuite/btcutil/base58"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
 type Did = string
 
+// Types of DID pubKeys. An empty pubKey type means ed25519, so that DIDs
+// created before pubKeys were typed keep their addresses.
+const (
+	PubKeyTypeEd25519   = "ed25519"
+	PubKeyTypeSecp256k1 = "secp256k1"
+)
+
 type DidDoc interface {
 	SetDid(did Did) error
 	GetDid() Did
 	SetPubKey(pubkey string) error
 	GetPubKey() string
+	GetRecoveryPubKey() string
+	IsDeactivated() bool
+	IsMultisig() bool
+	GetSignerPubKey() crypto.PubKey
+	GetCredentials() []DidCredential
 	Address() sdk.AccAddress
 }
 
@@ -38,6 +52,8 @@ type IxoDid struct {
 	VerifyKey           string `json:"verifyKey" yaml:"verifyKey"`
 	EncryptionPublicKey string `json:"encryptionPublicKey" yaml:"encryptionPublicKey"`
 	Secret              Secret `json:"secret" yaml:"secret"`
+	// PubKeyType is the type of the verify key and sign key (default ed25519)
+	PubKeyType string `json:"pubKeyType,omitempty" yaml:"pubKeyType,omitempty"`
 }
 
 // Above IxoDid modelled after Sovrin documents
@@ -63,24 +79,55 @@ func (id IxoDid) String() string {
 	return fmt.Sprintf("%v", string(output))
 }
 
-func VerifyKeyToAddr(verifyKey string) sdk.AccAddress {
+func VerifyKeyToPubKey(verifyKey string) ed25519.PubKeyEd25519 {
 	var pubKey ed25519.PubKeyEd25519
 	copy(pubKey[:], base58.Decode(verifyKey))
-	return sdk.AccAddress(pubKey.Address())
+	return pubKey
+}
+
+// TypedVerifyKeyToPubKey decodes a base58 verify key of the pubKey type. Keys
+// of unknown types are decoded as ed25519 keys, given that pubKey types are
+// validated before they are stored.
+func TypedVerifyKeyToPubKey(pubKeyType, verifyKey string) crypto.PubKey {
+	switch pubKeyType {
+	case PubKeyTypeSecp256k1:
+		var pubKey secp256k1.PubKeySecp256k1
+		copy(pubKey[:], base58.Decode(verifyKey))
+		return pubKey
+	default:
+		return VerifyKeyToPubKey(verifyKey)
+	}
+}
+
+func VerifyKeyToAddr(verifyKey string) sdk.AccAddress {
+	return sdk.AccAddress(VerifyKeyToPubKey(verifyKey).Address())
+}
+
+func TypedVerifyKeyToAddr(pubKeyType, verifyKey string) sdk.AccAddress {
+	return sdk.AccAddress(TypedVerifyKeyToPubKey(pubKeyType, verifyKey).Address())
 }
 
 func (id IxoDid) Address() sdk.AccAddress {
-	return VerifyKeyToAddr(id.VerifyKey)
+	return TypedVerifyKeyToAddr(id.PubKeyType, id.VerifyKey)
 }
 
+// Claim is the subject of a credential. Apart from the legacy KYCValidated
+// flag, a claim can hold arbitrary JSON data that conforms to a schema.
 type Claim struct {
-	Id           Did  `json:"id" yaml:"id"`
-	KYCValidated bool `json:"KYCValidated" yaml:"KYCValidated"`
+	Id           Did             `json:"id" yaml:"id"`
+	KYCValidated bool            `json:"KYCValidated" yaml:"KYCValidated"`
+	SchemaId     string          `json:"schemaId,omitempty" yaml:"schemaId"`
+	Data         json.RawMessage `json:"data,omitempty" yaml:"data"`
 }
 
+// DidCredential is a credential issued to the DID in the claim. Credentials
+// without an ID cannot be revoked. Issued and Expires are RFC3339 timestamps,
+// where an empty Expires means that the credential never expires.
 type DidCredential struct {
 	CredType []string `json:"type" yaml:"type"`
 	Issuer   Did      `json:"issuer" yaml:"issuer"`
 	Issued   string   `json:"issued" yaml:"issued"`
 	Claim    Claim    `json:"claim" yaml:"claim"`
+	Id       string   `json:"id,omitempty" yaml:"id"`
+	Expires  string   `json:"expires,omitempty" yaml:"expires"`
 }
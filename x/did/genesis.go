@@ -6,16 +6,32 @@ import (
 )
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.ValidatorUpdate {
+	// Initialise params
+	keeper.SetParams(ctx, data.Params)
+
 	// Initialise did docs
 	for _, d := range data.DidDocs {
 		keeper.AddDidDoc(ctx, d)
 	}
 
+	// Initialise pubKey history
+	for _, r := range data.PubKeyRecords {
+		keeper.SetPubKeyRecord(ctx, r)
+	}
+
+	// Initialise credential revocation registry
+	for _, r := range data.Revocations {
+		keeper.SetCredentialRevocation(ctx, r)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 	return GenesisState{
-		DidDocs: keeper.GetAllDidDocs(ctx),
+		DidDocs:       keeper.GetAllDidDocs(ctx),
+		PubKeyRecords: keeper.GetAllPubKeyRecords(ctx),
+		Revocations:   keeper.GetAllCredentialRevocations(ctx),
+		Params:        keeper.GetParams(ctx),
 	}
 }
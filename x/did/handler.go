@@ -1,19 +1,33 @@
 package did
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 
 	"github.com/ixofoundation/ixo-blockchain/x/did/internal/keeper"
 	"github.com/ixofoundation/ixo-blockchain/x/did/internal/types"
 )
 
-func NewHandler(k keeper.Keeper) sdk.Handler {
+func NewHandler(k keeper.Keeper, ak auth.AccountKeeper, bk bank.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
 		case types.MsgAddDid:
 			return handleMsgAddDidDoc(ctx, k, msg)
 		case types.MsgAddCredential:
 			return handleMsgAddCredential(ctx, k, msg)
+		case types.MsgRotateDidKey:
+			return handleMsgRotateDidKey(ctx, k, ak, bk, msg)
+		case types.MsgDeactivateDid:
+			return handleMsgDeactivateDid(ctx, k, msg)
+		case types.MsgUpdateDidDoc:
+			return handleMsgUpdateDidDoc(ctx, k, msg)
+		case types.MsgRevokeCredential:
+			return handleMsgRevokeCredential(ctx, k, msg)
+		case types.MsgSetDidMultisig:
+			return handleMsgSetDidMultisig(ctx, k, ak, bk, msg)
 		default:
 			return sdk.ErrUnknownRequest("No match for message type.").Result()
 		}
@@ -22,6 +36,7 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 
 func handleMsgAddDidDoc(ctx sdk.Context, k keeper.Keeper, msg types.MsgAddDid) sdk.Result {
 	didDoc := types.NewBaseDidDoc(msg.Did, msg.PubKey)
+	didDoc.PubKeyType = msg.PubKeyType
 
 	err := k.SetDidDoc(ctx, didDoc)
 	if err != nil {
@@ -31,6 +46,11 @@ func handleMsgAddDidDoc(ctx sdk.Context, k keeper.Keeper, msg types.MsgAddDid) s
 }
 
 func handleMsgAddCredential(ctx sdk.Context, k keeper.Keeper, msg types.MsgAddCredential) sdk.Result {
+	// Only trusted issuers can issue credentials
+	if !k.IsTrustedIssuer(ctx, msg.DidCredential) {
+		return types.ErrorUntrustedIssuer(types.DefaultCodespace, msg.DidCredential.Issuer).Result()
+	}
+
 	err := k.AddCredentials(ctx, msg.DidCredential.Claim.Id, msg.DidCredential)
 	if err != nil {
 		return err.Result()
@@ -38,3 +58,191 @@ func handleMsgAddCredential(ctx sdk.Context, k keeper.Keeper, msg types.MsgAddCr
 
 	return sdk.Result{}
 }
+
+func handleMsgRotateDidKey(ctx sdk.Context, k keeper.Keeper, ak auth.AccountKeeper, bk bank.Keeper, msg types.MsgRotateDidKey) sdk.Result {
+	existingDidDoc, err := k.GetDidDoc(ctx, msg.Did)
+	if err != nil {
+		return err.Result()
+	} else if existingDidDoc.IsDeactivated() {
+		return types.ErrorDidDeactivated(types.DefaultCodespace, msg.Did).Result()
+	}
+	didDoc := existingDidDoc.(types.BaseDidDoc)
+
+	// Only the recovery key can replace an existing recovery key
+	if msg.NewRecoveryPubKey != "" && didDoc.GetRecoveryPubKey() != "" &&
+		msg.NewRecoveryPubKey != didDoc.GetRecoveryPubKey() && !msg.SignedByRecoveryKey {
+		return sdk.ErrUnauthorized("recovery pubKey can only be replaced using the recovery key").Result()
+	}
+
+	// Keep the old pubKey so that past signatures can still be attributed
+	oldAddress := didDoc.Address()
+	k.AddPubKeyRecord(ctx, msg.Did, didDoc.GetPubKey(), didDoc.GetPubKeyType())
+	didDoc.RotatePubKey(msg.NewPubKey, msg.NewPubKeyType, msg.NewRecoveryPubKey)
+
+	// Move the DID's funds to the address of the new pubKey, but only if the
+	// rotation was signed by the old pubKey, i.e. by the owner of the funds.
+	// A recovery key does not control the old address, so funds stay there.
+	if !msg.SignedByRecoveryKey {
+		err = moveDidFunds(ctx, ak, bk, oldAddress, didDoc.Address())
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	k.AddDidDoc(ctx, didDoc)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateDidKey,
+			sdk.NewAttribute(types.AttributeKeyDid, msg.Did),
+			sdk.NewAttribute(types.AttributeKeyPubKey, msg.NewPubKey),
+			sdk.NewAttribute(types.AttributeKeySignedByRecovery, strconv.FormatBool(msg.SignedByRecoveryKey)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgDeactivateDid(ctx sdk.Context, k keeper.Keeper, msg types.MsgDeactivateDid) sdk.Result {
+	existingDidDoc, err := k.GetDidDoc(ctx, msg.Did)
+	if err != nil {
+		return err.Result()
+	} else if existingDidDoc.IsDeactivated() {
+		return types.ErrorDidDeactivated(types.DefaultCodespace, msg.Did).Result()
+	}
+	didDoc := existingDidDoc.(types.BaseDidDoc)
+
+	didDoc.Deactivate()
+	k.AddDidDoc(ctx, didDoc)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeactivateDid,
+			sdk.NewAttribute(types.AttributeKeyDid, msg.Did),
+			sdk.NewAttribute(types.AttributeKeySignedByRecovery, strconv.FormatBool(msg.SignedByRecoveryKey)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgUpdateDidDoc(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateDidDoc) sdk.Result {
+	existingDidDoc, err := k.GetDidDoc(ctx, msg.Did)
+	if err != nil {
+		return err.Result()
+	} else if existingDidDoc.IsDeactivated() {
+		return types.ErrorDidDeactivated(types.DefaultCodespace, msg.Did).Result()
+	}
+	didDoc := existingDidDoc.(types.BaseDidDoc)
+
+	didDoc.SetDocumentData(msg.Data)
+	k.AddDidDoc(ctx, didDoc)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateDidDoc,
+			sdk.NewAttribute(types.AttributeKeyDid, msg.Did),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRevokeCredential(ctx sdk.Context, k keeper.Keeper, msg types.MsgRevokeCredential) sdk.Result {
+	// Only the issuer of the credential can revoke it
+	_, err := k.GetCredential(ctx, msg.SubjectDid, msg.IssuerDid, msg.CredentialId)
+	if err != nil {
+		return err.Result()
+	} else if k.IsCredentialRevoked(ctx, msg.IssuerDid, msg.SubjectDid, msg.CredentialId) {
+		return types.ErrorCredentialAlreadyRevoked(types.DefaultCodespace, msg.CredentialId).Result()
+	}
+
+	revocation := types.NewCredentialRevocation(
+		ctx, msg.CredentialId, msg.IssuerDid, msg.SubjectDid, msg.Reason)
+	k.SetCredentialRevocation(ctx, revocation)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeCredential,
+			sdk.NewAttribute(types.AttributeKeyIssuerDid, msg.IssuerDid),
+			sdk.NewAttribute(types.AttributeKeyDid, msg.SubjectDid),
+			sdk.NewAttribute(types.AttributeKeyCredentialId, msg.CredentialId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSetDidMultisig(ctx sdk.Context, k keeper.Keeper, ak auth.AccountKeeper, bk bank.Keeper, msg types.MsgSetDidMultisig) sdk.Result {
+	existingDidDoc, err := k.GetDidDoc(ctx, msg.Did)
+	if err != nil {
+		return err.Result()
+	} else if existingDidDoc.IsDeactivated() {
+		return types.ErrorDidDeactivated(types.DefaultCodespace, msg.Did).Result()
+	}
+	didDoc := existingDidDoc.(types.BaseDidDoc)
+
+	oldAddress := didDoc.Address()
+	didDoc.SetMultisig(msg.Threshold, msg.PubKeys, msg.PubKeyTypes)
+
+	// Move the DID's funds to the address of the new signer key (the msg is
+	// signed by the old signer key, which controls the old address)
+	err = moveDidFunds(ctx, ak, bk, oldAddress, didDoc.Address())
+	if err != nil {
+		return err.Result()
+	}
+
+	k.AddDidDoc(ctx, didDoc)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetDidMultisig,
+			sdk.NewAttribute(types.AttributeKeyDid, msg.Did),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(msg.Threshold, 10)),
+			sdk.NewAttribute(types.AttributeKeyAddress, didDoc.Address().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// moveDidFunds moves a DID's spendable funds when its address changes, which
+// happens when its signer key changes. Coins that are still locked in a
+// vesting account cannot be moved, so these stay at the old address.
+func moveDidFunds(ctx sdk.Context, ak auth.AccountKeeper, bk bank.Keeper,
+	from, to sdk.AccAddress) sdk.Error {
+	if from.Equals(to) {
+		return nil
+	}
+
+	acc := ak.GetAccount(ctx, from)
+	if acc == nil {
+		return nil
+	}
+
+	coins := acc.SpendableCoins(ctx.BlockHeader().Time)
+	if coins.IsZero() {
+		return nil
+	}
+	return bk.SendCoins(ctx, from, to, coins)
+}
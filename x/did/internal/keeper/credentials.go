@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+	"github.com/ixofoundation/ixo-blockchain/x/did/internal/types"
+)
+
+func (k Keeper) GetCredentialRevocationIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.RevocationKey)
+}
+
+func (k Keeper) GetCredentialRevocationIteratorByIssuer(ctx sdk.Context, issuerDid exported.Did) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetCredentialRevocationsPrefixKey(issuerDid))
+}
+
+func (k Keeper) MustGetCredentialRevocationByKey(ctx sdk.Context, key []byte) types.CredentialRevocation {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("credential revocation not found")
+	}
+
+	bz := store.Get(key)
+	var revocation types.CredentialRevocation
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &revocation)
+
+	return revocation
+}
+
+func (k Keeper) IsCredentialRevoked(ctx sdk.Context, issuerDid, subjectDid exported.Did,
+	credentialId string) bool {
+	if credentialId == "" {
+		return false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetCredentialRevocationKey(issuerDid, subjectDid, credentialId))
+}
+
+func (k Keeper) SetCredentialRevocation(ctx sdk.Context, revocation types.CredentialRevocation) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetCredentialRevocationKey(
+		revocation.IssuerDid, revocation.SubjectDid, revocation.CredentialId)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(revocation))
+}
+
+func (k Keeper) GetCredentialRevocations(ctx sdk.Context, issuerDid exported.Did) []types.CredentialRevocation {
+	iterator := k.GetCredentialRevocationIteratorByIssuer(ctx, issuerDid)
+	defer iterator.Close()
+
+	revocations := []types.CredentialRevocation{}
+	for ; iterator.Valid(); iterator.Next() {
+		revocations = append(revocations, k.MustGetCredentialRevocationByKey(ctx, iterator.Key()))
+	}
+
+	return revocations
+}
+
+func (k Keeper) GetAllCredentialRevocations(ctx sdk.Context) []types.CredentialRevocation {
+	iterator := k.GetCredentialRevocationIterator(ctx)
+	defer iterator.Close()
+
+	revocations := []types.CredentialRevocation{}
+	for ; iterator.Valid(); iterator.Next() {
+		revocations = append(revocations, k.MustGetCredentialRevocationByKey(ctx, iterator.Key()))
+	}
+
+	return revocations
+}
+
+// GetCredential returns the credential of the DID that was issued by the
+// issuer with the specified ID.
+func (k Keeper) GetCredential(ctx sdk.Context, did, issuerDid exported.Did,
+	credentialId string) (exported.DidCredential, sdk.Error) {
+	didDoc, err := k.GetDidDoc(ctx, did)
+	if err != nil {
+		return exported.DidCredential{}, err
+	}
+
+	for _, credential := range didDoc.(types.BaseDidDoc).GetCredentials() {
+		if credential.Issuer == issuerDid && credential.Id == credentialId {
+			return credential, nil
+		}
+	}
+
+	return exported.DidCredential{}, types.ErrorCredentialNotFound(
+		types.DefaultCodespace, did, credentialId)
+}
+
+// GetCredentialStatus indicates whether the credential has expired by the
+// current block time or has been revoked by its issuer.
+func (k Keeper) GetCredentialStatus(ctx sdk.Context, credential exported.DidCredential) types.CredentialStatus {
+	return types.CredentialStatus{
+		Credential: credential,
+		Expired:    types.IsCredentialExpired(credential, ctx.BlockTime()),
+		Revoked:    k.IsCredentialRevoked(ctx, credential.Issuer, credential.Claim.Id, credential.Id),
+	}
+}
+
+// GetCredentials returns the credentials of the DID that match the filter.
+func (k Keeper) GetCredentials(ctx sdk.Context, did exported.Did,
+	filter types.CredentialFilter) ([]types.CredentialStatus, sdk.Error) {
+	didDoc, err := k.GetDidDoc(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := []types.CredentialStatus{}
+	for _, credential := range didDoc.(types.BaseDidDoc).GetCredentials() {
+		status := k.GetCredentialStatus(ctx, credential)
+		if filter.Matches(status) {
+			statuses = append(statuses, status)
+		}
+	}
+
+	return statuses, nil
+}
+
+// IsTrustedIssuer indicates whether the issuer of the credential is trusted
+// to issue credentials of all of the credential's types.
+func (k Keeper) IsTrustedIssuer(ctx sdk.Context, credential exported.DidCredential) bool {
+	trustedIssuer, found := k.GetParams(ctx).TrustedIssuers.Get(credential.Issuer)
+	return found && trustedIssuer.CanIssue(credential)
+}
+
+// HasTrustedCredential indicates whether the DID holds a credential of the
+// type that has not expired or been revoked, and whose issuer is currently
+// trusted to issue credentials of that type. This can be used by other
+// modules to gate actions on credentials such as proof of KYC.
+func (k Keeper) HasTrustedCredential(ctx sdk.Context, did exported.Did, credType string) bool {
+	didDoc, err := k.GetDidDoc(ctx, did)
+	if err != nil || didDoc.IsDeactivated() {
+		return false
+	}
+
+	trustedIssuers := k.GetParams(ctx).TrustedIssuers
+	for _, credential := range didDoc.(types.BaseDidDoc).GetCredentials() {
+		if !types.CredentialHasType(credential, credType) {
+			continue
+		}
+
+		trustedIssuer, found := trustedIssuers.Get(credential.Issuer)
+		if !found || !trustedIssuer.CanIssueType(credType) {
+			continue
+		}
+
+		if k.GetCredentialStatus(ctx, credential).IsValid() {
+			return true
+		}
+	}
+
+	return false
+}
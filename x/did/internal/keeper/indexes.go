@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+	"github.com/ixofoundation/ixo-blockchain/x/did/internal/types"
+)
+
+// setDidDocIndexes indexes the DID doc by its address and by the issuers of
+// its credentials. Index entries have empty values, since the indexed DID is
+// the last part of the key.
+func (k Keeper) setDidDocIndexes(ctx sdk.Context, didDoc exported.DidDoc) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAddressIndexKey(didDoc.Address(), didDoc.GetDid()), []byte{})
+	for _, cred := range didDoc.GetCredentials() {
+		store.Set(types.GetIssuerIndexKey(cred.Issuer, didDoc.GetDid()), []byte{})
+	}
+}
+
+func (k Keeper) deleteDidDocIndexes(ctx sdk.Context, didDoc exported.DidDoc) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAddressIndexKey(didDoc.Address(), didDoc.GetDid()))
+	for _, cred := range didDoc.GetCredentials() {
+		store.Delete(types.GetIssuerIndexKey(cred.Issuer, didDoc.GetDid()))
+	}
+}
+
+// getIndexedDids returns the DIDs at the end of the keys with the prefix.
+func (k Keeper) getIndexedDids(ctx sdk.Context, prefix []byte) []exported.Did {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	dids := []exported.Did{}
+	for ; iterator.Valid(); iterator.Next() {
+		dids = append(dids, exported.Did(iterator.Key()[len(prefix):]))
+	}
+
+	return dids
+}
+
+// GetDidsByAddress returns the DIDs whose signer key has the address. This is
+// normally a single DID, unless several DIDs were registered with one key.
+func (k Keeper) GetDidsByAddress(ctx sdk.Context, address sdk.AccAddress) []exported.Did {
+	return k.getIndexedDids(ctx, types.GetAddressIndexPrefixKey(address))
+}
+
+// GetDidsByIssuer returns the DIDs that hold credentials issued by the issuer.
+func (k Keeper) GetDidsByIssuer(ctx sdk.Context, issuerDid exported.Did) []exported.Did {
+	return k.getIndexedDids(ctx, types.GetIssuerIndexPrefixKey(issuerDid))
+}
+
+// GetDidDocsPaginated returns up to limit DID docs in DID order, starting from
+// the start DID (or from the first DID if empty), and the DID at which the
+// next page starts (or an empty DID if there are no more DID docs).
+func (k Keeper) GetDidDocsPaginated(ctx sdk.Context, startDid exported.Did,
+	limit uint64) (didDocs []types.BaseDidDoc, nextStartDid exported.Did) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.GetDidPrefixKey(startDid), sdk.PrefixEndBytes(types.DidKey))
+	defer iterator.Close()
+
+	didDocs = []types.BaseDidDoc{}
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(didDocs)) == limit {
+			return didDocs, exported.Did(iterator.Key()[len(types.DidKey):])
+		}
+
+		var didDoc types.BaseDidDoc
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &didDoc)
+		didDocs = append(didDocs, didDoc)
+	}
+
+	return didDocs, ""
+}
+
+// GetDidsPaginated is like GetDidDocsPaginated but only returns the DIDs.
+func (k Keeper) GetDidsPaginated(ctx sdk.Context, startDid exported.Did,
+	limit uint64) (dids []exported.Did, nextStartDid exported.Did) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.GetDidPrefixKey(startDid), sdk.PrefixEndBytes(types.DidKey))
+	defer iterator.Close()
+
+	dids = []exported.Did{}
+	for ; iterator.Valid(); iterator.Next() {
+		did := exported.Did(iterator.Key()[len(types.DidKey):])
+		if uint64(len(dids)) == limit {
+			return dids, did
+		}
+		dids = append(dids, did)
+	}
+
+	return dids, ""
+}
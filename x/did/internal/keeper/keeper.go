@@ -4,22 +4,36 @@ import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
 	"github.com/ixofoundation/ixo-blockchain/x/did/internal/types"
 )
 
 type Keeper struct {
-	storeKey sdk.StoreKey
-	cdc      *codec.Codec
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	paramSpace params.Subspace
 }
 
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace) Keeper {
 	return Keeper{
-		storeKey: key,
-		cdc:      cdc,
+		storeKey:   key,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
 	}
 }
 
+// GetParams returns the total set of did parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of did parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
 func (k Keeper) GetDidDoc(ctx sdk.Context, did exported.Did) (exported.DidDoc, sdk.Error) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetDidPrefixKey(did)
@@ -54,9 +68,17 @@ func (k Keeper) SetDidDoc(ctx sdk.Context, did exported.DidDoc) (err sdk.Error)
 }
 
 func (k Keeper) AddDidDoc(ctx sdk.Context, did exported.DidDoc) {
+	// Replace the secondary indexes of the existing DID doc (if any), given
+	// that its address and credentials might have changed
+	if existingDidDoc, err := k.GetDidDoc(ctx, did.GetDid()); err == nil {
+		k.deleteDidDocIndexes(ctx, existingDidDoc)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetDidPrefixKey(did.GetDid())
 	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(did))
+
+	k.setDidDocIndexes(ctx, did)
 }
 
 func (k Keeper) AddCredentials(ctx sdk.Context, did exported.Did, credential exported.DidCredential) (err sdk.Error) {
@@ -69,7 +91,7 @@ func (k Keeper) AddCredentials(ctx sdk.Context, did exported.Did, credential exp
 	credentials := baseDidDoc.GetCredentials()
 
 	for _, data := range credentials {
-		if data.Issuer == credential.Issuer && data.CredType[0] == credential.CredType[0] && data.CredType[1] == credential.CredType[1] && data.Claim.KYCValidated == credential.Claim.KYCValidated {
+		if types.IsSameCredential(data, credential) {
 			return types.ErrorInvalidCredentials(types.DefaultCodespace, "credentials already exist")
 		}
 	}
@@ -101,3 +123,23 @@ func (k Keeper) GetAddDids(ctx sdk.Context) (dids []exported.Did) {
 
 	return dids
 }
+
+// ResolveDid resolves a DID to its W3C DID Core document. Since ixo DIDs are
+// also valid Sovrin DIDs, a DID that is not found under one DID method is
+// looked up under the other method, e.g. did:sov:abc resolves did:ixo:abc.
+func (k Keeper) ResolveDid(ctx sdk.Context, did exported.Did) (types.DidResolution, sdk.Error) {
+	didDoc, err := k.GetDidDoc(ctx, did)
+	if err != nil {
+		alternativeDid, ok := types.GetAlternativeDid(did)
+		if !ok {
+			return types.DidResolution{}, err
+		}
+
+		didDoc, err = k.GetDidDoc(ctx, alternativeDid)
+		if err != nil {
+			return types.DidResolution{}, err
+		}
+	}
+
+	return types.NewDidResolution(did, didDoc.(types.BaseDidDoc)), nil
+}
@@ -1,8 +1,16 @@
 package keeper
 
 import (
+	"encoding/json"
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+	"github.com/ixofoundation/ixo-blockchain/x/ixo"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -21,3 +29,362 @@ func TestKeeper(t *testing.T) {
 	_, err = k.GetDidDoc(ctx, types.ValidDidDoc.GetDid())
 	require.Nil(t, err)
 }
+
+func TestKeeperPubKeyHistory(t *testing.T) {
+	ctx, k, cdc := CreateTestInput()
+	cdc.RegisterInterface((*exported.DidDoc)(nil), nil)
+	did := types.ValidDidDoc.GetDid()
+	newPubKey := "FkeDue5it82taeheMprdaPrctfK3DeVZ9hXVxpZMH5Ua"
+
+	err := k.SetDidDoc(ctx, &types.ValidDidDoc)
+	require.Nil(t, err)
+	require.Len(t, k.GetPubKeyRecords(ctx, did), 0)
+
+	// Rotate the pubKey at height 10
+	ctx = ctx.WithBlockHeight(10)
+	record := k.AddPubKeyRecord(ctx, did, types.ValidDidDoc.PubKey, "")
+	require.Equal(t, types.NewPubKeyRecord(did, 0, types.ValidDidDoc.PubKey, "", 0, 10), record)
+	didDoc := types.ValidDidDoc
+	didDoc.RotatePubKey(newPubKey, "", "")
+	k.AddDidDoc(ctx, didDoc)
+
+	// Rotate the pubKey back at height 20
+	ctx = ctx.WithBlockHeight(20)
+	record = k.AddPubKeyRecord(ctx, did, newPubKey, "")
+	require.Equal(t, types.NewPubKeyRecord(did, 1, newPubKey, "", 10, 20), record)
+	didDoc.RotatePubKey(types.ValidDidDoc.PubKey, "", "")
+	k.AddDidDoc(ctx, didDoc)
+
+	require.Len(t, k.GetPubKeyRecords(ctx, did), 2)
+	require.Len(t, k.GetPubKeyRecords(ctx, did+"2"), 0)
+
+	pubKeys, err := k.GetPubKeysAtHeight(ctx, did, 5)
+	require.Nil(t, err)
+	require.Equal(t, []string{types.ValidDidDoc.PubKey}, pubKeys)
+
+	pubKeys, err = k.GetPubKeysAtHeight(ctx, did, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{types.ValidDidDoc.PubKey, newPubKey}, pubKeys)
+
+	pubKeys, err = k.GetPubKeysAtHeight(ctx, did, 15)
+	require.Nil(t, err)
+	require.Equal(t, []string{newPubKey}, pubKeys)
+
+	pubKeys, err = k.GetPubKeysAtHeight(ctx, did, 25)
+	require.Nil(t, err)
+	require.Equal(t, []string{types.ValidDidDoc.PubKey}, pubKeys)
+
+	_, err = k.GetPubKeysAtHeight(ctx, types.EmptyDid, 25)
+	require.NotNil(t, err)
+}
+
+func TestKeeperMultisigDid(t *testing.T) {
+	ctx, k, cdc := CreateTestInput()
+	cdc.RegisterInterface((*exported.DidDoc)(nil), nil)
+	did := types.ValidDidDoc.GetDid()
+
+	// Three controllers, each with their own ixo DID keys, one of which is a
+	// secp256k1 key (ed25519 keys are regenerated until they are valid pubKeys,
+	// since some encode to fewer than 44 base58 characters)
+	var controllers []exported.IxoDid
+	var pubKeys []string
+	for i := 0; i < 2; i++ {
+		privKey := ed25519.GenPrivKey()
+		for !types.IsValidPubKey(base58.Encode(privKey[32:])) {
+			privKey = ed25519.GenPrivKey()
+		}
+		controller := exported.IxoDid{
+			VerifyKey: base58.Encode(privKey[32:]),
+			Secret:    exported.Secret{SignKey: base58.Encode(privKey[:32])},
+		}
+		controllers = append(controllers, controller)
+		pubKeys = append(pubKeys, controller.VerifyKey)
+	}
+	secpPrivKey := secp256k1.GenPrivKey()
+	secpPubKey := secpPrivKey.PubKey().(secp256k1.PubKeySecp256k1)
+	controllers = append(controllers, exported.IxoDid{
+		VerifyKey:  base58.Encode(secpPubKey[:]),
+		Secret:     exported.Secret{SignKey: base58.Encode(secpPrivKey[:])},
+		PubKeyType: exported.PubKeyTypeSecp256k1,
+	})
+	pubKeys = append(pubKeys, controllers[2].VerifyKey)
+	pubKeyTypes := []string{"", exported.PubKeyTypeEd25519, exported.PubKeyTypeSecp256k1}
+
+	require.Nil(t, types.ValidateMultisig(2, pubKeys, pubKeyTypes))
+	require.NotNil(t, types.ValidateMultisig(2, pubKeys, []string{"", "", "rsa"}))
+	require.NotNil(t, types.ValidateMultisig(2, pubKeys, pubKeyTypes[:2]))
+	require.NotNil(t, types.ValidateMultisig(0, pubKeys, pubKeyTypes))
+	require.NotNil(t, types.ValidateMultisig(4, pubKeys, pubKeyTypes))
+	require.NotNil(t, types.ValidateMultisig(1, []string{pubKeys[0], pubKeys[0]}, nil))
+	require.Nil(t, types.ValidateMultisig(1, pubKeys[:2], nil))
+
+	err := k.SetDidDoc(ctx, &types.ValidDidDoc)
+	require.Nil(t, err)
+
+	// Make the DID a 2-of-3 multisig DID
+	didDoc := types.ValidDidDoc
+	didDoc.SetMultisig(2, pubKeys, pubKeyTypes)
+	k.AddDidDoc(ctx, didDoc)
+
+	storedDoc, err := k.GetDidDoc(ctx, did)
+	require.Nil(t, err)
+	require.True(t, storedDoc.IsMultisig())
+	require.NotEqual(t, types.ValidDidDoc.Address(), storedDoc.Address())
+	require.Equal(t, storedDoc.GetSignerPubKey().Address().Bytes(), storedDoc.Address().Bytes())
+
+	initialPubKey, err := k.GetInitialPubKey(ctx, did)
+	require.Nil(t, err)
+	require.Equal(t, types.ValidDidDoc.PubKey, initialPubKey)
+
+	// Two of the controllers sign the tx
+	tx := auth.NewStdTx(nil, auth.NewStdFee(0, nil), nil, "")
+	signBytes := ixo.MultisigSignBytes("test-chain", 1, 0, tx)
+	var sigs []auth.StdSignature
+	for _, controller := range controllers[1:] {
+		sig, err := ixo.SignBytesWithIxoDid(signBytes, controller)
+		require.Nil(t, err)
+		sigs = append(sigs, sig)
+	}
+
+	// One signature is not enough, but two are
+	_, err2 := ixo.AssembleMultisigTx(tx, signBytes, storedDoc.GetSignerPubKey(), sigs[:1])
+	require.NotNil(t, err2)
+	signedTx, err2 := ixo.AssembleMultisigTx(tx, signBytes, storedDoc.GetSignerPubKey(), sigs)
+	require.Nil(t, err2)
+
+	stdSig := signedTx.GetSignatures()[0]
+	require.True(t, storedDoc.GetSignerPubKey().VerifyBytes(signBytes, stdSig.Signature))
+	require.False(t, storedDoc.GetSignerPubKey().VerifyBytes([]byte("other"), stdSig.Signature))
+
+	// A secp256k1 controller together with an ed25519 controller also suffices
+	sig, err2 := ixo.SignBytesWithIxoDid(signBytes, controllers[0])
+	require.Nil(t, err2)
+	_, err2 = ixo.AssembleMultisigTx(tx, signBytes, storedDoc.GetSignerPubKey(),
+		[]auth.StdSignature{sig, sigs[1]})
+	require.Nil(t, err2)
+
+	// All controllers are listed in the W3C DID document for authentication
+	w3cDoc := storedDoc.(types.BaseDidDoc).ToDidDocument(did)
+	require.Len(t, w3cDoc.VerificationMethod, 4)
+	require.Equal(t, []string{did + "#multisig-1", did + "#multisig-2", did + "#multisig-3"},
+		w3cDoc.Authentication)
+	require.Equal(t, types.Secp256k1VerificationKey, w3cDoc.VerificationMethod[3].Type)
+	require.Contains(t, w3cDoc.Context, types.Secp256k1Suite2019Context)
+
+	// Rotating the pubKey clears the controllers
+	rotatedDoc := didDoc
+	rotatedDoc.RotatePubKey(pubKeys[0], "", "")
+	require.False(t, rotatedDoc.IsMultisig())
+	require.Nil(t, rotatedDoc.MultisigPubKeyTypes)
+	require.Equal(t, exported.VerifyKeyToAddr(pubKeys[0]), rotatedDoc.Address())
+
+	// Clearing the controllers returns control to the pubKey
+	didDoc.SetMultisig(0, nil, nil)
+	require.False(t, didDoc.IsMultisig())
+	require.Equal(t, types.ValidDidDoc.Address(), didDoc.Address())
+}
+
+func TestKeeperTypedPubKeys(t *testing.T) {
+	ctx, k, cdc := CreateTestInput()
+	cdc.RegisterInterface((*exported.DidDoc)(nil), nil)
+
+	// Untyped pubKeys are ed25519 keys with the same address as before
+	edDoc := types.ValidDidDoc
+	require.Equal(t, exported.VerifyKeyToAddr(edDoc.PubKey), edDoc.Address())
+	require.True(t, types.IsValidTypedPubKey("", edDoc.PubKey))
+	require.False(t, types.IsValidTypedPubKey(exported.PubKeyTypeSecp256k1, edDoc.PubKey))
+	require.False(t, types.IsValidPubKeyType("rsa"))
+
+	// A DID with a secp256k1 key
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey().(secp256k1.PubKeySecp256k1)
+	ixoDid := exported.IxoDid{
+		Did:        "did:ixo:4XJLBfGtWSGKSz4BeRxdun",
+		VerifyKey:  base58.Encode(pubKey[:]),
+		Secret:     exported.Secret{SignKey: base58.Encode(privKey[:])},
+		PubKeyType: exported.PubKeyTypeSecp256k1,
+	}
+	require.True(t, types.IsValidTypedPubKey(ixoDid.PubKeyType, ixoDid.VerifyKey))
+
+	msg := types.NewMsgAddDid(ixoDid.Did, ixoDid.VerifyKey, ixoDid.PubKeyType)
+	require.Nil(t, msg.ValidateBasic())
+	require.NotNil(t, types.NewMsgAddDid(ixoDid.Did, edDoc.PubKey, ixoDid.PubKeyType).ValidateBasic())
+
+	secpDoc := types.NewBaseDidDoc(ixoDid.Did, ixoDid.VerifyKey)
+	secpDoc.PubKeyType = ixoDid.PubKeyType
+	err := k.SetDidDoc(ctx, secpDoc)
+	require.Nil(t, err)
+
+	storedDoc, err := k.GetDidDoc(ctx, ixoDid.Did)
+	require.Nil(t, err)
+	require.Equal(t, pubKey, storedDoc.GetSignerPubKey())
+	require.Equal(t, ixoDid.Address(), storedDoc.Address())
+	require.Equal(t, sdk.AccAddress(pubKey.Address()), storedDoc.Address())
+
+	// Signatures by the secp256k1 key verify against the DID's signer key
+	signBytes := []byte("sign bytes")
+	sig, err2 := ixo.SignBytesWithIxoDid(signBytes, ixoDid)
+	require.Nil(t, err2)
+	require.True(t, storedDoc.GetSignerPubKey().VerifyBytes(signBytes, sig.Signature))
+
+	// The key is resolved as a secp256k1 verification method
+	resolution := types.NewDidResolution(ixoDid.Did, storedDoc.(types.BaseDidDoc))
+	require.Equal(t, types.Secp256k1VerificationKey, resolution.DidDocument.VerificationMethod[0].Type)
+	require.Contains(t, resolution.DidDocument.Context, types.Secp256k1Suite2019Context)
+}
+
+func TestKeeperRotateDidKeyProof(t *testing.T) {
+	did := "did:ixo:U7GK8p8rVhJMKhBVRCJJ8c"
+
+	// The new key of a rotation
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey().(secp256k1.PubKeySecp256k1)
+	newIxoDid := exported.IxoDid{
+		Did:        did,
+		VerifyKey:  base58.Encode(pubKey[:]),
+		Secret:     exported.Secret{SignKey: base58.Encode(privKey[:])},
+		PubKeyType: exported.PubKeyTypeSecp256k1,
+	}
+
+	// A rotation signed by the new key is valid
+	msg, err := types.NewSignedMsgRotateDidKey(did, newIxoDid, "", false)
+	require.Nil(t, err)
+	require.Nil(t, msg.ValidateBasic())
+
+	// A rotation without a signature by the new key is invalid
+	unsigned := types.NewMsgRotateDidKey(did, newIxoDid.VerifyKey, newIxoDid.PubKeyType, "", false, nil)
+	require.NotNil(t, unsigned.ValidateBasic())
+
+	// A rotation signed by the new key cannot be used for another DID
+	otherDid := msg
+	otherDid.Did = "did:ixo:4XJLBfGtWSGKSz4BeRxdun"
+	require.NotNil(t, otherDid.ValidateBasic())
+
+	// A rotation to a key whose signature was made by another key is invalid
+	otherPrivKey := secp256k1.GenPrivKey()
+	otherSig, err := otherPrivKey.Sign(msg.GetNewPubKeyProofBytes())
+	require.Nil(t, err)
+	forged := msg
+	forged.NewPubKeySignature = otherSig
+	require.NotNil(t, forged.ValidateBasic())
+}
+
+func TestKeeperCredentials(t *testing.T) {
+	ctx, k, cdc := CreateTestInput()
+	cdc.RegisterInterface((*exported.DidDoc)(nil), nil)
+	ctx = ctx.WithBlockTime(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC))
+	did := types.ValidDidDoc.GetDid()
+	issuer1 := "did:ixo:U7GK8p8rVhJMKhBVRCJJ8c"
+	issuer2 := "did:ixo:4XJLBfGtWSGKSz4BeRxdun"
+
+	err := k.SetDidDoc(ctx, &types.ValidDidDoc)
+	require.Nil(t, err)
+
+	// Credentials with a single type can be added
+	cred1 := types.NewDidCredential("cred-1", []string{"Membership"}, issuer1,
+		"2020-01-01T00:00:00Z", "", did, "membership-v1", json.RawMessage(`{"level":"gold"}`))
+	require.Nil(t, types.ValidateCredential(cred1))
+	require.Nil(t, k.AddCredentials(ctx, did, cred1))
+	require.NotNil(t, k.AddCredentials(ctx, did, cred1))
+
+	// Expired credential from another issuer
+	cred2 := types.NewDidCredential("cred-1", []string{"Credential", "ProofOfKYC"}, issuer2,
+		"2020-01-01T00:00:00Z", "2020-02-01T00:00:00Z", did, "", nil)
+	require.Nil(t, types.ValidateCredential(cred2))
+	require.Nil(t, k.AddCredentials(ctx, did, cred2))
+
+	// Claim data requires a schema
+	cred3 := types.NewDidCredential("cred-3", []string{"Membership"}, issuer1,
+		"2020-01-01T00:00:00Z", "", did, "", json.RawMessage(`{"level":"gold"}`))
+	require.NotNil(t, types.ValidateCredential(cred3))
+
+	all, err := k.GetCredentials(ctx, did, types.CredentialFilter{})
+	require.Nil(t, err)
+	require.Len(t, all, 2)
+	require.True(t, all[0].IsValid())
+	require.True(t, all[1].Expired)
+
+	valid := true
+	credentials, err := k.GetCredentials(ctx, did, types.CredentialFilter{Valid: &valid})
+	require.Nil(t, err)
+	require.Len(t, credentials, 1)
+	require.Equal(t, cred1.Issuer, credentials[0].Credential.Issuer)
+
+	credentials, err = k.GetCredentials(ctx, did, types.CredentialFilter{CredType: "ProofOfKYC"})
+	require.Nil(t, err)
+	require.Len(t, credentials, 1)
+	require.Equal(t, issuer2, credentials[0].Credential.Issuer)
+
+	// Revoking a credential only affects the issuer's credential with that ID
+	_, err = k.GetCredential(ctx, did, issuer1, "cred-2")
+	require.NotNil(t, err)
+	_, err = k.GetCredential(ctx, did, issuer1, "cred-1")
+	require.Nil(t, err)
+	k.SetCredentialRevocation(ctx, types.NewCredentialRevocation(ctx, "cred-1", issuer1, did, "expelled"))
+	require.True(t, k.IsCredentialRevoked(ctx, issuer1, did, "cred-1"))
+	require.False(t, k.IsCredentialRevoked(ctx, issuer2, did, "cred-1"))
+
+	// ...and only the credential of that subject
+	otherSubject := "did:ixo:4XJLBfGtWSGKSz4BeRxdun"
+	require.False(t, k.IsCredentialRevoked(ctx, issuer1, otherSubject, "cred-1"))
+	require.Len(t, k.GetCredentialRevocations(ctx, issuer1), 1)
+	require.Len(t, k.GetCredentialRevocations(ctx, issuer2), 0)
+
+	credentials, err = k.GetCredentials(ctx, did, types.CredentialFilter{Issuer: issuer1})
+	require.Nil(t, err)
+	require.Len(t, credentials, 1)
+	require.True(t, credentials[0].Revoked)
+	require.False(t, credentials[0].IsValid())
+}
+
+func TestKeeperTrustedIssuers(t *testing.T) {
+	ctx, k, cdc := CreateTestInput()
+	cdc.RegisterInterface((*exported.DidDoc)(nil), nil)
+	ctx = ctx.WithBlockTime(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC))
+	did := types.ValidDidDoc.GetDid()
+	kycIssuer := "did:ixo:U7GK8p8rVhJMKhBVRCJJ8c"
+	otherIssuer := "did:ixo:4XJLBfGtWSGKSz4BeRxdun"
+
+	params := types.NewParams(types.TrustedIssuers{
+		types.NewTrustedIssuer(kycIssuer, []string{"ProofOfKYC"}),
+	})
+	require.Nil(t, types.ValidateParams(params))
+	k.SetParams(ctx, params)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	err := k.SetDidDoc(ctx, &types.ValidDidDoc)
+	require.Nil(t, err)
+
+	// Only the KYC issuer can issue KYC credentials, and nothing else
+	kycCred := types.NewDidCredential("kyc-1", []string{"Credential", "ProofOfKYC"},
+		kycIssuer, "2020-01-01T00:00:00Z", "2021-01-01T00:00:00Z", did, "", nil)
+	require.True(t, k.IsTrustedIssuer(ctx, kycCred))
+	otherCred := kycCred
+	otherCred.Issuer = otherIssuer
+	require.False(t, k.IsTrustedIssuer(ctx, otherCred))
+	memberCred := kycCred
+	memberCred.CredType = []string{"ProofOfKYC", "Membership"}
+	require.False(t, k.IsTrustedIssuer(ctx, memberCred))
+
+	// Credentials only count if valid and from an issuer that is still trusted
+	require.False(t, k.HasTrustedCredential(ctx, did, "ProofOfKYC"))
+	require.Nil(t, k.AddCredentials(ctx, did, otherCred))
+	require.False(t, k.HasTrustedCredential(ctx, did, "ProofOfKYC"))
+	require.Nil(t, k.AddCredentials(ctx, did, kycCred))
+	require.True(t, k.HasTrustedCredential(ctx, did, "ProofOfKYC"))
+	require.False(t, k.HasTrustedCredential(ctx, did, "Membership"))
+
+	require.False(t, k.HasTrustedCredential(ctx.WithBlockTime(
+		time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)), did, "ProofOfKYC"))
+
+	k.SetParams(ctx, types.DefaultParams())
+	require.False(t, k.HasTrustedCredential(ctx, did, "ProofOfKYC"))
+
+	k.SetParams(ctx, params)
+	k.SetCredentialRevocation(ctx, types.NewCredentialRevocation(ctx, "kyc-1", kycIssuer, did, ""))
+	require.False(t, k.HasTrustedCredential(ctx, did, "ProofOfKYC"))
+
+	// Duplicate trusted issuers are invalid
+	params.TrustedIssuers = append(params.TrustedIssuers, params.TrustedIssuers[0])
+	require.NotNil(t, types.ValidateParams(params))
+}
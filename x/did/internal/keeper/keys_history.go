@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+	"github.com/ixofoundation/ixo-blockchain/x/did/internal/types"
+)
+
+func (k Keeper) GetPubKeyRecordIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.PubKeyRecordKey)
+}
+
+func (k Keeper) GetPubKeyRecordIteratorByDid(ctx sdk.Context, did exported.Did) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetPubKeyRecordsPrefixKey(did))
+}
+
+func (k Keeper) MustGetPubKeyRecordByKey(ctx sdk.Context, key []byte) types.PubKeyRecord {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("pubKey record not found")
+	}
+
+	bz := store.Get(key)
+	var record types.PubKeyRecord
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &record)
+
+	return record
+}
+
+// GetPubKeyRecords returns the past pubKeys of a DID, oldest first.
+func (k Keeper) GetPubKeyRecords(ctx sdk.Context, did exported.Did) []types.PubKeyRecord {
+	iterator := k.GetPubKeyRecordIteratorByDid(ctx, did)
+	defer iterator.Close()
+
+	records := []types.PubKeyRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, k.MustGetPubKeyRecordByKey(ctx, iterator.Key()))
+	}
+
+	return records
+}
+
+func (k Keeper) GetAllPubKeyRecords(ctx sdk.Context) []types.PubKeyRecord {
+	iterator := k.GetPubKeyRecordIterator(ctx)
+	defer iterator.Close()
+
+	records := []types.PubKeyRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, k.MustGetPubKeyRecordByKey(ctx, iterator.Key()))
+	}
+
+	return records
+}
+
+func (k Keeper) SetPubKeyRecord(ctx sdk.Context, record types.PubKeyRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPubKeyRecordKey(record.Did, record.Index)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(record))
+}
+
+// AddPubKeyRecord records that the pubKey was used by the DID until the
+// current block height. The key is assumed to have been in use since the
+// previous rotation, or since genesis if the DID was never rotated before.
+func (k Keeper) AddPubKeyRecord(ctx sdk.Context, did exported.Did,
+	pubKey, pubKeyType string) types.PubKeyRecord {
+	records := k.GetPubKeyRecords(ctx, did)
+
+	var index uint64
+	var fromHeight int64
+	if len(records) > 0 {
+		last := records[len(records)-1]
+		index = last.Index + 1
+		fromHeight = last.ToHeight
+	}
+
+	record := types.NewPubKeyRecord(did, index, pubKey, pubKeyType, fromHeight, ctx.BlockHeight())
+	k.SetPubKeyRecord(ctx, record)
+
+	return record
+}
+
+// GetPubKeysAtHeight returns the pubKeys that the DID used at the height. This
+// is normally a single key, but at the height of a rotation it includes both
+// the old and the new key.
+func (k Keeper) GetPubKeysAtHeight(ctx sdk.Context, did exported.Did,
+	height int64) ([]string, sdk.Error) {
+	didDoc, err := k.GetDidDoc(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+
+	records := k.GetPubKeyRecords(ctx, did)
+
+	pubKeys := []string{}
+	for _, record := range records {
+		if record.WasActiveAt(height) {
+			pubKeys = append(pubKeys, record.PubKey)
+		}
+	}
+
+	// The current pubKey has been in use since the latest rotation
+	if len(records) == 0 || height >= records[len(records)-1].ToHeight {
+		pubKeys = append(pubKeys, didDoc.GetPubKey())
+	}
+
+	return pubKeys, nil
+}
+
+// GetInitialPubKey returns the pubKey that the DID was registered with, i.e.
+// the pubKey of its oldest record, or its current pubKey if never rotated.
+func (k Keeper) GetInitialPubKey(ctx sdk.Context, did exported.Did) (string, sdk.Error) {
+	didDoc, err := k.GetDidDoc(ctx, did)
+	if err != nil {
+		return "", err
+	}
+
+	records := k.GetPubKeyRecords(ctx, did)
+	if len(records) > 0 {
+		return records[0].PubKey, nil
+	}
+	return didDoc.GetPubKey(), nil
+}
@@ -3,9 +3,12 @@ package keeper
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+	"github.com/ixofoundation/ixo-blockchain/x/did/internal/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -13,6 +16,19 @@ const (
 	QueryDidDoc     = "queryDidDoc"
 	QueryAllDids    = "queryAllDids"
 	QueryAllDidDocs = "queryAllDidDocs"
+
+	QueryDidKeyHistory = "queryDidKeyHistory"
+	QueryResolveDid    = "queryResolveDid"
+
+	QueryDidCredentials        = "queryDidCredentials"
+	QueryCredentialRevocations = "queryCredentialRevocations"
+	QueryParams                = "queryParams"
+
+	QueryDidsByAddress = "queryDidsByAddress"
+	QueryDidsByIssuer  = "queryDidsByIssuer"
+
+	DefaultQueryPageLimit = 100
+	MaxQueryPageLimit     = 1000
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -21,9 +37,23 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case QueryDidDoc:
 			return queryDidDoc(ctx, path[1:], k)
 		case QueryAllDids:
-			return queryAllDids(ctx, k)
+			return queryAllDids(ctx, path[1:], k)
 		case QueryAllDidDocs:
-			return queryAllDidDocs(ctx, k)
+			return queryAllDidDocs(ctx, path[1:], k)
+		case QueryDidKeyHistory:
+			return queryDidKeyHistory(ctx, path[1:], k)
+		case QueryResolveDid:
+			return queryResolveDid(ctx, path[1:], k)
+		case QueryDidCredentials:
+			return queryDidCredentials(ctx, path[1:], k)
+		case QueryCredentialRevocations:
+			return queryCredentialRevocations(ctx, path[1:], k)
+		case QueryParams:
+			return queryParams(ctx, k)
+		case QueryDidsByAddress:
+			return queryDidsByAddress(ctx, path[1:], k)
+		case QueryDidsByIssuer:
+			return queryDidsByIssuer(ctx, path[1:], k)
 		default:
 			return nil, sdk.ErrUnknownRequest("Unknown did query endpoint")
 		}
@@ -44,10 +74,79 @@ func queryDidDoc(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
 	return res, nil
 }
 
-func queryAllDids(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
-	allDids := k.GetAddDids(ctx)
+// parseStartAndLimit parses the optional start DID and limit of the
+// paginated DID queries, which expect a path of the form [start-did, limit].
+func parseStartAndLimit(path []string) (startDid exported.Did, limit uint64, err sdk.Error) {
+	if len(path) > 0 {
+		startDid = path[0]
+	}
+
+	limit = DefaultQueryPageLimit
+	if len(path) > 1 && path[1] != "" {
+		var err error
+		limit, err = strconv.ParseUint(path[1], 10, 64)
+		if err != nil || limit == 0 {
+			return "", 0, sdk.ErrUnknownRequest(fmt.Sprintf(
+				"limit '%s' is not a valid positive integer", path[1]))
+		} else if limit > MaxQueryPageLimit {
+			limit = MaxQueryPageLimit
+		}
+	}
+
+	return startDid, limit, nil
+}
+
+func queryAllDids(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	startDid, limit, err := parseStartAndLimit(path)
+	if err != nil {
+		return nil, err
+	}
+
+	dids, nextStartDid := k.GetDidsPaginated(ctx, startDid, limit)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, types.QueryDidsResponse{
+		Dids:         dids,
+		NextStartDid: nextStartDid,
+	})
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryAllDidDocs(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	startDid, limit, err := parseStartAndLimit(path)
+	if err != nil {
+		return nil, err
+	}
+
+	didDocs, nextStartDid := k.GetDidDocsPaginated(ctx, startDid, limit)
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, types.QueryDidDocsResponse{
+		DidDocs:      didDocs,
+		NextStartDid: nextStartDid,
+	})
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryDidsByAddress(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("address not specified")
+	}
+
+	address, errAddr := sdk.AccAddressFromBech32(path[0])
+	if errAddr != nil {
+		return nil, sdk.ErrInvalidAddress(errAddr.Error())
+	}
+
+	dids := k.GetDidsByAddress(ctx, address)
 
-	res, errRes := json.Marshal(allDids)
+	res, errRes := codec.MarshalJSONIndent(k.cdc, dids)
 	if errRes != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
 	}
@@ -55,14 +154,119 @@ func queryAllDids(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	return res, nil
 }
 
-func queryAllDidDocs(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
-	var didDocs []exported.DidDoc
-	didDocs = k.GetAllDidDocs(ctx)
+func queryDidsByIssuer(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("issuer did not specified")
+	}
+
+	dids := k.GetDidsByIssuer(ctx, path[0])
 
-	res, errRes := json.Marshal(didDocs)
+	res, errRes := codec.MarshalJSONIndent(k.cdc, dids)
 	if errRes != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
 	}
 
 	return res, nil
 }
+
+func queryDidKeyHistory(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("did not specified")
+	}
+
+	_, err := k.GetDidDoc(ctx, path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	records := k.GetPubKeyRecords(ctx, path[0])
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, records)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryResolveDid(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("did not specified")
+	}
+
+	resolution, err := k.ResolveDid(ctx, path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON-LD documents are not amino-encoded, so that "@context" is kept
+	res, errRes := json.MarshalIndent(resolution, "", "  ")
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+// queryDidCredentials expects a path of the form [did, type, issuer, valid]
+// where the type, issuer and validity are optional filters that can also be
+// empty. The validity filter is either "true" or "false".
+func queryDidCredentials(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("did not specified")
+	}
+
+	var filter types.CredentialFilter
+	if len(path) > 1 {
+		filter.CredType = path[1]
+	}
+	if len(path) > 2 {
+		filter.Issuer = path[2]
+	}
+	if len(path) > 3 && path[3] != "" {
+		valid, err := strconv.ParseBool(path[3])
+		if err != nil {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf(
+				"validity '%s' is not a valid boolean", path[3]))
+		}
+		filter.Valid = &valid
+	}
+
+	credentials, err := k.GetCredentials(ctx, path[0], filter)
+	if err != nil {
+		return nil, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, credentials)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryCredentialRevocations(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("issuer did not specified")
+	}
+
+	revocations := k.GetCredentialRevocations(ctx, path[0])
+
+	res, err := codec.MarshalJSONIndent(k.cdc, revocations)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, params)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
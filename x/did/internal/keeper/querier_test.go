@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
 	"testing"
 
@@ -41,3 +42,132 @@ func TestQueryDidDocs(t *testing.T) {
 	_, _ = cdc.MarshalJSONIndent(b, "", " ")
 
 }
+
+func TestQueryResolveDid(t *testing.T) {
+	ctx, k, cdc := CreateTestInput()
+	cdc.RegisterInterface((*exported.DidDoc)(nil), nil)
+	querier := NewQuerier(k)
+	query := abci.RequestQuery{Path: "", Data: []byte{}}
+
+	did := "did:ixo:U7GK8p8rVhJMKhBVRCJJ8c"
+	didDoc := types.NewBaseDidDoc(did, types.ValidDidDoc.PubKey)
+	data := types.DidDocumentData{
+		Controllers: []exported.Did{"did:ixo:4XJLBfGtWSGKSz4BeRxdun"},
+		VerificationMethods: []types.VerificationMethod{
+			types.NewVerificationMethod("key-2", types.X25519KeyAgreementKey,
+				"", "FkeDue5it82taeheMprdaPrctfK3DeVZ9hXVxpZMH5Ua"),
+		},
+		KeyAgreement: []string{"key-2"},
+		Services: []types.Service{
+			types.NewService("agent", "DIDCommMessaging", "https://agent.ixo.world/endpoint"),
+		},
+	}
+	require.Nil(t, data.Validate())
+	didDoc.SetDocumentData(data)
+	k.AddDidDoc(ctx, didDoc)
+
+	res, err := querier(ctx, []string{QueryResolveDid, did}, query)
+	require.Nil(t, err)
+
+	var resolution types.DidResolution
+	require.Nil(t, json.Unmarshal(res, &resolution))
+	doc := resolution.DidDocument
+	require.Equal(t, did, doc.Id)
+	require.Equal(t, []string{types.DidCoreContext, types.Ed25519Suite2018Context,
+		types.X25519Suite2019Context}, doc.Context)
+	require.Equal(t, data.Controllers, doc.Controller)
+	require.Len(t, doc.VerificationMethod, 2)
+	require.Equal(t, did+"#key-1", doc.VerificationMethod[0].Id)
+	require.Equal(t, types.ValidDidDoc.PubKey, doc.VerificationMethod[0].PublicKeyBase58)
+	require.Equal(t, did, doc.VerificationMethod[1].Controller)
+	require.Equal(t, []string{did + "#key-1"}, doc.Authentication)
+	require.Equal(t, []string{did + "#key-2"}, doc.KeyAgreement)
+	require.Equal(t, did+"#agent", doc.Service[0].Id)
+	require.False(t, resolution.DidDocumentMetadata.Deactivated)
+
+	// The did:sov equivalent resolves to the same document
+	sovDid := "did:sov:U7GK8p8rVhJMKhBVRCJJ8c"
+	res, err = querier(ctx, []string{QueryResolveDid, sovDid}, query)
+	require.Nil(t, err)
+	require.Nil(t, json.Unmarshal(res, &resolution))
+	require.Equal(t, sovDid, resolution.DidDocument.Id)
+	require.Equal(t, []string{did}, resolution.DidDocument.AlsoKnownAs)
+
+	// Deactivated DIDs resolve without verification methods
+	didDoc.Deactivate()
+	k.AddDidDoc(ctx, didDoc)
+	res, err = querier(ctx, []string{QueryResolveDid, did}, query)
+	require.Nil(t, err)
+	require.Nil(t, json.Unmarshal(res, &resolution))
+	require.True(t, resolution.DidDocumentMetadata.Deactivated)
+	require.Len(t, resolution.DidDocument.VerificationMethod, 0)
+
+	_, err = querier(ctx, []string{QueryResolveDid, "did:ixo:4XJLBfGtWSGKSz4BeRxdun"}, query)
+	require.NotNil(t, err)
+
+	// Relationships cannot refer to unknown verification methods
+	data.Authentication = []string{"key-3"}
+	require.NotNil(t, data.Validate())
+}
+
+func TestQueryDidsPaginatedAndIndexed(t *testing.T) {
+	ctx, k, cdc := CreateTestInput()
+	cdc.RegisterInterface((*exported.DidDoc)(nil), nil)
+	querier := NewQuerier(k)
+	query := abci.RequestQuery{Path: "", Data: []byte{}}
+
+	issuer := "did:ixo:4XJLBfGtWSGKSz4BeRxdun"
+	dids := []exported.Did{
+		"did:ixo:4XJLBfGtWSGKSz4BeRxdun",
+		"did:ixo:U7GK8p8rVhJMKhBVRCJJ8c",
+		"did:sov:U7GK8p8rVhJMKhBVRCJJ8c",
+	}
+	for _, did := range dids {
+		k.AddDidDoc(ctx, types.NewBaseDidDoc(did, types.ValidDidDoc.PubKey))
+	}
+
+	// The first page ends where the second page starts
+	res, err := querier(ctx, []string{QueryAllDids, "", "2"}, query)
+	require.Nil(t, err)
+	var page types.QueryDidsResponse
+	require.Nil(t, cdc.UnmarshalJSON(res, &page))
+	require.Equal(t, dids[:2], page.Dids)
+	require.Equal(t, dids[2], page.NextStartDid)
+
+	res, err = querier(ctx, []string{QueryAllDidDocs, page.NextStartDid, "2"}, query)
+	require.Nil(t, err)
+	var docsPage types.QueryDidDocsResponse
+	require.Nil(t, cdc.UnmarshalJSON(res, &docsPage))
+	require.Len(t, docsPage.DidDocs, 1)
+	require.Equal(t, dids[2], docsPage.DidDocs[0].Did)
+	require.Equal(t, "", docsPage.NextStartDid)
+
+	_, err = querier(ctx, []string{QueryAllDids, "", "0"}, query)
+	require.NotNil(t, err)
+
+	// All three DIDs share a key, and therefore an address
+	address := types.ValidDidDoc.Address()
+	res, err = querier(ctx, []string{QueryDidsByAddress, address.String()}, query)
+	require.Nil(t, err)
+	var byAddress []exported.Did
+	require.Nil(t, cdc.UnmarshalJSON(res, &byAddress))
+	require.Equal(t, dids, byAddress)
+
+	// Rotating a DID's key moves it to the new address
+	didDoc := k.MustGetDidDoc(ctx, dids[1]).(types.BaseDidDoc)
+	didDoc.RotatePubKey("FkeDue5it82taeheMprdaPrctfK3DeVZ9hXVxpZMH5Ua", "", "")
+	k.AddDidDoc(ctx, didDoc)
+	require.Equal(t, []exported.Did{dids[0], dids[2]}, k.GetDidsByAddress(ctx, address))
+	require.Equal(t, []exported.Did{dids[1]}, k.GetDidsByAddress(ctx, didDoc.Address()))
+
+	// DIDs are indexed by the issuers of their credentials
+	cred := types.NewDidCredential("cred-1", []string{"Credential"}, issuer,
+		"2020-01-01T00:00:00Z", "", dids[2], "", nil)
+	require.Nil(t, k.AddCredentials(ctx, dids[2], cred))
+	res, err = querier(ctx, []string{QueryDidsByIssuer, issuer}, query)
+	require.Nil(t, err)
+	var byIssuer []exported.Did
+	require.Nil(t, cdc.UnmarshalJSON(res, &byIssuer))
+	require.Equal(t, []exported.Did{dids[2]}, byIssuer)
+	require.Len(t, k.GetDidsByIssuer(ctx, dids[1]), 0)
+}
@@ -8,6 +8,11 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgAddDid{}, "did/AddDid", nil)
 	cdc.RegisterConcrete(MsgAddCredential{}, "did/AddCredential", nil)
+	cdc.RegisterConcrete(MsgRotateDidKey{}, "did/RotateDidKey", nil)
+	cdc.RegisterConcrete(MsgDeactivateDid{}, "did/DeactivateDid", nil)
+	cdc.RegisterConcrete(MsgUpdateDidDoc{}, "did/UpdateDidDoc", nil)
+	cdc.RegisterConcrete(MsgRevokeCredential{}, "did/RevokeCredential", nil)
+	cdc.RegisterConcrete(MsgSetDidMultisig{}, "did/SetDidMultisig", nil)
 
 	cdc.RegisterInterface((*exported.DidDoc)(nil), nil)
 
@@ -0,0 +1,213 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+)
+
+const MaxCredentialIdLength = 256
+
+func NewDidCredential(id string, credTypes []string, issuer exported.Did,
+	issued, expires string, subjectDid exported.Did, schemaId string,
+	data json.RawMessage) exported.DidCredential {
+	return exported.DidCredential{
+		Id:       id,
+		CredType: credTypes,
+		Issuer:   issuer,
+		Issued:   issued,
+		Expires:  expires,
+		Claim: exported.Claim{
+			Id:       subjectDid,
+			SchemaId: schemaId,
+			Data:     data,
+		},
+	}
+}
+
+// ValidateCredentialId checks that a credential ID is non-empty and that it
+// does not contain whitespace or control characters, given that it is used
+// as part of the store keys of the revocation registry.
+func ValidateCredentialId(id string) sdk.Error {
+	if id == "" {
+		return ErrorInvalidCredentials(DefaultCodespace, "credential id should not be empty")
+	} else if len(id) > MaxCredentialIdLength {
+		return ErrorInvalidCredentials(DefaultCodespace, "credential id is too long")
+	}
+	for _, c := range id {
+		if c <= ' ' || c == 0x7f {
+			return ErrorInvalidCredentials(DefaultCodespace,
+				"credential id should not contain whitespace or control characters")
+		}
+	}
+	return nil
+}
+
+func ValidateCredential(cred exported.DidCredential) sdk.Error {
+	// Check that not empty
+	if strings.TrimSpace(cred.Claim.Id) == "" {
+		return ErrorInvalidDid(DefaultCodespace, "claim id should not be empty")
+	} else if strings.TrimSpace(cred.Issuer) == "" {
+		return ErrorInvalidIssuer(DefaultCodespace, "issuer should not be empty")
+	} else if len(cred.CredType) == 0 {
+		return ErrorInvalidCredentials(DefaultCodespace, "credential type should not be empty")
+	}
+
+	// Check that DID valid
+	if !IsValidDid(cred.Issuer) {
+		return ErrorInvalidDid(DefaultCodespace, "issuer did is invalid")
+	}
+
+	// Check that ID (if any) valid
+	if cred.Id != "" {
+		if err := ValidateCredentialId(cred.Id); err != nil {
+			return err
+		}
+	}
+
+	// Check that types valid
+	for _, credType := range cred.CredType {
+		if strings.TrimSpace(credType) == "" {
+			return ErrorInvalidCredentials(DefaultCodespace, "credential types should not be empty")
+		}
+	}
+
+	// Check that dates valid
+	issued, err := time.Parse(time.RFC3339, cred.Issued)
+	if err != nil {
+		return ErrorInvalidCredentials(DefaultCodespace, "issued date is not a valid RFC3339 date")
+	}
+	if cred.Expires != "" {
+		expires, err := time.Parse(time.RFC3339, cred.Expires)
+		if err != nil {
+			return ErrorInvalidCredentials(DefaultCodespace, "expiry date is not a valid RFC3339 date")
+		} else if !expires.After(issued) {
+			return ErrorInvalidCredentials(DefaultCodespace, "expiry date should be after issued date")
+		}
+	}
+
+	// Check that claim data (if any) is a JSON object with a schema
+	if len(cred.Claim.Data) != 0 {
+		var data map[string]json.RawMessage
+		if err := json.Unmarshal(cred.Claim.Data, &data); err != nil {
+			return ErrorInvalidCredentials(DefaultCodespace, "claim data should be a JSON object")
+		} else if strings.TrimSpace(cred.Claim.SchemaId) == "" {
+			return ErrorInvalidCredentials(DefaultCodespace, "claim data should have a schema id")
+		}
+	}
+
+	return nil
+}
+
+// CredentialHasType indicates whether the credential has the type.
+func CredentialHasType(cred exported.DidCredential, credType string) bool {
+	for _, t := range cred.CredType {
+		if t == credType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCredentialExpired indicates whether the credential has expired by the
+// time. Credentials with an unparseable expiry date are considered expired.
+func IsCredentialExpired(cred exported.DidCredential, t time.Time) bool {
+	if cred.Expires == "" {
+		return false
+	}
+
+	expires, err := time.Parse(time.RFC3339, cred.Expires)
+	if err != nil {
+		return true
+	}
+	return !t.Before(expires)
+}
+
+// IsSameCredential indicates whether two credentials are the same credential.
+// Credentials with IDs are the same if they are from the same issuer and have
+// the same ID. Otherwise, they are the same if they are from the same issuer,
+// have the same types, and make the same claim.
+func IsSameCredential(a, b exported.DidCredential) bool {
+	if a.Issuer != b.Issuer {
+		return false
+	} else if a.Id != "" || b.Id != "" {
+		return a.Id == b.Id
+	} else if len(a.CredType) != len(b.CredType) {
+		return false
+	}
+	for i := range a.CredType {
+		if a.CredType[i] != b.CredType[i] {
+			return false
+		}
+	}
+	return a.Claim.KYCValidated == b.Claim.KYCValidated &&
+		a.Claim.SchemaId == b.Claim.SchemaId &&
+		string(a.Claim.Data) == string(b.Claim.Data)
+}
+
+// CredentialRevocation is an entry in the revocation registry. Only the
+// issuer of a credential can revoke it, and revocations are permanent.
+type CredentialRevocation struct {
+	CredentialId string       `json:"credentialId" yaml:"credentialId"`
+	IssuerDid    exported.Did `json:"issuerDid" yaml:"issuerDid"`
+	SubjectDid   exported.Did `json:"subjectDid" yaml:"subjectDid"`
+	Reason       string       `json:"reason" yaml:"reason"`
+	Height       int64        `json:"height" yaml:"height"`
+	Time         time.Time    `json:"time" yaml:"time"`
+}
+
+func NewCredentialRevocation(ctx sdk.Context, credentialId string, issuerDid,
+	subjectDid exported.Did, reason string) CredentialRevocation {
+	return CredentialRevocation{
+		CredentialId: credentialId,
+		IssuerDid:    issuerDid,
+		SubjectDid:   subjectDid,
+		Reason:       reason,
+		Height:       ctx.BlockHeight(),
+		Time:         ctx.BlockTime(),
+	}
+}
+
+func (r CredentialRevocation) Validate() sdk.Error {
+	if err := ValidateCredentialId(r.CredentialId); err != nil {
+		return err
+	} else if !IsValidDid(r.IssuerDid) {
+		return ErrorInvalidDid(DefaultCodespace, "issuer did is invalid")
+	} else if strings.TrimSpace(r.SubjectDid) == "" {
+		return ErrorInvalidDid(DefaultCodespace, "subject did should not be empty")
+	}
+	return nil
+}
+
+// CredentialStatus is a credential along with whether it is still valid.
+type CredentialStatus struct {
+	Credential exported.DidCredential `json:"credential" yaml:"credential"`
+	Expired    bool                   `json:"expired" yaml:"expired"`
+	Revoked    bool                   `json:"revoked" yaml:"revoked"`
+}
+
+func (s CredentialStatus) IsValid() bool {
+	return !s.Expired && !s.Revoked
+}
+
+// CredentialFilter selects the credentials of a DID. Empty fields match any
+// credential, and a nil Valid matches both valid and invalid credentials.
+type CredentialFilter struct {
+	CredType string
+	Issuer   exported.Did
+	Valid    *bool
+}
+
+func (f CredentialFilter) Matches(status CredentialStatus) bool {
+	if f.CredType != "" && !CredentialHasType(status.Credential, f.CredType) {
+		return false
+	} else if f.Issuer != "" && status.Credential.Issuer != f.Issuer {
+		return false
+	} else if f.Valid != nil && status.IsValid() != *f.Valid {
+		return false
+	}
+	return true
+}
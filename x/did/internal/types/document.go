@@ -0,0 +1,333 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+)
+
+// W3C DID Core (https://www.w3.org/TR/did-core/) contexts, verification
+// method types and the IDs of the verification methods that hold the pubKeys.
+const (
+	DidCoreContext            = "https://www.w3.org/ns/did/v1"
+	Ed25519Suite2018Context   = "https://w3id.org/security/suites/ed25519-2018/v1"
+	X25519Suite2019Context    = "https://w3id.org/security/suites/x25519-2019/v1"
+	Secp256k1Suite2019Context = "https://w3id.org/security/suites/secp256k1-2019/v1"
+	DidResolutionContext      = "https://w3id.org/did-resolution/v1"
+	DidLdJsonContentType      = "application/did+ld+json"
+	Ed25519VerificationKey    = "Ed25519VerificationKey2018"
+	X25519KeyAgreementKey     = "X25519KeyAgreementKey2019"
+	Secp256k1VerificationKey  = "EcdsaSecp256k1VerificationKey2019"
+	PubKeyVerificationMethod  = "key-1"
+	MultisigMethodPrefix      = "multisig-"
+)
+
+var (
+	ValidDidFragment   = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,64}$`)
+	IsValidDidFragment = ValidDidFragment.MatchString
+
+	// Verification method types and the pubKey types of their keys
+	validVerificationMethodTypes = map[string]string{
+		Ed25519VerificationKey:   exported.PubKeyTypeEd25519,
+		X25519KeyAgreementKey:    exported.PubKeyTypeEd25519,
+		Secp256k1VerificationKey: exported.PubKeyTypeSecp256k1,
+	}
+)
+
+// VerificationMethod is a key, in addition to the pubKey, that can be used
+// for the purposes listed in the DID doc. The ID is a fragment of the DID,
+// i.e. a method "key-2" of "did:ixo:abc" is resolved as "did:ixo:abc#key-2".
+type VerificationMethod struct {
+	Id              string       `json:"id" yaml:"id"`
+	Type            string       `json:"type" yaml:"type"`
+	Controller      exported.Did `json:"controller" yaml:"controller"`
+	PublicKeyBase58 string       `json:"publicKeyBase58" yaml:"publicKeyBase58"`
+}
+
+func NewVerificationMethod(id, methodType string, controller exported.Did,
+	publicKeyBase58 string) VerificationMethod {
+	return VerificationMethod{
+		Id:              id,
+		Type:            methodType,
+		Controller:      controller,
+		PublicKeyBase58: publicKeyBase58,
+	}
+}
+
+// Service is an endpoint through which the DID subject can be interacted
+// with. Like verification methods, the ID is a fragment of the DID.
+type Service struct {
+	Id              string `json:"id" yaml:"id"`
+	Type            string `json:"type" yaml:"type"`
+	ServiceEndpoint string `json:"serviceEndpoint" yaml:"serviceEndpoint"`
+}
+
+func NewService(id, serviceType, serviceEndpoint string) Service {
+	return Service{
+		Id:              id,
+		Type:            serviceType,
+		ServiceEndpoint: serviceEndpoint,
+	}
+}
+
+// DidDocumentData is the part of a DID doc that its subject can freely
+// update. Verification relationships (authentication, assertionMethod and
+// keyAgreement) list the IDs of the verification methods that can be used
+// for each purpose. The pubKey is always available for assertions (and for
+// authentication, unless the DID is multisig) under the ID "key-1", and the
+// multisig pubKeys are available for authentication under the IDs
+// "multisig-1", "multisig-2", etc.
+type DidDocumentData struct {
+	Controllers         []exported.Did       `json:"controllers" yaml:"controllers"`
+	VerificationMethods []VerificationMethod `json:"verificationMethods" yaml:"verificationMethods"`
+	Authentication      []string             `json:"authentication" yaml:"authentication"`
+	AssertionMethod     []string             `json:"assertionMethod" yaml:"assertionMethod"`
+	KeyAgreement        []string             `json:"keyAgreement" yaml:"keyAgreement"`
+	Services            []Service            `json:"services" yaml:"services"`
+}
+
+func isValidBase58Key(key, pubKeyType string) bool {
+	if pubKeyType == exported.PubKeyTypeSecp256k1 {
+		return IsValidTypedPubKey(pubKeyType, key)
+	}
+	return len(base58.Decode(key)) == 32
+}
+
+func isValidServiceEndpoint(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	return err == nil && u.Scheme != "" && (u.Host != "" || u.Opaque != "")
+}
+
+func (data DidDocumentData) Validate() error {
+	for _, controller := range data.Controllers {
+		if !IsValidDid(controller) {
+			return fmt.Errorf("controller %s is not a valid did", controller)
+		}
+	}
+
+	methodIds := map[string]bool{PubKeyVerificationMethod: true}
+	for _, method := range data.VerificationMethods {
+		if !IsValidDidFragment(method.Id) {
+			return fmt.Errorf("verification method id '%s' is invalid", method.Id)
+		} else if methodIds[method.Id] || strings.HasPrefix(method.Id, MultisigMethodPrefix) {
+			return fmt.Errorf("verification method id '%s' is reserved or duplicate", method.Id)
+		} else if _, ok := validVerificationMethodTypes[method.Type]; !ok {
+			return fmt.Errorf("verification method type '%s' is not supported", method.Type)
+		} else if method.Controller != "" && !IsValidDid(method.Controller) {
+			return fmt.Errorf("verification method controller %s is not a valid did", method.Controller)
+		} else if !isValidBase58Key(method.PublicKeyBase58, validVerificationMethodTypes[method.Type]) {
+			return fmt.Errorf("verification method '%s' key is invalid", method.Id)
+		}
+		methodIds[method.Id] = true
+	}
+
+	relationships := map[string][]string{
+		"authentication":  data.Authentication,
+		"assertionMethod": data.AssertionMethod,
+		"keyAgreement":    data.KeyAgreement,
+	}
+	for name, ids := range relationships {
+		for _, id := range ids {
+			if !methodIds[id] {
+				return fmt.Errorf("%s refers to unknown verification method '%s'", name, id)
+			}
+		}
+	}
+
+	serviceIds := make(map[string]bool)
+	for _, service := range data.Services {
+		if !IsValidDidFragment(service.Id) {
+			return fmt.Errorf("service id '%s' is invalid", service.Id)
+		} else if serviceIds[service.Id] {
+			return fmt.Errorf("service id '%s' is duplicate", service.Id)
+		} else if strings.TrimSpace(service.Type) == "" {
+			return fmt.Errorf("service '%s' type is empty", service.Id)
+		} else if !isValidServiceEndpoint(service.ServiceEndpoint) {
+			return fmt.Errorf("service '%s' endpoint is not a valid URI", service.Id)
+		}
+		serviceIds[service.Id] = true
+	}
+
+	return nil
+}
+
+// DidDocument is the W3C DID Core JSON-LD representation of a DID doc.
+type DidDocument struct {
+	Context            []string                 `json:"@context"`
+	Id                 exported.Did             `json:"id"`
+	AlsoKnownAs        []string                 `json:"alsoKnownAs,omitempty"`
+	Controller         []exported.Did           `json:"controller,omitempty"`
+	VerificationMethod []DidDocumentMethod      `json:"verificationMethod"`
+	Authentication     []string                 `json:"authentication"`
+	AssertionMethod    []string                 `json:"assertionMethod"`
+	KeyAgreement       []string                 `json:"keyAgreement,omitempty"`
+	Service            []DidDocumentServiceItem `json:"service,omitempty"`
+}
+
+type DidDocumentMethod struct {
+	Id              string       `json:"id"`
+	Type            string       `json:"type"`
+	Controller      exported.Did `json:"controller"`
+	PublicKeyBase58 string       `json:"publicKeyBase58"`
+}
+
+type DidDocumentServiceItem struct {
+	Id              string `json:"id"`
+	Type            string `json:"type"`
+	ServiceEndpoint string `json:"serviceEndpoint"`
+}
+
+// DidResolution is the result of resolving a DID as defined by the W3C DID
+// Resolution spec, which is what DID resolvers (e.g. the Universal Resolver)
+// expect from a resolver driver.
+type DidResolution struct {
+	Context               string                `json:"@context"`
+	DidDocument           DidDocument           `json:"didDocument"`
+	DidResolutionMetadata DidResolutionMetadata `json:"didResolutionMetadata"`
+	DidDocumentMetadata   DidDocumentMetadata   `json:"didDocumentMetadata"`
+}
+
+type DidResolutionMetadata struct {
+	ContentType string `json:"contentType"`
+}
+
+type DidDocumentMetadata struct {
+	Deactivated bool `json:"deactivated"`
+}
+
+// GetAlternativeDid returns the DID with the same identifier under the other
+// supported DID method, i.e. did:sov:abc for did:ixo:abc and vice versa.
+func GetAlternativeDid(did exported.Did) (exported.Did, bool) {
+	if strings.HasPrefix(did, "did:ixo:") {
+		return "did:sov:" + strings.TrimPrefix(did, "did:ixo:"), true
+	} else if strings.HasPrefix(did, "did:sov:") {
+		return "did:ixo:" + strings.TrimPrefix(did, "did:sov:"), true
+	}
+	return "", false
+}
+
+// NewDidResolution resolves the DID doc as the requested DID, which can be
+// either the DID of the DID doc or its alternative DID.
+func NewDidResolution(requestedDid exported.Did, didDoc BaseDidDoc) DidResolution {
+	return DidResolution{
+		Context:     DidResolutionContext,
+		DidDocument: didDoc.ToDidDocument(requestedDid),
+		DidResolutionMetadata: DidResolutionMetadata{
+			ContentType: DidLdJsonContentType,
+		},
+		DidDocumentMetadata: DidDocumentMetadata{
+			Deactivated: didDoc.IsDeactivated(),
+		},
+	}
+}
+
+// ToDidDocument converts the DID doc to its W3C DID Core representation,
+// with the requested DID as the document's ID.
+func (dd BaseDidDoc) ToDidDocument(requestedDid exported.Did) DidDocument {
+	methodUrl := func(id string) string { return requestedDid + "#" + id }
+
+	doc := DidDocument{
+		Context:            []string{DidCoreContext, Ed25519Suite2018Context},
+		Id:                 requestedDid,
+		Controller:         dd.Controllers,
+		VerificationMethod: []DidDocumentMethod{},
+		Authentication:     []string{},
+		AssertionMethod:    []string{},
+	}
+	if requestedDid != dd.Did {
+		doc.AlsoKnownAs = []string{dd.Did}
+	}
+
+	// Deactivated DIDs have no usable verification methods or services
+	if dd.IsDeactivated() {
+		return doc
+	}
+
+	pubKeyMethodType := Ed25519VerificationKey
+	if dd.PubKeyType == exported.PubKeyTypeSecp256k1 {
+		pubKeyMethodType = Secp256k1VerificationKey
+	}
+	doc.VerificationMethod = append(doc.VerificationMethod, DidDocumentMethod{
+		Id:              methodUrl(PubKeyVerificationMethod),
+		Type:            pubKeyMethodType,
+		Controller:      requestedDid,
+		PublicKeyBase58: dd.PubKey,
+	})
+	doc.AssertionMethod = append(doc.AssertionMethod, methodUrl(PubKeyVerificationMethod))
+
+	// Transactions of a multisig DID are authenticated by its controllers
+	// rather than by the pubKey, so all of them are listed
+	usesSecp256k1 := pubKeyMethodType == Secp256k1VerificationKey
+	if !dd.IsMultisig() {
+		doc.Authentication = append(doc.Authentication, methodUrl(PubKeyVerificationMethod))
+	}
+	for i, pubKey := range dd.MultisigPubKeys {
+		methodType := Ed25519VerificationKey
+		if dd.GetMultisigPubKeyType(i) == exported.PubKeyTypeSecp256k1 {
+			methodType = Secp256k1VerificationKey
+			usesSecp256k1 = true
+		}
+		id := MultisigMethodPrefix + strconv.Itoa(i+1)
+		doc.VerificationMethod = append(doc.VerificationMethod, DidDocumentMethod{
+			Id:              methodUrl(id),
+			Type:            methodType,
+			Controller:      requestedDid,
+			PublicKeyBase58: pubKey,
+		})
+		doc.Authentication = append(doc.Authentication, methodUrl(id))
+	}
+
+	usesX25519 := false
+	for _, method := range dd.VerificationMethods {
+		controller := method.Controller
+		if controller == "" {
+			controller = requestedDid
+		}
+		if method.Type == X25519KeyAgreementKey {
+			usesX25519 = true
+		} else if method.Type == Secp256k1VerificationKey {
+			usesSecp256k1 = true
+		}
+		doc.VerificationMethod = append(doc.VerificationMethod, DidDocumentMethod{
+			Id:              methodUrl(method.Id),
+			Type:            method.Type,
+			Controller:      controller,
+			PublicKeyBase58: method.PublicKeyBase58,
+		})
+	}
+	if usesX25519 {
+		doc.Context = append(doc.Context, X25519Suite2019Context)
+	}
+	if usesSecp256k1 {
+		doc.Context = append(doc.Context, Secp256k1Suite2019Context)
+	}
+
+	for _, id := range dd.Authentication {
+		if id != PubKeyVerificationMethod {
+			doc.Authentication = append(doc.Authentication, methodUrl(id))
+		}
+	}
+	for _, id := range dd.AssertionMethod {
+		if id != PubKeyVerificationMethod {
+			doc.AssertionMethod = append(doc.AssertionMethod, methodUrl(id))
+		}
+	}
+	for _, id := range dd.KeyAgreement {
+		doc.KeyAgreement = append(doc.KeyAgreement, methodUrl(id))
+	}
+
+	for _, service := range dd.Services {
+		doc.Service = append(doc.Service, DidDocumentServiceItem{
+			Id:              methodUrl(service.Id),
+			Type:            service.Type,
+			ServiceEndpoint: service.ServiceEndpoint,
+		})
+	}
+
+	return doc
+}
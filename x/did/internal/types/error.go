@@ -1,15 +1,22 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
-	DefaultCodespace       sdk.CodespaceType = ModuleName
-	CodeInvalidDid                           = 201
-	CodeInvalidPubKey                        = 202
-	CodeInvalidIssuer                        = 203
-	CodeInvalidCredentials                   = 204
+	DefaultCodespace             sdk.CodespaceType = ModuleName
+	CodeInvalidDid                                 = 201
+	CodeInvalidPubKey                              = 202
+	CodeInvalidIssuer                              = 203
+	CodeInvalidCredentials                         = 204
+	CodeDidDeactivated                             = 205
+	CodeInvalidDidDocument                         = 206
+	CodeCredentialNotFound                         = 207
+	CodeCredentialAlreadyRevoked                   = 208
+	CodeUntrustedIssuer                            = 209
 )
 
 func ErrorInvalidDid(codeSpace sdk.CodespaceType, msg string) sdk.Error {
@@ -43,3 +50,26 @@ func ErrorInvalidCredentials(codeSpace sdk.CodespaceType, msg string) sdk.Error
 
 	return sdk.NewError(codeSpace, CodeInvalidCredentials, "Data already exist")
 }
+
+func ErrorDidDeactivated(codeSpace sdk.CodespaceType, did string) sdk.Error {
+	return sdk.NewError(codeSpace, CodeDidDeactivated, fmt.Sprintf("did %s is deactivated", did))
+}
+
+func ErrorInvalidDidDocument(codeSpace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codeSpace, CodeInvalidDidDocument, msg)
+}
+
+func ErrorCredentialNotFound(codeSpace sdk.CodespaceType, did, credentialId string) sdk.Error {
+	return sdk.NewError(codeSpace, CodeCredentialNotFound,
+		fmt.Sprintf("did %s has no credential %s", did, credentialId))
+}
+
+func ErrorCredentialAlreadyRevoked(codeSpace sdk.CodespaceType, credentialId string) sdk.Error {
+	return sdk.NewError(codeSpace, CodeCredentialAlreadyRevoked,
+		fmt.Sprintf("credential %s is already revoked", credentialId))
+}
+
+func ErrorUntrustedIssuer(codeSpace sdk.CodespaceType, issuerDid string) sdk.Error {
+	return sdk.NewError(codeSpace, CodeUntrustedIssuer,
+		fmt.Sprintf("issuer %s is not trusted to issue this credential", issuerDid))
+}
@@ -0,0 +1,19 @@
+package types
+
+const (
+	EventTypeRotateDidKey     = "rotate_did_key"
+	EventTypeDeactivateDid    = "deactivate_did"
+	EventTypeUpdateDidDoc     = "update_did_doc"
+	EventTypeRevokeCredential = "revoke_credential"
+	EventTypeSetDidMultisig   = "set_did_multisig"
+
+	AttributeKeyDid              = "did"
+	AttributeKeyPubKey           = "pub_key"
+	AttributeKeySignedByRecovery = "signed_by_recovery_key"
+	AttributeKeyIssuerDid        = "issuer_did"
+	AttributeKeyCredentialId     = "credential_id"
+	AttributeKeyThreshold        = "threshold"
+	AttributeKeyAddress          = "address"
+
+	AttributeValueCategory = ModuleName
+)
@@ -1,24 +1,59 @@
 package types
 
-import "github.com/ixofoundation/ixo-blockchain/x/did/exported"
+import (
+	"fmt"
+
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+)
 
 type GenesisState struct {
-	DidDocs []exported.DidDoc `json:"did_docs" yaml:"did_docs"`
+	DidDocs       []exported.DidDoc      `json:"did_docs" yaml:"did_docs"`
+	PubKeyRecords []PubKeyRecord         `json:"pub_key_records" yaml:"pub_key_records"`
+	Revocations   []CredentialRevocation `json:"revocations" yaml:"revocations"`
+	Params        Params                 `json:"params" yaml:"params"`
 }
 
-func NewGenesisState(didDocs []exported.DidDoc) GenesisState {
+func NewGenesisState(didDocs []exported.DidDoc, pubKeyRecords []PubKeyRecord,
+	revocations []CredentialRevocation, params Params) GenesisState {
 	return GenesisState{
-		DidDocs: didDocs,
+		DidDocs:       didDocs,
+		PubKeyRecords: pubKeyRecords,
+		Revocations:   revocations,
+		Params:        params,
 	}
 }
 
-//noinspection GoUnusedParameter
 func ValidateGenesis(data GenesisState) error {
+	if err := ValidateParams(data.Params); err != nil {
+		return err
+	}
+	for _, didDoc := range data.DidDocs {
+		if baseDidDoc, ok := didDoc.(BaseDidDoc); ok {
+			err := ValidateMultisig(baseDidDoc.MultisigThreshold,
+				baseDidDoc.MultisigPubKeys, baseDidDoc.MultisigPubKeyTypes)
+			if err != nil {
+				return fmt.Errorf("did %s: %s", baseDidDoc.Did, err)
+			}
+		}
+	}
+	for _, record := range data.PubKeyRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, revocation := range data.Revocations {
+		if err := revocation.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		DidDocs: nil,
+		DidDocs:       nil,
+		PubKeyRecords: nil,
+		Revocations:   nil,
+		Params:        DefaultParams(),
 	}
 }
@@ -1,16 +1,64 @@
 package types
 
-import "github.com/ixofoundation/ixo-blockchain/x/did/exported"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+)
 
 const (
-	ModuleName   = "did"
-	StoreKey     = ModuleName
-	RouterKey    = ModuleName
-	QuerierRoute = ModuleName
+	ModuleName        = "did"
+	DefaultParamspace = ModuleName
+	StoreKey          = ModuleName
+	RouterKey         = ModuleName
+	QuerierRoute      = ModuleName
 )
 
-var DidKey = []byte{0x01}
+var (
+	DidKey          = []byte{0x01}
+	PubKeyRecordKey = []byte{0x02}
+	RevocationKey   = []byte{0x03}
+
+	// Secondary indexes of DID docs by address and by credential issuer
+	AddressIndexKey = []byte{0x04}
+	IssuerIndexKey  = []byte{0x05}
+)
 
 func GetDidPrefixKey(did exported.Did) []byte {
 	return append(DidKey, []byte(did)...)
 }
+
+func GetPubKeyRecordsPrefixKey(did exported.Did) []byte {
+	return append(append(PubKeyRecordKey, []byte(did)...), 0x00)
+}
+
+func GetPubKeyRecordKey(did exported.Did, index uint64) []byte {
+	return append(GetPubKeyRecordsPrefixKey(did), sdk.Uint64ToBigEndian(index)...)
+}
+
+func GetCredentialRevocationsPrefixKey(issuerDid exported.Did) []byte {
+	return append(append(RevocationKey, []byte(issuerDid)...), 0x00)
+}
+
+// GetCredentialRevocationKey returns the key of the revocation of the
+// subject's credential, given that credential IDs are only unique per issuer
+// and subject.
+func GetCredentialRevocationKey(issuerDid, subjectDid exported.Did, credentialId string) []byte {
+	return append(append(append(GetCredentialRevocationsPrefixKey(issuerDid),
+		[]byte(subjectDid)...), 0x00), []byte(credentialId)...)
+}
+
+func GetAddressIndexPrefixKey(address sdk.AccAddress) []byte {
+	return append(AddressIndexKey, address.Bytes()...)
+}
+
+func GetAddressIndexKey(address sdk.AccAddress, did exported.Did) []byte {
+	return append(GetAddressIndexPrefixKey(address), []byte(did)...)
+}
+
+func GetIssuerIndexPrefixKey(issuerDid exported.Did) []byte {
+	return append(append(IssuerIndexKey, []byte(issuerDid)...), 0x00)
+}
+
+func GetIssuerIndexKey(issuerDid, did exported.Did) []byte {
+	return append(GetIssuerIndexPrefixKey(issuerDid), []byte(did)...)
+}
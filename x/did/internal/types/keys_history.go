@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+)
+
+// PubKeyRecord is a pubKey that a DID used before it was rotated. Records are
+// kept so that signatures made with past keys can still be attributed to the
+// DID. The key was in use from FromHeight up to and including ToHeight, the
+// height at which it was rotated.
+type PubKeyRecord struct {
+	Did        exported.Did `json:"did" yaml:"did"`
+	Index      uint64       `json:"index" yaml:"index"`
+	PubKey     string       `json:"pubKey" yaml:"pubKey"`
+	FromHeight int64        `json:"fromHeight" yaml:"fromHeight"`
+	ToHeight   int64        `json:"toHeight" yaml:"toHeight"`
+	PubKeyType string       `json:"pubKeyType" yaml:"pubKeyType"`
+}
+
+func NewPubKeyRecord(did exported.Did, index uint64, pubKey, pubKeyType string,
+	fromHeight, toHeight int64) PubKeyRecord {
+	return PubKeyRecord{
+		Did:        did,
+		Index:      index,
+		PubKey:     pubKey,
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+		PubKeyType: pubKeyType,
+	}
+}
+
+// WasActiveAt indicates whether the key was the DID's key at the height. At
+// the height of a rotation, both the old and the new key are considered active.
+func (r PubKeyRecord) WasActiveAt(height int64) bool {
+	return height >= r.FromHeight && height <= r.ToHeight
+}
+
+func (r PubKeyRecord) Validate() sdk.Error {
+	if !IsValidDid(r.Did) {
+		return ErrorInvalidDid(DefaultCodespace, "did is invalid")
+	} else if !IsValidTypedPubKey(r.PubKeyType, r.PubKey) {
+		return ErrorInvalidPubKey(DefaultCodespace, "pubKey is invalid")
+	} else if r.FromHeight > r.ToHeight {
+		return ErrorInvalidPubKey(DefaultCodespace, "pubKey record heights are invalid")
+	}
+	return nil
+}
@@ -12,22 +12,35 @@ import (
 const (
 	TypeMsgAddDid        = "add-did"
 	TypeMsgAddCredential = "add-credential"
+	TypeMsgRotateDidKey  = "rotate-did-key"
+	TypeMsgDeactivateDid = "deactivate-did"
+	TypeMsgUpdateDidDoc  = "update-did-doc"
+
+	TypeMsgRevokeCredential = "revoke-credential"
+	TypeMsgSetDidMultisig   = "set-did-multisig"
 )
 
 var (
 	_ ixo.IxoMsg = MsgAddDid{}
 	_ ixo.IxoMsg = MsgAddCredential{}
+	_ ixo.IxoMsg = MsgRotateDidKey{}
+	_ ixo.IxoMsg = MsgDeactivateDid{}
+	_ ixo.IxoMsg = MsgUpdateDidDoc{}
+	_ ixo.IxoMsg = MsgRevokeCredential{}
+	_ ixo.IxoMsg = MsgSetDidMultisig{}
 )
 
 type MsgAddDid struct {
-	Did    exported.Did `json:"did" yaml:"did"`
-	PubKey string       `json:"pubKey" yaml:"pubKey"`
+	Did        exported.Did `json:"did" yaml:"did"`
+	PubKey     string       `json:"pubKey" yaml:"pubKey"`
+	PubKeyType string       `json:"pubKeyType,omitempty" yaml:"pubKeyType"`
 }
 
-func NewMsgAddDid(did string, publicKey string) MsgAddDid {
+func NewMsgAddDid(did string, publicKey string, pubKeyType string) MsgAddDid {
 	return MsgAddDid{
-		Did:    did,
-		PubKey: publicKey,
+		Did:        did,
+		PubKey:     publicKey,
+		PubKeyType: pubKeyType,
 	}
 }
 
@@ -53,6 +66,14 @@ func (msg MsgAddDid) ValidateBasic() sdk.Error {
 		return ErrorInvalidDid(DefaultCodespace, "did is invalid")
 	}
 
+	// Check that pubKey type valid and that typed pubKeys are valid keys of
+	// the type (untyped pubKeys are accepted as before pubKeys were typed)
+	if !IsValidPubKeyType(msg.PubKeyType) {
+		return ErrorInvalidPubKey(DefaultCodespace, "pubKey type is not supported")
+	} else if msg.PubKeyType != "" && !IsValidTypedPubKey(msg.PubKeyType, msg.PubKey) {
+		return ErrorInvalidPubKey(DefaultCodespace, "pubKey is not a valid "+msg.PubKeyType+" key")
+	}
+
 	return nil
 }
 
@@ -61,7 +82,8 @@ func (msg MsgAddDid) GetSignBytes() []byte {
 }
 
 func (msg MsgAddDid) String() string {
-	return fmt.Sprintf("MsgAddDid{Did: %v, publicKey: %v}", msg.Did, msg.PubKey)
+	return fmt.Sprintf("MsgAddDid{Did: %v, publicKey: %v, pubKeyType: %v}",
+		msg.Did, msg.PubKey, msg.PubKeyType)
 }
 
 type MsgAddCredential struct {
@@ -84,6 +106,12 @@ func NewMsgAddCredential(did string, credType []string, issuer string, issued st
 	}
 }
 
+func NewMsgAddGenericCredential(credential exported.DidCredential) MsgAddCredential {
+	return MsgAddCredential{
+		DidCredential: credential,
+	}
+}
+
 func (msg MsgAddCredential) Type() string  { return TypeMsgAddCredential }
 func (msg MsgAddCredential) Route() string { return RouterKey }
 
@@ -98,21 +126,324 @@ func (msg MsgAddCredential) String() string {
 }
 
 func (msg MsgAddCredential) ValidateBasic() sdk.Error {
-	// Check if empty
-	if strings.TrimSpace(msg.DidCredential.Claim.Id) == "" {
-		return ErrorInvalidDid(DefaultCodespace, "claim id should not be empty")
-	} else if strings.TrimSpace(msg.DidCredential.Issuer) == "" {
+	return ValidateCredential(msg.DidCredential)
+}
+
+func (msg MsgAddCredential) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// MsgRotateDidKey replaces the pubKey of a DID, and optionally its recovery
+// pubKey. It is signed by the current pubKey or, if SignedByRecoveryKey is
+// set, by the recovery pubKey. Only the recovery pubKey can replace itself.
+// The new pubKey can be of another type, but recovery pubKeys are ed25519.
+// NewPubKeySignature is a signature by the new pubKey over the bytes from
+// GetNewPubKeyProofBytes, proving that the rotator holds the new key.
+type MsgRotateDidKey struct {
+	Did                 exported.Did `json:"did" yaml:"did"`
+	NewPubKey           string       `json:"newPubKey" yaml:"newPubKey"`
+	NewRecoveryPubKey   string       `json:"newRecoveryPubKey" yaml:"newRecoveryPubKey"`
+	SignedByRecoveryKey bool         `json:"signedByRecoveryKey" yaml:"signedByRecoveryKey"`
+	NewPubKeyType       string       `json:"newPubKeyType,omitempty" yaml:"newPubKeyType"`
+	NewPubKeySignature  []byte       `json:"newPubKeySignature,omitempty" yaml:"newPubKeySignature"`
+}
+
+func NewMsgRotateDidKey(did exported.Did, newPubKey, newPubKeyType, newRecoveryPubKey string,
+	signedByRecoveryKey bool, newPubKeySignature []byte) MsgRotateDidKey {
+	return MsgRotateDidKey{
+		Did:                 did,
+		NewPubKey:           newPubKey,
+		NewRecoveryPubKey:   newRecoveryPubKey,
+		SignedByRecoveryKey: signedByRecoveryKey,
+		NewPubKeyType:       newPubKeyType,
+		NewPubKeySignature:  newPubKeySignature,
+	}
+}
+
+// NewSignedMsgRotateDidKey creates a MsgRotateDidKey to the pubKey of
+// newIxoDid, signed by newIxoDid to prove that the rotator holds the new key.
+func NewSignedMsgRotateDidKey(did exported.Did, newIxoDid exported.IxoDid,
+	newRecoveryPubKey string, signedByRecoveryKey bool) (MsgRotateDidKey, error) {
+	msg := NewMsgRotateDidKey(did, newIxoDid.VerifyKey, newIxoDid.PubKeyType,
+		newRecoveryPubKey, signedByRecoveryKey, nil)
+
+	sig, err := ixo.SignBytesWithIxoDid(msg.GetNewPubKeyProofBytes(), newIxoDid)
+	if err != nil {
+		return MsgRotateDidKey{}, err
+	}
+	msg.NewPubKeySignature = sig.Signature
+
+	return msg, nil
+}
+
+// newPubKeyProof is what the new pubKey of a MsgRotateDidKey signs
+type newPubKeyProof struct {
+	Did           exported.Did `json:"did"`
+	NewPubKey     string       `json:"newPubKey"`
+	NewPubKeyType string       `json:"newPubKeyType,omitempty"`
+}
+
+// GetNewPubKeyProofBytes returns the bytes that the new pubKey signs to give
+// NewPubKeySignature. They bind the new pubKey to this DID only.
+func (msg MsgRotateDidKey) GetNewPubKeyProofBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(newPubKeyProof{
+		Did:           msg.Did,
+		NewPubKey:     msg.NewPubKey,
+		NewPubKeyType: msg.NewPubKeyType,
+	}))
+}
+
+func (msg MsgRotateDidKey) Type() string  { return TypeMsgRotateDidKey }
+func (msg MsgRotateDidKey) Route() string { return RouterKey }
+
+func (msg MsgRotateDidKey) GetSignerDid() exported.Did { return msg.Did }
+func (msg MsgRotateDidKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgRotateDidKey) ValidateBasic() sdk.Error {
+	// Check that not empty
+	if strings.TrimSpace(msg.Did) == "" {
+		return ErrorInvalidDid(DefaultCodespace, "did should not be empty")
+	} else if strings.TrimSpace(msg.NewPubKey) == "" {
+		return ErrorInvalidPubKey(DefaultCodespace, "new pubKey should not be empty")
+	}
+
+	// Check that DID and pubKeys valid
+	if !IsValidDid(msg.Did) {
+		return ErrorInvalidDid(DefaultCodespace, "did is invalid")
+	} else if !IsValidPubKeyType(msg.NewPubKeyType) {
+		return ErrorInvalidPubKey(DefaultCodespace, "new pubKey type is not supported")
+	} else if !IsValidTypedPubKey(msg.NewPubKeyType, msg.NewPubKey) {
+		return ErrorInvalidPubKey(DefaultCodespace, "new pubKey is invalid")
+	} else if msg.NewRecoveryPubKey != "" && !IsValidPubKey(msg.NewRecoveryPubKey) {
+		return ErrorInvalidPubKey(DefaultCodespace, "new recovery pubKey is invalid")
+	} else if msg.NewRecoveryPubKey == msg.NewPubKey {
+		return ErrorInvalidPubKey(DefaultCodespace, "recovery pubKey must differ from pubKey")
+	}
+
+	// Check that the new pubKey signed the rotation, so that a DID cannot be
+	// rotated to a pubKey (and thus an address) that the rotator does not hold
+	newPubKey := exported.TypedVerifyKeyToPubKey(msg.NewPubKeyType, msg.NewPubKey)
+	if len(msg.NewPubKeySignature) == 0 {
+		return ErrorInvalidPubKey(DefaultCodespace, "new pubKey signature should not be empty")
+	} else if !newPubKey.VerifyBytes(msg.GetNewPubKeyProofBytes(), msg.NewPubKeySignature) {
+		return ErrorInvalidPubKey(DefaultCodespace, "new pubKey signature is invalid")
+	}
+
+	return nil
+}
+
+func (msg MsgRotateDidKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRotateDidKey) String() string {
+	return fmt.Sprintf("MsgRotateDidKey{Did: %v, NewPubKey: %v, NewPubKeyType: %v, NewRecoveryPubKey: %v, SignedByRecoveryKey: %v}",
+		msg.Did, msg.NewPubKey, msg.NewPubKeyType, msg.NewRecoveryPubKey, msg.SignedByRecoveryKey)
+}
+
+// MsgDeactivateDid permanently deactivates a DID, after which it cannot sign
+// any transactions. It is signed by the current pubKey or, if
+// SignedByRecoveryKey is set, by the recovery pubKey.
+type MsgDeactivateDid struct {
+	Did                 exported.Did `json:"did" yaml:"did"`
+	SignedByRecoveryKey bool         `json:"signedByRecoveryKey" yaml:"signedByRecoveryKey"`
+}
+
+func NewMsgDeactivateDid(did exported.Did, signedByRecoveryKey bool) MsgDeactivateDid {
+	return MsgDeactivateDid{
+		Did:                 did,
+		SignedByRecoveryKey: signedByRecoveryKey,
+	}
+}
+
+func (msg MsgDeactivateDid) Type() string  { return TypeMsgDeactivateDid }
+func (msg MsgDeactivateDid) Route() string { return RouterKey }
+
+func (msg MsgDeactivateDid) GetSignerDid() exported.Did { return msg.Did }
+func (msg MsgDeactivateDid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgDeactivateDid) ValidateBasic() sdk.Error {
+	// Check that not empty
+	if strings.TrimSpace(msg.Did) == "" {
+		return ErrorInvalidDid(DefaultCodespace, "did should not be empty")
+	}
+
+	// Check that DID valid
+	if !IsValidDid(msg.Did) {
+		return ErrorInvalidDid(DefaultCodespace, "did is invalid")
+	}
+
+	return nil
+}
+
+func (msg MsgDeactivateDid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgDeactivateDid) String() string {
+	return fmt.Sprintf("MsgDeactivateDid{Did: %v, SignedByRecoveryKey: %v}",
+		msg.Did, msg.SignedByRecoveryKey)
+}
+
+// MsgUpdateDidDoc replaces the controllers, verification methods, verification
+// relationships and services of a DID doc. The pubKey cannot be changed using
+// this message (see MsgRotateDidKey).
+type MsgUpdateDidDoc struct {
+	Did  exported.Did    `json:"did" yaml:"did"`
+	Data DidDocumentData `json:"data" yaml:"data"`
+}
+
+func NewMsgUpdateDidDoc(did exported.Did, data DidDocumentData) MsgUpdateDidDoc {
+	return MsgUpdateDidDoc{
+		Did:  did,
+		Data: data,
+	}
+}
+
+func (msg MsgUpdateDidDoc) Type() string  { return TypeMsgUpdateDidDoc }
+func (msg MsgUpdateDidDoc) Route() string { return RouterKey }
+
+func (msg MsgUpdateDidDoc) GetSignerDid() exported.Did { return msg.Did }
+func (msg MsgUpdateDidDoc) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgUpdateDidDoc) ValidateBasic() sdk.Error {
+	// Check that not empty
+	if strings.TrimSpace(msg.Did) == "" {
+		return ErrorInvalidDid(DefaultCodespace, "did should not be empty")
+	}
+
+	// Check that DID valid
+	if !IsValidDid(msg.Did) {
+		return ErrorInvalidDid(DefaultCodespace, "did is invalid")
+	}
+
+	// Check that document data valid
+	if err := msg.Data.Validate(); err != nil {
+		return ErrorInvalidDidDocument(DefaultCodespace, err.Error())
+	}
+
+	return nil
+}
+
+func (msg MsgUpdateDidDoc) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUpdateDidDoc) String() string {
+	return fmt.Sprintf("MsgUpdateDidDoc{Did: %v, Data: %v}", msg.Did, msg.Data)
+}
+
+// MsgRevokeCredential adds a credential issued by the signer to the
+// revocation registry. Only credentials with an ID can be revoked.
+type MsgRevokeCredential struct {
+	IssuerDid    exported.Did `json:"issuerDid" yaml:"issuerDid"`
+	SubjectDid   exported.Did `json:"subjectDid" yaml:"subjectDid"`
+	CredentialId string       `json:"credentialId" yaml:"credentialId"`
+	Reason       string       `json:"reason" yaml:"reason"`
+}
+
+func NewMsgRevokeCredential(issuerDid, subjectDid exported.Did,
+	credentialId, reason string) MsgRevokeCredential {
+	return MsgRevokeCredential{
+		IssuerDid:    issuerDid,
+		SubjectDid:   subjectDid,
+		CredentialId: credentialId,
+		Reason:       reason,
+	}
+}
+
+func (msg MsgRevokeCredential) Type() string  { return TypeMsgRevokeCredential }
+func (msg MsgRevokeCredential) Route() string { return RouterKey }
+
+func (msg MsgRevokeCredential) GetSignerDid() exported.Did { return msg.IssuerDid }
+func (msg MsgRevokeCredential) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgRevokeCredential) ValidateBasic() sdk.Error {
+	// Check that not empty
+	if strings.TrimSpace(msg.IssuerDid) == "" {
 		return ErrorInvalidIssuer(DefaultCodespace, "issuer should not be empty")
+	} else if strings.TrimSpace(msg.SubjectDid) == "" {
+		return ErrorInvalidDid(DefaultCodespace, "subject did should not be empty")
 	}
 
 	// Check that DID valid
-	if !IsValidDid(msg.DidCredential.Issuer) {
+	if !IsValidDid(msg.IssuerDid) {
 		return ErrorInvalidDid(DefaultCodespace, "issuer did is invalid")
 	}
 
+	return ValidateCredentialId(msg.CredentialId)
+}
+
+func (msg MsgRevokeCredential) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRevokeCredential) String() string {
+	return fmt.Sprintf("MsgRevokeCredential{IssuerDid: %v, SubjectDid: %v, CredentialId: %v, Reason: %v}",
+		msg.IssuerDid, msg.SubjectDid, msg.CredentialId, msg.Reason)
+}
+
+// MsgSetDidMultisig makes a DID a multisig DID controlled by Threshold of the
+// PubKeys, or returns control to its pubKey if PubKeys is empty. It is signed
+// by the DID's current signer, and the DID's funds move to its new address.
+type MsgSetDidMultisig struct {
+	Did       exported.Did `json:"did" yaml:"did"`
+	Threshold uint64       `json:"threshold" yaml:"threshold"`
+	PubKeys   []string     `json:"pubKeys" yaml:"pubKeys"`
+	// PubKeyTypes are the types of the PubKeys, where none means all ed25519
+	PubKeyTypes []string `json:"pubKeyTypes,omitempty" yaml:"pubKeyTypes"`
+}
+
+func NewMsgSetDidMultisig(did exported.Did, threshold uint64,
+	pubKeys, pubKeyTypes []string) MsgSetDidMultisig {
+	return MsgSetDidMultisig{
+		Did:         did,
+		Threshold:   threshold,
+		PubKeys:     pubKeys,
+		PubKeyTypes: pubKeyTypes,
+	}
+}
+
+func (msg MsgSetDidMultisig) Type() string  { return TypeMsgSetDidMultisig }
+func (msg MsgSetDidMultisig) Route() string { return RouterKey }
+
+func (msg MsgSetDidMultisig) GetSignerDid() exported.Did { return msg.Did }
+func (msg MsgSetDidMultisig) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgSetDidMultisig) ValidateBasic() sdk.Error {
+	// Check that not empty
+	if strings.TrimSpace(msg.Did) == "" {
+		return ErrorInvalidDid(DefaultCodespace, "did should not be empty")
+	}
+
+	// Check that DID valid
+	if !IsValidDid(msg.Did) {
+		return ErrorInvalidDid(DefaultCodespace, "did is invalid")
+	}
+
+	// Check that threshold and pubKeys valid
+	if err := ValidateMultisig(msg.Threshold, msg.PubKeys, msg.PubKeyTypes); err != nil {
+		return ErrorInvalidPubKey(DefaultCodespace, err.Error())
+	}
+
 	return nil
 }
 
-func (msg MsgAddCredential) GetSignBytes() []byte {
+func (msg MsgSetDidMultisig) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
+
+func (msg MsgSetDidMultisig) String() string {
+	return fmt.Sprintf("MsgSetDidMultisig{Did: %v, Threshold: %v, PubKeys: %v, PubKeyTypes: %v}",
+		msg.Did, msg.Threshold, msg.PubKeys, msg.PubKeyTypes)
+}
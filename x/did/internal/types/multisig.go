@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+)
+
+// MaxMultisigPubKeys is the maximum size of the controller set of a multisig
+// DID, which bounds the gas needed to verify its signatures.
+const MaxMultisigPubKeys = 16
+
+// ValidateMultisig checks that threshold is between 1 and the number of
+// pubKeys, and that the pubKeys are valid keys of the pubKeyTypes and unique.
+// The pubKeyTypes are either empty (all ed25519) or one per pubKey. An empty
+// set of pubKeys (with a zero threshold) is valid and means that the DID is
+// not multisig.
+func ValidateMultisig(threshold uint64, pubKeys, pubKeyTypes []string) error {
+	if len(pubKeys) == 0 {
+		if threshold != 0 {
+			return fmt.Errorf("threshold should be 0 if there are no pubKeys")
+		} else if len(pubKeyTypes) != 0 {
+			return fmt.Errorf("pubKeyTypes should be empty if there are no pubKeys")
+		}
+		return nil
+	} else if len(pubKeys) > MaxMultisigPubKeys {
+		return fmt.Errorf("number of pubKeys should not exceed %d", MaxMultisigPubKeys)
+	} else if threshold == 0 || threshold > uint64(len(pubKeys)) {
+		return fmt.Errorf("threshold should be between 1 and the number of pubKeys")
+	} else if len(pubKeyTypes) != 0 && len(pubKeyTypes) != len(pubKeys) {
+		return fmt.Errorf("there should be either no pubKeyTypes or one per pubKey")
+	}
+
+	seen := make(map[string]bool)
+	for i, pubKey := range pubKeys {
+		pubKeyType := ""
+		if len(pubKeyTypes) != 0 {
+			pubKeyType = pubKeyTypes[i]
+		}
+
+		if !IsValidPubKeyType(pubKeyType) {
+			return fmt.Errorf("pubKeyType %s is invalid", pubKeyType)
+		} else if !IsValidTypedPubKey(pubKeyType, pubKey) {
+			return fmt.Errorf("pubKey %s is invalid", pubKey)
+		} else if seen[pubKey] {
+			return fmt.Errorf("pubKey %s is duplicate", pubKey)
+		}
+		seen[pubKey] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+)
+
+// Parameter store keys
+var (
+	KeyTrustedIssuers = []byte("TrustedIssuers")
+)
+
+// Credential types that every credential can have, and which therefore do
+// not need to be listed in a trusted issuer's credential types.
+var baseCredentialTypes = map[string]bool{
+	"Credential":           true,
+	"VerifiableCredential": true,
+}
+
+// TrustedIssuer is a DID that is allowed to issue credentials of the listed
+// types. The type "*" allows the issuer to issue credentials of any type.
+type TrustedIssuer struct {
+	IssuerDid       exported.Did `json:"issuer_did" yaml:"issuer_did"`
+	CredentialTypes []string     `json:"credential_types" yaml:"credential_types"`
+}
+
+func NewTrustedIssuer(issuerDid exported.Did, credentialTypes []string) TrustedIssuer {
+	return TrustedIssuer{
+		IssuerDid:       issuerDid,
+		CredentialTypes: credentialTypes,
+	}
+}
+
+// CanIssueType indicates whether the issuer is trusted for the credential type.
+func (ti TrustedIssuer) CanIssueType(credType string) bool {
+	if baseCredentialTypes[credType] {
+		return true
+	}
+	for _, t := range ti.CredentialTypes {
+		if t == "*" || t == credType {
+			return true
+		}
+	}
+	return false
+}
+
+// CanIssue indicates whether the issuer is trusted for all of the types of
+// the credential.
+func (ti TrustedIssuer) CanIssue(credential exported.DidCredential) bool {
+	if credential.Issuer != ti.IssuerDid {
+		return false
+	}
+	for _, credType := range credential.CredType {
+		if !ti.CanIssueType(credType) {
+			return false
+		}
+	}
+	return true
+}
+
+type TrustedIssuers []TrustedIssuer
+
+// Get returns the trusted issuer entry of the DID, if any.
+func (tis TrustedIssuers) Get(issuerDid exported.Did) (TrustedIssuer, bool) {
+	for _, ti := range tis {
+		if ti.IssuerDid == issuerDid {
+			return ti, true
+		}
+	}
+	return TrustedIssuer{}, false
+}
+
+func (tis TrustedIssuers) Validate() error {
+	seen := make(map[exported.Did]bool)
+	for _, ti := range tis {
+		if !IsValidDid(ti.IssuerDid) {
+			return fmt.Errorf("trusted issuer did %s is invalid", ti.IssuerDid)
+		} else if seen[ti.IssuerDid] {
+			return fmt.Errorf("trusted issuer %s is duplicate", ti.IssuerDid)
+		} else if len(ti.CredentialTypes) == 0 {
+			return fmt.Errorf("trusted issuer %s has no credential types", ti.IssuerDid)
+		}
+		for _, credType := range ti.CredentialTypes {
+			if strings.TrimSpace(credType) == "" {
+				return fmt.Errorf("trusted issuer %s has an empty credential type", ti.IssuerDid)
+			}
+		}
+		seen[ti.IssuerDid] = true
+	}
+	return nil
+}
+
+// did parameters
+type Params struct {
+	// The DIDs that are allowed to issue credentials and the types of
+	// credentials that they can issue. Can be changed through governance.
+	TrustedIssuers TrustedIssuers `json:"trusted_issuers" yaml:"trusted_issuers"`
+}
+
+// ParamTable for did module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(trustedIssuers TrustedIssuers) Params {
+	return Params{
+		TrustedIssuers: trustedIssuers,
+	}
+}
+
+// default did module parameters
+func DefaultParams() Params {
+	return Params{
+		TrustedIssuers: TrustedIssuers{}, // no trusted issuers
+	}
+}
+
+// validate params
+func ValidateParams(params Params) error {
+	return params.TrustedIssuers.Validate()
+}
+
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Did Params:\n  Trusted Issuers:\n")
+	for _, ti := range p.TrustedIssuers {
+		sb.WriteString(fmt.Sprintf("    %s: %s\n",
+			ti.IssuerDid, strings.Join(ti.CredentialTypes, ", ")))
+	}
+	return sb.String()
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyTrustedIssuers, Value: &p.TrustedIssuers},
+	}
+}
@@ -0,0 +1,17 @@
+package types
+
+import "github.com/ixofoundation/ixo-blockchain/x/did/exported"
+
+// QueryDidsResponse is a page of DIDs. NextStartDid is the first DID of the
+// next page, or empty if this is the last page.
+type QueryDidsResponse struct {
+	Dids         []exported.Did `json:"dids" yaml:"dids"`
+	NextStartDid exported.Did   `json:"nextStartDid" yaml:"nextStartDid"`
+}
+
+// QueryDidDocsResponse is a page of DID docs. NextStartDid is the DID of the
+// first DID doc of the next page, or empty if this is the last page.
+type QueryDidDocsResponse struct {
+	DidDocs      []BaseDidDoc `json:"didDocs" yaml:"didDocs"`
+	NextStartDid exported.Did `json:"nextStartDid" yaml:"nextStartDid"`
+}
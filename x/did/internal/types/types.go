@@ -4,8 +4,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/btcsuite/btcutil/base58"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"regexp"
 )
 
@@ -21,12 +25,57 @@ var (
 	//   possibly should just be `^did:(ixo:|sov:)([a-zA-Z0-9]){21,22}$`.
 )
 
+// IsValidPubKeyType indicates whether DID pubKeys can be of the type, where
+// an empty type means ed25519.
+func IsValidPubKeyType(pubKeyType string) bool {
+	switch pubKeyType {
+	case "", exported.PubKeyTypeEd25519, exported.PubKeyTypeSecp256k1:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValidTypedPubKey indicates whether the pubKey is a valid base58 key of the
+// type, i.e. a 32-byte ed25519 key or a 33-byte compressed secp256k1 key.
+func IsValidTypedPubKey(pubKeyType, pubKey string) bool {
+	switch pubKeyType {
+	case "", exported.PubKeyTypeEd25519:
+		return IsValidPubKey(pubKey)
+	case exported.PubKeyTypeSecp256k1:
+		bz := base58.Decode(pubKey)
+		return len(bz) == secp256k1.PubKeySecp256k1Size && (bz[0] == 0x02 || bz[0] == 0x03)
+	default:
+		return false
+	}
+}
+
 var _ exported.DidDoc = (*BaseDidDoc)(nil)
 
 type BaseDidDoc struct {
 	Did         exported.Did             `json:"did" yaml:"did"`
 	PubKey      string                   `json:"pubKey" yaml:"pubKey"`
 	Credentials []exported.DidCredential `json:"credentials" yaml:"credentials"`
+	// RecoveryPubKey (if any) can rotate the pubKey in case it is leaked
+	RecoveryPubKey string `json:"recoveryPubKey" yaml:"recoveryPubKey"`
+	// Deactivated DIDs cannot sign transactions and cannot be reactivated
+	Deactivated bool `json:"deactivated" yaml:"deactivated"`
+	// W3C DID Core document data (see DidDocumentData)
+	Controllers         []exported.Did       `json:"controllers" yaml:"controllers"`
+	VerificationMethods []VerificationMethod `json:"verificationMethods" yaml:"verificationMethods"`
+	Authentication      []string             `json:"authentication" yaml:"authentication"`
+	AssertionMethod     []string             `json:"assertionMethod" yaml:"assertionMethod"`
+	KeyAgreement        []string             `json:"keyAgreement" yaml:"keyAgreement"`
+	Services            []Service            `json:"services" yaml:"services"`
+	// Multisig DIDs are controlled by MultisigThreshold of the MultisigPubKeys
+	// rather than by the pubKey (see GetSignerPubKey)
+	MultisigThreshold uint64   `json:"multisigThreshold" yaml:"multisigThreshold"`
+	MultisigPubKeys   []string `json:"multisigPubKeys" yaml:"multisigPubKeys"`
+	// PubKeyType is the type of the pubKey, where an empty type means ed25519
+	PubKeyType string `json:"pubKeyType" yaml:"pubKeyType"`
+	// MultisigPubKeyTypes are the types of the MultisigPubKeys, where no types
+	// means that all of them are ed25519 (see GetMultisigPubKeyType)
+	MultisigPubKeyTypes []string `json:"multisigPubKeyTypes,omitempty" yaml:"multisigPubKeyTypes"`
 }
 
 func NewBaseDidDoc(did exported.Did, pubKey string) BaseDidDoc {
@@ -39,7 +88,11 @@ func NewBaseDidDoc(did exported.Did, pubKey string) BaseDidDoc {
 
 func (dd BaseDidDoc) GetDid() exported.Did                     { return dd.Did }
 func (dd BaseDidDoc) GetPubKey() string                        { return dd.PubKey }
+func (dd BaseDidDoc) GetPubKeyType() string                    { return dd.PubKeyType }
 func (dd BaseDidDoc) GetCredentials() []exported.DidCredential { return dd.Credentials }
+func (dd BaseDidDoc) GetRecoveryPubKey() string                { return dd.RecoveryPubKey }
+func (dd BaseDidDoc) IsDeactivated() bool                      { return dd.Deactivated }
+func (dd BaseDidDoc) IsMultisig() bool                         { return len(dd.MultisigPubKeys) != 0 }
 
 func (dd BaseDidDoc) SetDid(did exported.Did) error {
 	if len(dd.Did) != 0 {
@@ -61,8 +114,80 @@ func (dd BaseDidDoc) SetPubKey(pubKey string) error {
 	return nil
 }
 
+// RotatePubKey replaces the pubKey (which can be of another type), and the
+// recovery pubKey if a new one is specified, unlike SetPubKey which only sets
+// a pubKey that is not yet set. Any multisig is cleared so that the DID is
+// controlled by the new pubKey, since the controllers may be compromised.
+func (dd *BaseDidDoc) RotatePubKey(pubKey, pubKeyType, recoveryPubKey string) {
+	dd.PubKey = pubKey
+	dd.PubKeyType = pubKeyType
+	if recoveryPubKey != "" {
+		dd.RecoveryPubKey = recoveryPubKey
+	}
+	dd.SetMultisig(0, nil, nil)
+}
+
+func (dd BaseDidDoc) GetDocumentData() DidDocumentData {
+	return DidDocumentData{
+		Controllers:         dd.Controllers,
+		VerificationMethods: dd.VerificationMethods,
+		Authentication:      dd.Authentication,
+		AssertionMethod:     dd.AssertionMethod,
+		KeyAgreement:        dd.KeyAgreement,
+		Services:            dd.Services,
+	}
+}
+
+// SetDocumentData replaces the controllers, verification methods,
+// verification relationships and services of the DID doc.
+func (dd *BaseDidDoc) SetDocumentData(data DidDocumentData) {
+	dd.Controllers = data.Controllers
+	dd.VerificationMethods = data.VerificationMethods
+	dd.Authentication = data.Authentication
+	dd.AssertionMethod = data.AssertionMethod
+	dd.KeyAgreement = data.KeyAgreement
+	dd.Services = data.Services
+}
+
+// SetMultisig makes the DID a multisig DID controlled by threshold of the
+// pubKeys, which are of the pubKeyTypes (or all ed25519 if none are given).
+// An empty set of pubKeys returns control to the pubKey.
+func (dd *BaseDidDoc) SetMultisig(threshold uint64, pubKeys, pubKeyTypes []string) {
+	dd.MultisigThreshold = threshold
+	dd.MultisigPubKeys = pubKeys
+	dd.MultisigPubKeyTypes = pubKeyTypes
+}
+
+// GetMultisigPubKeyType returns the type of the i-th multisig pubKey, where an
+// empty type means ed25519.
+func (dd BaseDidDoc) GetMultisigPubKeyType(i int) string {
+	if i >= len(dd.MultisigPubKeyTypes) {
+		return ""
+	}
+	return dd.MultisigPubKeyTypes[i]
+}
+
+func (dd *BaseDidDoc) Deactivate() {
+	dd.Deactivated = true
+}
+
+// GetSignerPubKey returns the key that transactions signed by the DID are
+// verified against, i.e. the M-of-N threshold key of a multisig DID and the
+// (typed) pubKey otherwise.
+func (dd BaseDidDoc) GetSignerPubKey() crypto.PubKey {
+	if !dd.IsMultisig() {
+		return exported.TypedVerifyKeyToPubKey(dd.GetPubKeyType(), dd.GetPubKey())
+	}
+
+	pubKeys := make([]crypto.PubKey, len(dd.MultisigPubKeys))
+	for i, pubKey := range dd.MultisigPubKeys {
+		pubKeys[i] = exported.TypedVerifyKeyToPubKey(dd.GetMultisigPubKeyType(i), pubKey)
+	}
+	return multisig.NewPubKeyMultisigThreshold(int(dd.MultisigThreshold), pubKeys)
+}
+
 func (dd BaseDidDoc) Address() sdk.AccAddress {
-	return exported.VerifyKeyToAddr(dd.GetPubKey())
+	return sdk.AccAddress(dd.GetSignerPubKey().Address())
 }
 
 func (dd *BaseDidDoc) AddCredential(cred exported.DidCredential) {
@@ -8,6 +8,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -61,6 +63,14 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	didTxCmd.AddCommand(client.PostCommands(
 		cli.GetCmdAddDidDoc(cdc),
 		cli.GetCmdAddCredential(cdc),
+		cli.GetCmdRotateDidKey(cdc),
+		cli.GetCmdDeactivateDid(cdc),
+		cli.GetCmdUpdateDidDoc(cdc),
+		cli.GetCmdAddGenericCredential(cdc),
+		cli.GetCmdRevokeCredential(cdc),
+		cli.GetCmdSetDidMultisig(cdc),
+		cli.GetCmdSignMultisigTx(cdc),
+		cli.GetCmdMultisignTx(cdc),
 	)...)
 
 	return didTxCmd
@@ -81,6 +91,13 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		cli.GetCmdDidDoc(cdc),
 		cli.GetCmdAllDids(cdc),
 		cli.GetCmdAllDidDocs(cdc),
+		cli.GetCmdDidsByAddress(cdc),
+		cli.GetCmdDidsByIssuer(cdc),
+		cli.GetCmdDidKeyHistory(cdc),
+		cli.GetCmdResolveDid(cdc),
+		cli.GetCmdDidCredentials(cdc),
+		cli.GetCmdCredentialRevocations(cdc),
+		cli.GetCmdParams(cdc),
 	)...)
 
 	return didQueryCmd
@@ -88,13 +105,17 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 type AppModule struct {
 	AppModuleBasic
-	keeper keeper.Keeper
+	keeper        keeper.Keeper
+	accountKeeper auth.AccountKeeper
+	bankKeeper    bank.Keeper
 }
 
-func NewAppModule(keeper Keeper) AppModule {
+func NewAppModule(keeper Keeper, accountKeeper auth.AccountKeeper, bankKeeper bank.Keeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
 	}
 }
 
@@ -108,7 +129,9 @@ func (AppModule) Route() string {
 	return RouterKey
 }
 
-func (am AppModule) NewHandler() sdk.Handler { return NewHandler(am.keeper) }
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.keeper, am.accountKeeper, am.bankKeeper)
+}
 
 func (AppModule) QuerierRoute() string { return QuerierRoute }
 
@@ -22,6 +22,9 @@ var (
 	GenerateOrBroadcastMsgs          = types.GenerateOrBroadcastMsgs
 	CompleteAndBroadcastTxRest       = types.CompleteAndBroadcastTxRest
 	SignAndBroadcastTxFromStdSignMsg = types.SignAndBroadcastTxFromStdSignMsg
+	SignBytesWithIxoDid              = types.SignBytesWithIxoDid
+	MultisigSignBytes                = types.MultisigSignBytes
+	AssembleMultisigTx               = types.AssembleMultisigTx
 
 	// Types
 	IxoDecimals = types.IxoDecimals
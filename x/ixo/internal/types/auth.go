@@ -18,6 +18,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 	ed25519tm "github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"os"
 )
 
@@ -45,11 +46,12 @@ func NewDefaultPubKeyGetter(didKeeper DidKeeper) PubKeyGetter {
 		signerDidDoc, err := didKeeper.GetDidDoc(ctx, msg.GetSignerDid())
 		if err != nil {
 			return pubKey, err.Result()
+		} else if signerDidDoc.IsDeactivated() {
+			return pubKey, sdk.ErrUnauthorized(fmt.Sprintf(
+				"did %s is deactivated", signerDidDoc.GetDid())).Result()
 		}
 
-		var pubKeyRaw ed25519tm.PubKeyEd25519
-		copy(pubKeyRaw[:], base58.Decode(signerDidDoc.GetPubKey()))
-		return pubKeyRaw, sdk.Result{}
+		return signerDidDoc.GetSignerPubKey(), sdk.Result{}
 	}
 }
 
@@ -71,6 +73,55 @@ func consumeSimSigGas(gasmeter sdk.GasMeter, pubkey crypto.PubKey, sig auth.StdS
 	gasmeter.ConsumeGas(params.TxSizeCostPerByte*cost, "txSize")
 }
 
+// consumeSigVerificationGas charges for the verification of a signature by
+// an ed25519 or secp256k1 key or, for multisig DIDs, of each signature in a
+// multisignature. Simulated txs are charged as if all of a multisig's keys
+// had signed.
+func consumeSigVerificationGas(meter sdk.GasMeter, sig []byte, pubKey crypto.PubKey,
+	params auth.Params, simulate bool) sdk.Result {
+	switch pubKey := pubKey.(type) {
+	case multisig.PubKeyMultisigThreshold:
+		if simulate {
+			for _, subKey := range pubKey.PubKeys {
+				res := consumeSigVerificationGas(meter, nil, subKey, params, simulate)
+				if !res.IsOK() {
+					return res
+				}
+			}
+			return sdk.Result{}
+		}
+
+		var multisignature multisig.Multisignature
+		if err := ModuleCdc.UnmarshalBinaryBare(sig, &multisignature); err != nil {
+			return sdk.ErrUnauthorized("invalid multisignature").Result()
+		} else if multisignature.BitArray == nil ||
+			multisignature.BitArray.Size() != len(pubKey.PubKeys) {
+			return sdk.ErrUnauthorized("multisignature does not match multisig pubKey").Result()
+		}
+
+		sigIndex := 0
+		for i, subKey := range pubKey.PubKeys {
+			if !multisignature.BitArray.GetIndex(i) {
+				continue
+			} else if sigIndex >= len(multisignature.Sigs) {
+				return sdk.ErrUnauthorized("multisignature is missing signatures").Result()
+			}
+			res := consumeSigVerificationGas(meter, multisignature.Sigs[sigIndex], subKey, params, simulate)
+			if !res.IsOK() {
+				return res
+			}
+			sigIndex++
+		}
+		return sdk.Result{}
+	case secp256k1.PubKeySecp256k1:
+		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
+		return sdk.Result{}
+	default:
+		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
+		return sdk.Result{}
+	}
+}
+
 func ProcessSig(
 	ctx sdk.Context, acc auth.Account, sig auth.StdSignature, signBytes []byte, simulate bool, params auth.Params,
 ) (updatedAcc auth.Account, res sdk.Result) {
@@ -94,7 +145,9 @@ func ProcessSig(
 	}
 
 	// Consume signature gas
-	ctx.GasMeter().ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
+	if res := consumeSigVerificationGas(ctx.GasMeter(), sig.Signature, pubKey, params, simulate); !res.IsOK() {
+		return nil, res
+	}
 
 	// Verify signature
 	if !simulate && !pubKey.VerifyBytes(signBytes, sig.Signature) {
@@ -275,11 +328,7 @@ func Sign(cliCtx context.CLIContext, msg auth.StdSignMsg,
 		panic("expected one message")
 	}
 
-	var privateKey ed25519tm.PrivKeyEd25519
-	copy(privateKey[:], base58.Decode(ixoDid.Secret.SignKey))
-	copy(privateKey[32:], base58.Decode(ixoDid.VerifyKey))
-
-	sig, err := MakeSignature(msg.Bytes(), privateKey)
+	sig, err := SignBytesWithIxoDid(msg.Bytes(), ixoDid)
 	if err != nil {
 		return nil, err
 	}
@@ -418,8 +467,65 @@ func SignAndBroadcastTxFromStdSignMsg(cliCtx context.CLIContext,
 	return res, nil
 }
 
+// SignBytesWithIxoDid signs the bytes using the key of the ixo DID, which is
+// an ed25519 key unless the ixo DID specifies another pubKey type.
+func SignBytesWithIxoDid(signBytes []byte, ixoDid exported.IxoDid) (auth.StdSignature, error) {
+	switch ixoDid.PubKeyType {
+	case "", exported.PubKeyTypeEd25519:
+		var privateKey ed25519tm.PrivKeyEd25519
+		copy(privateKey[:], base58.Decode(ixoDid.Secret.SignKey))
+		copy(privateKey[32:], base58.Decode(ixoDid.VerifyKey))
+		return MakeSignature(signBytes, privateKey)
+	case exported.PubKeyTypeSecp256k1:
+		var privateKey secp256k1.PrivKeySecp256k1
+		copy(privateKey[:], base58.Decode(ixoDid.Secret.SignKey))
+		return MakeSignature(signBytes, privateKey)
+	default:
+		return auth.StdSignature{}, fmt.Errorf("unsupported pubKey type %s", ixoDid.PubKeyType)
+	}
+}
+
+// MultisigSignBytes returns the bytes that the controllers of a multisig DID
+// sign for the tx, given the account number and sequence of the DID's address.
+func MultisigSignBytes(chainID string, accNum, sequence uint64, tx auth.StdTx) []byte {
+	return auth.StdSignBytes(chainID, accNum, sequence, tx.Fee, tx.Msgs, tx.Memo)
+}
+
+// AssembleMultisigTx combines the signatures by (at least threshold of) the
+// keys of a multisig pubKey into a single multisignature for the tx. Each
+// signature is verified against the sign bytes before it is added.
+func AssembleMultisigTx(tx auth.StdTx, signBytes []byte, pubKey crypto.PubKey,
+	sigs []auth.StdSignature) (auth.StdTx, error) {
+	multisigPubKey, ok := pubKey.(multisig.PubKeyMultisigThreshold)
+	if !ok {
+		return auth.StdTx{}, fmt.Errorf("pubKey is not a multisig pubKey")
+	}
+
+	multisignature := multisig.NewMultisig(len(multisigPubKey.PubKeys))
+	for i, sig := range sigs {
+		if sig.PubKey == nil || !sig.PubKey.VerifyBytes(signBytes, sig.Signature) {
+			return auth.StdTx{}, fmt.Errorf("signature %d is invalid", i+1)
+		}
+		err := multisignature.AddSignatureFromPubKey(sig.Signature, sig.PubKey, multisigPubKey.PubKeys)
+		if err != nil {
+			return auth.StdTx{}, err
+		}
+	}
+
+	if uint(len(multisignature.Sigs)) < multisigPubKey.K {
+		return auth.StdTx{}, fmt.Errorf("got %d signatures but %d are required",
+			len(multisignature.Sigs), multisigPubKey.K)
+	}
+
+	stdSig := auth.StdSignature{
+		PubKey:    multisigPubKey,
+		Signature: multisignature.Marshal(),
+	}
+	return auth.NewStdTx(tx.Msgs, tx.Fee, []auth.StdSignature{stdSig}, tx.Memo), nil
+}
+
 func MakeSignature(signBytes []byte,
-	privateKey ed25519tm.PrivKeyEd25519) (auth.StdSignature, error) {
+	privateKey crypto.PrivKey) (auth.StdSignature, error) {
 	sig, err := privateKey.Sign(signBytes)
 	if err != nil {
 		return auth.StdSignature{}, err
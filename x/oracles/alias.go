@@ -6,14 +6,24 @@ import (
 )
 
 const (
-	ModuleName   = types.ModuleName
-	QuerierRoute = types.QuerierRoute
-	RouterKey    = types.RouterKey
-	StoreKey     = types.StoreKey
+	ModuleName        = types.ModuleName
+	DefaultParamspace = types.DefaultParamspace
+	QuerierRoute      = types.QuerierRoute
+	RouterKey         = types.RouterKey
+	StoreKey          = types.StoreKey
 
 	MintCap     = types.MintCap
 	BurnCap     = types.BurnCap
 	TransferCap = types.TransferCap
+	PriceCap    = types.PriceCap
+
+	OracleStatusActive    = types.OracleStatusActive
+	OracleStatusSuspended = types.OracleStatusSuspended
+
+	ProofSchemeNone         = types.ProofSchemeNone
+	ProofSchemeEd25519      = types.ProofSchemeEd25519
+	ProofSchemeMerkle       = types.ProofSchemeMerkle
+	ProofSchemeHashPreimage = types.ProofSchemeHashPreimage
 
 	DefaultCodespace = types.DefaultCodespace
 )
@@ -21,6 +31,7 @@ const (
 type (
 	Keeper       = keeper.Keeper
 	GenesisState = types.GenesisState
+	Params       = types.Params
 
 	Oracle          = types.Oracle
 	Oracles         = types.Oracles
@@ -28,6 +39,29 @@ type (
 	OracleTokenCaps = types.OracleTokenCaps
 	TokenCap        = types.TokenCap
 	TokenCaps       = types.TokenCaps
+	OracleStatus    = types.OracleStatus
+	OracleHistory   = types.OracleHistory
+	Price           = types.Price
+	Prices          = types.Prices
+
+	OracleTokenLimits = types.OracleTokenLimits
+	OracleTokenUsage  = types.OracleTokenUsage
+
+	ProofScheme    = types.ProofScheme
+	ProofStatement = types.ProofStatement
+	ProofVerifier  = types.ProofVerifier
+	VerifiedProof  = types.VerifiedProof
+	UsedProof      = types.UsedProof
+
+	MsgSubmitPrice   = types.MsgSubmitPrice
+	MsgAddOracle     = types.MsgAddOracle
+	MsgUpdateOracle  = types.MsgUpdateOracle
+	MsgSuspendOracle = types.MsgSuspendOracle
+	MsgResumeOracle  = types.MsgResumeOracle
+	MsgRemoveOracle  = types.MsgRemoveOracle
+
+	QueryOracleResponse      = types.QueryOracleResponse
+	QueryOracleUsageResponse = types.QueryOracleUsageResponse
 )
 
 var (
@@ -40,6 +74,30 @@ var (
 	DefaultGenesisState = types.DefaultGenesisState
 	ValidateGenesis     = types.ValidateGenesis
 
+	NewParams      = types.NewParams
+	DefaultParams  = types.DefaultParams
+	ValidateParams = types.ValidateParams
+
+	NewOracle            = types.NewOracle
+	ParseOracleTokenCaps = types.ParseOracleTokenCaps
+
+	NewPrice            = types.NewPrice
+	NewMsgSubmitPrice   = types.NewMsgSubmitPrice
+	NewMsgAddOracle     = types.NewMsgAddOracle
+	NewMsgUpdateOracle  = types.NewMsgUpdateOracle
+	NewMsgSuspendOracle = types.NewMsgSuspendOracle
+	NewMsgResumeOracle  = types.NewMsgResumeOracle
+	NewMsgRemoveOracle  = types.NewMsgRemoveOracle
+
+	NewOracleTokenLimits   = types.NewOracleTokenLimits
+	ParseOracleTokenLimits = types.ParseOracleTokenLimits
+
+	NewProofStatement = types.NewProofStatement
+
+	ErrOracleSuspended = types.ErrOracleSuspended
+	ErrLimitExceeded   = types.ErrLimitExceeded
+	ErrInvalidProof    = types.ErrInvalidProof
+
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
 )
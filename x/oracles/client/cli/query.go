@@ -1,11 +1,16 @@
 package cli
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/ixofoundation/ixo-blockchain/x/oracles/internal/keeper"
 	"github.com/ixofoundation/ixo-blockchain/x/oracles/internal/types"
@@ -14,7 +19,7 @@ import (
 func GetOraclesRequestHandler(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "get-oracles",
-		Short: "Query oracles",
+		Short: "Query oracles, including their status and history",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -24,7 +29,7 @@ func GetOraclesRequestHandler(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			var oracles types.Oracles
+			var oracles []types.QueryOracleResponse
 			if err := cdc.UnmarshalJSON(bz, &oracles); err != nil {
 				return err
 			}
@@ -34,3 +39,146 @@ func GetOraclesRequestHandler(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetCmdOracleHistory(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-oracle-history [oracle-did]",
+		Short: "Query the changes made to an oracle, including if it was removed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+				types.QuerierRoute, keeper.QueryOracleHistory, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var history types.OracleHistory
+			if err := cdc.UnmarshalJSON(bz, &history); err != nil {
+				return err
+			}
+
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+}
+
+func GetCmdOracleUsage(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-oracle-usage [oracle-did]",
+		Short: "Query the amounts used by an oracle and the headroom within its limits",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+				types.QuerierRoute, keeper.QueryOracleUsage, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var usage []types.QueryOracleUsageResponse
+			if err := cdc.UnmarshalJSON(bz, &usage); err != nil {
+				return err
+			}
+
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+}
+
+func GetCmdPrices(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "prices [denom] [reference-unit]",
+		Short: "Query the latest prices of a token submitted by each oracle",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s",
+				types.QuerierRoute, keeper.QueryPrices, args[0], args[1]), nil)
+			if err != nil {
+				return err
+			}
+
+			var prices types.Prices
+			if err := cdc.UnmarshalJSON(bz, &prices); err != nil {
+				return err
+			}
+
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+}
+
+func GetCmdParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query params, including the oracle admin DIDs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s",
+				types.QuerierRoute, keeper.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(bz, &params); err != nil {
+				return err
+			}
+
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+}
+
+func GetCmdProofStatement(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-proof-statement [operation] [oracle-did] [from-did] [to-did-or-addr] [amount] [nonce]",
+		Short: "Get the statement that backs an oracle's mint, burn or transfer",
+		Long: `Get the statement that backs an oracle's mint, burn or transfer,
+which is signed for the ed25519 proof scheme, and the SHA-256 hash of the
+statement, which is the Merkle tree leaf for the merkle proof scheme. The
+operation is mint, burn or transfer, on the chain with the chain ID. Use an empty from-did for mints and an
+empty to-did-or-addr for burns. The proof is then of the form nonce:signature
+(base64) or nonce:sibling[,sibling] (hex) respectively.`,
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			operation := types.TokenCap(args[0])
+			if operation != types.MintCap && operation != types.BurnCap &&
+				operation != types.TransferCap {
+				return fmt.Errorf("operation must be mint, burn or transfer")
+			}
+
+			amount, err := sdk.ParseCoins(args[4])
+			if err != nil {
+				return err
+			}
+
+			chainId := viper.GetString(flags.FlagChainID)
+			if chainId == "" {
+				return fmt.Errorf("chain ID must be specified using --%s", flags.FlagChainID)
+			}
+
+			statement := types.NewProofStatement(
+				args[1], operation, args[2], args[3], amount)
+			statement.Nonce = args[5]
+			statement.ChainId = chainId
+
+			signBytes := statement.GetSignBytes()
+			hash := sha256.Sum256(signBytes)
+
+			fmt.Println(string(signBytes))
+			fmt.Println(hex.EncodeToString(hash[:]))
+			return nil
+		},
+	}
+}
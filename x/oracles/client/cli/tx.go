@@ -0,0 +1,214 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/spf13/cobra"
+
+	"github.com/ixofoundation/ixo-blockchain/x/ixo"
+
+	"github.com/ixofoundation/ixo-blockchain/x/oracles/internal/types"
+)
+
+func GetCmdSubmitPrice(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "submit-price [denom] [reference-unit] [price] [oracle-ixo-did]",
+		Short: "Create and sign a submit-price tx using DIDs",
+		Long: `Create and sign a submit-price tx using DIDs. The price is the amount
+of the reference unit that one unit of the denom is worth, e.g. a price of
+0.05 for denom uixo and reference unit usd means that 1uixo is worth 0.05usd.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denom := args[0]
+			referenceUnit := args[1]
+			priceStr := args[2]
+			ixoDidStr := args[3]
+
+			price, err2 := sdk.NewDecFromStr(priceStr)
+			if err2 != nil {
+				return err2
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgSubmitPrice(denom, referenceUnit, price, ixoDid.Did)
+
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+}
+
+const (
+	FlagProofScheme = "proof-scheme"
+	FlagProofKey    = "proof-key"
+)
+
+func GetCmdAddOracle(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-oracle [oracle-did] [capability][,[capability]] [admin-ixo-did]",
+		Short: "Create and sign an add-oracle tx using DIDs",
+		Long: `Create and sign an add-oracle tx using DIDs. Capabilities are of
+the form denom:cap[/cap][:limits], e.g. uixo:mint/burn/transfer,res:price. The
+optional limits are of the form [tx=amount][/window=amount@blocks][/lifetime=amount]
+and apply to each capability separately, e.g. uixo:mint:tx=1000/window=5000@100
+limits minting to 1000uixo per transaction and 5000uixo in any 100 blocks. The
+signer must be one of the oracle admin DIDs in the oracles module params.
+
+The proof scheme determines how the proofs of the oracle's treasury operations
+are verified: none, ed25519 (the proof key is a base58 public key), merkle (the
+proof key is a hex Merkle root) or hash (the proof key is a hex SHA-256 hash).`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oracleDid := args[0]
+			capsStr := args[1]
+			ixoDidStr := args[2]
+
+			caps, err2 := types.ParseOracleTokenCaps(capsStr)
+			if err2 != nil {
+				return err2
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			proofScheme, _ := cmd.Flags().GetString(FlagProofScheme)
+			proofKey, _ := cmd.Flags().GetString(FlagProofKey)
+
+			msg := types.NewMsgAddOracle(oracleDid, caps,
+				types.ProofScheme(proofScheme), proofKey, ixoDid.Did)
+
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+
+	cmd.Flags().String(FlagProofScheme, string(types.ProofSchemeNone), "Proof scheme of the oracle (none, ed25519, merkle or hash)")
+	cmd.Flags().String(FlagProofKey, "", "Proof key of the oracle for the proof scheme")
+	return cmd
+}
+
+func GetCmdUpdateOracle(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-oracle [oracle-did] [capability][,[capability]] [admin-ixo-did]",
+		Short: "Create and sign an update-oracle tx using DIDs",
+		Long: `Create and sign an update-oracle tx using DIDs. The capabilities
+(and their limits) replace all of the oracle's existing capabilities. See
+add-oracle for the format of the capabilities and the proof schemes. The
+oracle's proof scheme and key are only replaced if a proof scheme is specified.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oracleDid := args[0]
+			capsStr := args[1]
+			ixoDidStr := args[2]
+
+			caps, err2 := types.ParseOracleTokenCaps(capsStr)
+			if err2 != nil {
+				return err2
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			proofScheme, _ := cmd.Flags().GetString(FlagProofScheme)
+			proofKey, _ := cmd.Flags().GetString(FlagProofKey)
+
+			msg := types.NewMsgUpdateOracle(oracleDid, caps,
+				types.ProofScheme(proofScheme), proofKey, ixoDid.Did)
+
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+
+	cmd.Flags().String(FlagProofScheme, "", "New proof scheme of the oracle (none, ed25519, merkle or hash)")
+	cmd.Flags().String(FlagProofKey, "", "New proof key of the oracle for the proof scheme")
+	return cmd
+}
+
+func GetCmdSuspendOracle(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "suspend-oracle [oracle-did] [admin-ixo-did]",
+		Short: "Create and sign a suspend-oracle tx using DIDs",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oracleDid := args[0]
+			ixoDidStr := args[1]
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgSuspendOracle(oracleDid, ixoDid.Did)
+
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+}
+
+func GetCmdResumeOracle(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "resume-oracle [oracle-did] [admin-ixo-did]",
+		Short: "Create and sign a resume-oracle tx using DIDs",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oracleDid := args[0]
+			ixoDidStr := args[1]
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgResumeOracle(oracleDid, ixoDid.Did)
+
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+}
+
+func GetCmdRemoveOracle(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-oracle [oracle-did] [admin-ixo-did]",
+		Short: "Create and sign a remove-oracle tx using DIDs",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oracleDid := args[0]
+			ixoDidStr := args[1]
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgRemoveOracle(oracleDid, ixoDid.Did)
+
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+}
@@ -14,6 +14,13 @@ import (
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/oracles", queryOraclesRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/oracles/history/{%s}", RestOracleDid),
+		queryOracleHistoryRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/oracles/usage/{%s}", RestOracleDid),
+		queryOracleUsageRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/oracles/params", queryParamsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/oracles/prices/{%s}/{%s}", RestDenom, RestReferenceUnit),
+		queryPricesRequestHandler(cliCtx)).Methods("GET")
 }
 
 func queryOraclesRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -27,7 +34,7 @@ func queryOraclesRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		var oracles types.Oracles
+		var oracles []types.QueryOracleResponse
 		if err := cliCtx.Codec.UnmarshalJSON(bz, &oracles); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't Unmarshal data %s", err.Error())))
@@ -37,3 +44,98 @@ func queryOraclesRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, oracles)
 	}
 }
+
+func queryOracleHistoryRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		oracleDid := vars[RestOracleDid]
+
+		bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryOracleHistory, oracleDid), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't get query data %s", err.Error())))
+			return
+		}
+
+		var history types.OracleHistory
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &history); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't Unmarshal data %s", err.Error())))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, history)
+	}
+}
+
+func queryOracleUsageRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		oracleDid := vars[RestOracleDid]
+
+		bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryOracleUsage, oracleDid), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't get query data %s", err.Error())))
+			return
+		}
+
+		var usage []types.QueryOracleUsageResponse
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &usage); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't Unmarshal data %s", err.Error())))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, usage)
+	}
+}
+
+func queryPricesRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		denom := vars[RestDenom]
+		referenceUnit := vars[RestReferenceUnit]
+
+		bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryPrices, denom, referenceUnit), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't get query data %s", err.Error())))
+			return
+		}
+
+		var prices types.Prices
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &prices); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't Unmarshal data %s", err.Error())))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, prices)
+	}
+}
+
+func queryParamsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s",
+			types.QuerierRoute, keeper.QueryParams), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't get query data %s", err.Error())))
+			return
+		}
+
+		var params types.Params
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't Unmarshal data %s", err.Error())))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, params)
+	}
+}
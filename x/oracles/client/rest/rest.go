@@ -5,6 +5,13 @@ import (
 	"github.com/gorilla/mux"
 )
 
+const (
+	RestDenom         = "denom"
+	RestReferenceUnit = "reference_unit"
+	RestOracleDid     = "oracle_did"
+)
+
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
+	registerTxRoutes(cliCtx, r)
 }
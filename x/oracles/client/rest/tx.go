@@ -0,0 +1,200 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/ixo"
+
+	"github.com/ixofoundation/ixo-blockchain/x/oracles/internal/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/oracles/submitPrice", submitPriceRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/oracles/addOracle", addOracleRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/oracles/updateOracle", updateOracleRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/oracles/suspendOracle", suspendOracleRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/oracles/resumeOracle", resumeOracleRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/oracles/removeOracle", removeOracleRequestHandler(cliCtx)).Methods("POST")
+}
+
+func submitPriceRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		denomParam := r.URL.Query().Get("denom")
+		referenceUnitParam := r.URL.Query().Get("referenceUnit")
+		priceParam := r.URL.Query().Get("price")
+		oracleDidParam := r.URL.Query().Get("oracleDid")
+
+		mode := r.URL.Query().Get("mode")
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		price, err2 := sdk.NewDecFromStr(priceParam)
+		if err2 != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err2.Error()))
+			return
+		}
+
+		oracleDid, err := did.UnmarshalIxoDid(oracleDidParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		msg := types.NewMsgSubmitPrice(
+			denomParam, referenceUnitParam, price, oracleDid.Did)
+
+		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, oracleDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func addOracleRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		oracleDidParam := r.URL.Query().Get("oracleDid")
+		capabilitiesParam := r.URL.Query().Get("capabilities")
+		proofSchemeParam := r.URL.Query().Get("proofScheme")
+		proofKeyParam := r.URL.Query().Get("proofKey")
+		adminDidParam := r.URL.Query().Get("adminDid")
+
+		mode := r.URL.Query().Get("mode")
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		caps, err2 := types.ParseOracleTokenCaps(capabilitiesParam)
+		if err2 != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err2.Error()))
+			return
+		}
+
+		adminDid, err := did.UnmarshalIxoDid(adminDidParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		msg := types.NewMsgAddOracle(oracleDidParam, caps,
+			types.ProofScheme(proofSchemeParam), proofKeyParam, adminDid.Did)
+
+		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, adminDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func updateOracleRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		oracleDidParam := r.URL.Query().Get("oracleDid")
+		capabilitiesParam := r.URL.Query().Get("capabilities")
+		proofSchemeParam := r.URL.Query().Get("proofScheme")
+		proofKeyParam := r.URL.Query().Get("proofKey")
+		adminDidParam := r.URL.Query().Get("adminDid")
+
+		mode := r.URL.Query().Get("mode")
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		caps, err2 := types.ParseOracleTokenCaps(capabilitiesParam)
+		if err2 != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err2.Error()))
+			return
+		}
+
+		adminDid, err := did.UnmarshalIxoDid(adminDidParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		msg := types.NewMsgUpdateOracle(oracleDidParam, caps,
+			types.ProofScheme(proofSchemeParam), proofKeyParam, adminDid.Did)
+
+		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, adminDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func suspendOracleRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return oracleAdminRequestHandler(cliCtx, func(oracleDid, adminDid did.Did) ixo.IxoMsg {
+		return types.NewMsgSuspendOracle(oracleDid, adminDid)
+	})
+}
+
+func resumeOracleRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return oracleAdminRequestHandler(cliCtx, func(oracleDid, adminDid did.Did) ixo.IxoMsg {
+		return types.NewMsgResumeOracle(oracleDid, adminDid)
+	})
+}
+
+func removeOracleRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return oracleAdminRequestHandler(cliCtx, func(oracleDid, adminDid did.Did) ixo.IxoMsg {
+		return types.NewMsgRemoveOracle(oracleDid, adminDid)
+	})
+}
+
+// oracleAdminRequestHandler handles requests that only specify the oracle DID
+// and the admin DID, using newMsg to create the message to be broadcast
+func oracleAdminRequestHandler(cliCtx context.CLIContext,
+	newMsg func(oracleDid, adminDid did.Did) ixo.IxoMsg) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		oracleDidParam := r.URL.Query().Get("oracleDid")
+		adminDidParam := r.URL.Query().Get("adminDid")
+
+		mode := r.URL.Query().Get("mode")
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		adminDid, err := did.UnmarshalIxoDid(adminDidParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		msg := newMsg(oracleDidParam, adminDid.Did)
+
+		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, adminDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
@@ -6,14 +6,48 @@ import (
 
 // InitGenesis new oracles genesis
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	// Initialise params
+	keeper.SetParams(ctx, data.Params)
+
 	// Initialise oracles
 	for _, o := range data.Oracles {
+		if o.Status == "" {
+			o.Status = OracleStatusActive
+		}
+		if o.ProofScheme == "" {
+			o.ProofScheme = ProofSchemeNone
+		}
 		keeper.SetOracle(ctx, o)
 	}
+
+	// Initialise prices
+	for _, p := range data.Prices {
+		keeper.SetPrice(ctx, p)
+	}
+
+	// Initialise oracle history
+	for _, e := range data.OracleHistory {
+		keeper.AddOracleHistoryEntry(ctx, e)
+	}
+
+	// Initialise oracle usage
+	for _, u := range data.OracleUsage {
+		keeper.SetOracleTokenUsage(ctx, u)
+	}
+
+	// Initialise used proofs
+	for _, p := range data.UsedProofs {
+		keeper.SetUsedProof(ctx, p)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	oracles := keeper.GetOracles(ctx)
-	return NewGenesisState(oracles)
+	prices := keeper.GetAllPrices(ctx)
+	oracleHistory := keeper.GetAllOracleHistory(ctx)
+	params := keeper.GetParams(ctx)
+	oracleUsage := keeper.GetAllOracleTokenUsage(ctx)
+	usedProofs := keeper.GetAllUsedProofs(ctx)
+	return NewGenesisState(oracles, prices, oracleHistory, params, oracleUsage, usedProofs)
 }
@@ -0,0 +1,231 @@
+package oracles
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles/internal/keeper"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles/internal/types"
+)
+
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case MsgSubmitPrice:
+			return handleMsgSubmitPrice(ctx, k, msg)
+		case MsgAddOracle:
+			return handleMsgAddOracle(ctx, k, msg)
+		case MsgUpdateOracle:
+			return handleMsgUpdateOracle(ctx, k, msg)
+		case MsgSuspendOracle:
+			return handleMsgSuspendOracle(ctx, k, msg)
+		case MsgResumeOracle:
+			return handleMsgResumeOracle(ctx, k, msg)
+		case MsgRemoveOracle:
+			return handleMsgRemoveOracle(ctx, k, msg)
+		default:
+			return sdk.ErrUnknownRequest("No match for message type.").Result()
+		}
+	}
+}
+
+func handleMsgSubmitPrice(ctx sdk.Context, k keeper.Keeper, msg types.MsgSubmitPrice) sdk.Result {
+
+	// Check that oracle exists
+	if !k.OracleExists(ctx, msg.OracleDid) {
+		return types.ErrInvalidOracle(types.DefaultCodespace,
+			"oracle is not a registered oracle").Result()
+	}
+
+	// Check that oracle is not suspended
+	oracle := k.MustGetOracle(ctx, msg.OracleDid)
+	if !oracle.IsActive() {
+		return types.ErrOracleSuspended(types.DefaultCodespace, msg.OracleDid).Result()
+	}
+
+	// Check that oracle has the price capability for the denom
+	if !oracle.Capabilities.Includes(msg.Denom) ||
+		!oracle.Capabilities.MustGet(msg.Denom).Capabilities.Includes(types.PriceCap) {
+		return types.ErrMissingOracleCapability(
+			types.DefaultCodespace, msg.Denom, types.PriceCap).Result()
+	}
+
+	// Store price, replacing the oracle's previous price
+	k.SetPrice(ctx, types.NewPrice(ctx, msg.Denom, msg.ReferenceUnit,
+		msg.Price, msg.OracleDid))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSubmitPrice,
+			sdk.NewAttribute(types.AttributeKeyOracleDid, msg.OracleDid),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyReferenceUnit, msg.ReferenceUnit),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgAddOracle(ctx sdk.Context, k keeper.Keeper, msg types.MsgAddOracle) sdk.Result {
+
+	// Check that signer is an oracles admin
+	if !k.GetParams(ctx).IsAdmin(msg.AdminDid) {
+		return types.ErrUnauthorizedDid(types.DefaultCodespace, msg.AdminDid).Result()
+	}
+
+	// Check that oracle does not already exist
+	if k.OracleExists(ctx, msg.OracleDid) {
+		return types.ErrInvalidOracle(types.DefaultCodespace,
+			"oracle is already a registered oracle").Result()
+	}
+
+	// Check that proof scheme is known and that proof key is valid for it
+	if err := k.ValidateProofScheme(msg.ProofScheme, msg.ProofKey); err != nil {
+		return err.Result()
+	}
+
+	oracle := types.NewOracle(msg.OracleDid, msg.Capabilities)
+	if !msg.ProofScheme.IsNone() {
+		oracle.ProofScheme = msg.ProofScheme
+		oracle.ProofKey = msg.ProofKey
+	}
+	k.SetOracle(ctx, oracle)
+	k.AddOracleHistoryEntry(ctx, types.NewOracleHistoryEntry(
+		ctx, oracle, types.OracleActionAdd, msg.AdminDid))
+
+	return oracleChangedResult(ctx, types.EventTypeAddOracle, oracle, msg.AdminDid)
+}
+
+func handleMsgUpdateOracle(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateOracle) sdk.Result {
+
+	// Check that signer is an oracles admin
+	if !k.GetParams(ctx).IsAdmin(msg.AdminDid) {
+		return types.ErrUnauthorizedDid(types.DefaultCodespace, msg.AdminDid).Result()
+	}
+
+	// Check that oracle exists
+	if !k.OracleExists(ctx, msg.OracleDid) {
+		return types.ErrInvalidOracle(types.DefaultCodespace,
+			"oracle is not a registered oracle").Result()
+	}
+
+	// Check that proof scheme (if any) is known and that proof key is valid
+	if msg.ProofScheme != "" {
+		if err := k.ValidateProofScheme(msg.ProofScheme, msg.ProofKey); err != nil {
+			return err.Result()
+		}
+	}
+
+	// Replace the oracle's capabilities, keeping its status, and its proof
+	// scheme unless a new one is specified
+	oracle := k.MustGetOracle(ctx, msg.OracleDid)
+	oracle.Capabilities = msg.Capabilities
+	if msg.ProofScheme != "" {
+		oracle.ProofScheme = msg.ProofScheme
+		oracle.ProofKey = msg.ProofKey
+	}
+	k.SetOracle(ctx, oracle)
+	k.AddOracleHistoryEntry(ctx, types.NewOracleHistoryEntry(
+		ctx, oracle, types.OracleActionUpdate, msg.AdminDid))
+
+	return oracleChangedResult(ctx, types.EventTypeUpdateOracle, oracle, msg.AdminDid)
+}
+
+func handleMsgSuspendOracle(ctx sdk.Context, k keeper.Keeper, msg types.MsgSuspendOracle) sdk.Result {
+
+	// Check that signer is an oracles admin
+	if !k.GetParams(ctx).IsAdmin(msg.AdminDid) {
+		return types.ErrUnauthorizedDid(types.DefaultCodespace, msg.AdminDid).Result()
+	}
+
+	// Check that oracle exists and is not already suspended
+	if !k.OracleExists(ctx, msg.OracleDid) {
+		return types.ErrInvalidOracle(types.DefaultCodespace,
+			"oracle is not a registered oracle").Result()
+	}
+	oracle := k.MustGetOracle(ctx, msg.OracleDid)
+	if !oracle.IsActive() {
+		return types.ErrOracleSuspended(types.DefaultCodespace, msg.OracleDid).Result()
+	}
+
+	oracle.Status = types.OracleStatusSuspended
+	k.SetOracle(ctx, oracle)
+	k.AddOracleHistoryEntry(ctx, types.NewOracleHistoryEntry(
+		ctx, oracle, types.OracleActionSuspend, msg.AdminDid))
+
+	return oracleChangedResult(ctx, types.EventTypeSuspendOracle, oracle, msg.AdminDid)
+}
+
+func handleMsgResumeOracle(ctx sdk.Context, k keeper.Keeper, msg types.MsgResumeOracle) sdk.Result {
+
+	// Check that signer is an oracles admin
+	if !k.GetParams(ctx).IsAdmin(msg.AdminDid) {
+		return types.ErrUnauthorizedDid(types.DefaultCodespace, msg.AdminDid).Result()
+	}
+
+	// Check that oracle exists and is suspended
+	if !k.OracleExists(ctx, msg.OracleDid) {
+		return types.ErrInvalidOracle(types.DefaultCodespace,
+			"oracle is not a registered oracle").Result()
+	}
+	oracle := k.MustGetOracle(ctx, msg.OracleDid)
+	if oracle.IsActive() {
+		return types.ErrInvalidOracle(types.DefaultCodespace,
+			"oracle is not suspended").Result()
+	}
+
+	oracle.Status = types.OracleStatusActive
+	k.SetOracle(ctx, oracle)
+	k.AddOracleHistoryEntry(ctx, types.NewOracleHistoryEntry(
+		ctx, oracle, types.OracleActionResume, msg.AdminDid))
+
+	return oracleChangedResult(ctx, types.EventTypeResumeOracle, oracle, msg.AdminDid)
+}
+
+func handleMsgRemoveOracle(ctx sdk.Context, k keeper.Keeper, msg types.MsgRemoveOracle) sdk.Result {
+
+	// Check that signer is an oracles admin
+	if !k.GetParams(ctx).IsAdmin(msg.AdminDid) {
+		return types.ErrUnauthorizedDid(types.DefaultCodespace, msg.AdminDid).Result()
+	}
+
+	// Check that oracle exists
+	if !k.OracleExists(ctx, msg.OracleDid) {
+		return types.ErrInvalidOracle(types.DefaultCodespace,
+			"oracle is not a registered oracle").Result()
+	}
+
+	// Remove oracle, recording its capabilities at the time of removal
+	oracle := k.MustGetOracle(ctx, msg.OracleDid)
+	k.RemoveOracle(ctx, msg.OracleDid)
+	k.AddOracleHistoryEntry(ctx, types.NewOracleHistoryEntry(
+		ctx, oracle, types.OracleActionRemove, msg.AdminDid))
+
+	return oracleChangedResult(ctx, types.EventTypeRemoveOracle, oracle, msg.AdminDid)
+}
+
+// oracleChangedResult emits the event for a change made to an oracle by an
+// admin and returns the result of the message
+func oracleChangedResult(ctx sdk.Context, eventType string,
+	oracle types.Oracle, adminDid did.Did) sdk.Result {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyOracleDid, oracle.OracleDid),
+			sdk.NewAttribute(types.AttributeKeyAdminDid, adminDid),
+			sdk.NewAttribute(types.AttributeKeyCapabilities, oracle.Capabilities.String()),
+			sdk.NewAttribute(types.AttributeKeyProofScheme, string(oracle.ProofScheme)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,135 @@
+package oracles
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles/internal/keeper"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles/internal/types"
+)
+
+const (
+	testAdminDid  = "did:ixo:4XJLBfGtWSGKSz4BeRxdun"
+	testOracleDid = "did:ixo:U7GK8p8rVhJMKhBVRCJJ8c"
+)
+
+func createHandlerTestInput(t *testing.T) (sdk.Context, keeper.Keeper, sdk.Handler, types.OracleTokenCaps) {
+	ctx, k, _ := keeper.CreateTestInput()
+	k.SetParams(ctx, types.NewParams([]did.Did{testAdminDid}))
+
+	caps, err := types.ParseOracleTokenCaps("uixo:price")
+	require.Nil(t, err)
+
+	return ctx, k, NewHandler(k), caps
+}
+
+func TestHandlerRejectsNonAdmins(t *testing.T) {
+	ctx, k, handler, caps := createHandlerTestInput(t)
+	nonAdminDid := testOracleDid
+
+	res := handler(ctx, types.NewMsgAddOracle(
+		testOracleDid, caps, types.ProofSchemeNone, "", nonAdminDid))
+	require.Equal(t, types.CodeUnauthorizedDid, res.Code)
+	require.False(t, k.OracleExists(ctx, testOracleDid))
+
+	res = handler(ctx, types.NewMsgAddOracle(
+		testOracleDid, caps, types.ProofSchemeNone, "", testAdminDid))
+	require.True(t, res.IsOK(), res.Log)
+
+	msgs := []sdk.Msg{
+		types.NewMsgUpdateOracle(testOracleDid, nil, "", "", nonAdminDid),
+		types.NewMsgSuspendOracle(testOracleDid, nonAdminDid),
+		types.NewMsgResumeOracle(testOracleDid, nonAdminDid),
+		types.NewMsgRemoveOracle(testOracleDid, nonAdminDid),
+	}
+	for _, msg := range msgs {
+		res = handler(ctx, msg)
+		require.Equal(t, types.CodeUnauthorizedDid, res.Code, msg.Type())
+	}
+
+	// The oracle and its history are unchanged
+	oracle := k.MustGetOracle(ctx, testOracleDid)
+	require.True(t, oracle.IsActive())
+	require.Equal(t, caps, oracle.Capabilities)
+	require.Len(t, k.GetOracleHistory(ctx, testOracleDid), 1)
+}
+
+func TestHandlerSuspendAndResumeOracle(t *testing.T) {
+	ctx, k, handler, caps := createHandlerTestInput(t)
+	res := handler(ctx, types.NewMsgAddOracle(
+		testOracleDid, caps, types.ProofSchemeNone, "", testAdminDid))
+	require.True(t, res.IsOK(), res.Log)
+
+	submitPrice := types.NewMsgSubmitPrice("uixo", "usd", sdk.NewDec(2), testOracleDid)
+	res = handler(ctx, submitPrice)
+	require.True(t, res.IsOK(), res.Log)
+	require.Len(t, k.GetPrices(ctx, "uixo", "usd"), 1)
+
+	// An active oracle cannot be resumed
+	res = handler(ctx, types.NewMsgResumeOracle(testOracleDid, testAdminDid))
+	require.Equal(t, types.CodeInvalidOracle, res.Code)
+
+	// A suspended oracle cannot submit prices, its existing price is ignored,
+	// and it cannot be suspended again
+	res = handler(ctx, types.NewMsgSuspendOracle(testOracleDid, testAdminDid))
+	require.True(t, res.IsOK(), res.Log)
+	require.False(t, k.MustGetOracle(ctx, testOracleDid).IsActive())
+	res = handler(ctx, submitPrice)
+	require.Equal(t, types.CodeOracleSuspended, res.Code)
+	require.Len(t, k.GetPrices(ctx, "uixo", "usd"), 0)
+	res = handler(ctx, types.NewMsgSuspendOracle(testOracleDid, testAdminDid))
+	require.Equal(t, types.CodeOracleSuspended, res.Code)
+
+	// A resumed oracle keeps its capabilities and can submit prices again
+	res = handler(ctx, types.NewMsgResumeOracle(testOracleDid, testAdminDid))
+	require.True(t, res.IsOK(), res.Log)
+	oracle := k.MustGetOracle(ctx, testOracleDid)
+	require.True(t, oracle.IsActive())
+	require.Equal(t, caps, oracle.Capabilities)
+	require.Len(t, k.GetPrices(ctx, "uixo", "usd"), 1)
+	res = handler(ctx, submitPrice)
+	require.True(t, res.IsOK(), res.Log)
+}
+
+func TestHandlerOracleHistoryOrdering(t *testing.T) {
+	ctx, k, handler, caps := createHandlerTestInput(t)
+	otherCaps, err := types.ParseOracleTokenCaps("uixo:price,uatom:price")
+	require.Nil(t, err)
+
+	msgs := []sdk.Msg{
+		types.NewMsgAddOracle(testOracleDid, caps, types.ProofSchemeNone, "", testAdminDid),
+		types.NewMsgUpdateOracle(testOracleDid, otherCaps, "", "", testAdminDid),
+		types.NewMsgSuspendOracle(testOracleDid, testAdminDid),
+		types.NewMsgResumeOracle(testOracleDid, testAdminDid),
+		types.NewMsgRemoveOracle(testOracleDid, testAdminDid),
+	}
+	start := time.Unix(1000, 0).UTC()
+	for i, msg := range msgs {
+		ctx = ctx.WithBlockHeight(int64(i + 1)).
+			WithBlockTime(start.Add(time.Duration(i) * time.Minute))
+		res := handler(ctx, msg)
+		require.True(t, res.IsOK(), res.Log)
+	}
+	require.False(t, k.OracleExists(ctx, testOracleDid))
+
+	// The history is kept after removal and is ordered oldest first
+	expectedActions := []types.OracleAction{
+		types.OracleActionAdd, types.OracleActionUpdate, types.OracleActionSuspend,
+		types.OracleActionResume, types.OracleActionRemove,
+	}
+	history := k.GetOracleHistory(ctx, testOracleDid)
+	require.Len(t, history, len(expectedActions))
+	for i, entry := range history {
+		require.Equal(t, expectedActions[i], entry.Action)
+		require.Equal(t, int64(i+1), entry.Height)
+		require.True(t, start.Add(time.Duration(i)*time.Minute).Equal(entry.Time))
+		require.Equal(t, testAdminDid, entry.AdminDid)
+	}
+	require.Equal(t, caps, history[0].Capabilities)
+	require.Equal(t, otherCaps, history[len(history)-1].Capabilities)
+	require.Equal(t, history, k.GetAllOracleHistory(ctx))
+}
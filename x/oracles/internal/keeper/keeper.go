@@ -2,22 +2,46 @@ package keeper
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/ixofoundation/ixo-blockchain/x/did"
 	"github.com/ixofoundation/ixo-blockchain/x/oracles/internal/types"
 )
 
 type Keeper struct {
-	cdc      *codec.Codec
-	storeKey sdk.StoreKey
+	cdc            *codec.Codec
+	storeKey       sdk.StoreKey
+	paramSpace     params.Subspace
+	proofVerifiers map[types.ProofScheme]types.ProofVerifier
 }
 
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey) Keeper {
-	return Keeper{
-		cdc:      cdc,
-		storeKey: key,
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace) Keeper {
+	k := Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		paramSpace:     paramSpace.WithKeyTable(types.ParamKeyTable()),
+		proofVerifiers: make(map[types.ProofScheme]types.ProofVerifier),
+	}
+
+	for scheme, verifier := range types.DefaultProofVerifiers() {
+		k.RegisterProofVerifier(scheme, verifier)
 	}
+
+	return k
+}
+
+// GetParams returns the total set of oracles parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of oracles parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
 }
 
 // GetOracles returns the list of registered oracles
@@ -62,3 +86,130 @@ func (k Keeper) SetOracle(ctx sdk.Context, oracle types.Oracle) {
 	key := types.GetOraclePrefixKey(oracle.OracleDid)
 	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(oracle))
 }
+
+// RemoveOracle deregisters an oracle and deletes the prices that it submitted.
+// The oracle's history is kept.
+func (k Keeper) RemoveOracle(ctx sdk.Context, oracleDid did.Did) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOraclePrefixKey(oracleDid))
+
+	// Collect the keys first, since the store cannot be modified while it is
+	// being iterated over
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, types.GetOraclePricesPrefixKey(oracleDid))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key(), iterator.Value())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetOracleHistory returns the changes made to an oracle by admins, oldest first
+func (k Keeper) GetOracleHistory(ctx sdk.Context, oracleDid did.Did) (history types.OracleHistory) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOracleHistoryKey(oracleDid))
+	if bz == nil {
+		return nil
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &history)
+	return history
+}
+
+// GetAllOracleHistory returns the history of all oracles, including removed ones
+func (k Keeper) GetAllOracleHistory(ctx sdk.Context) (history types.OracleHistory) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OracleHistoryKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var oracleHistory types.OracleHistory
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &oracleHistory)
+		history = append(history, oracleHistory...)
+	}
+
+	return history
+}
+
+// AddOracleHistoryEntry appends an entry to the history of its oracle
+func (k Keeper) AddOracleHistoryEntry(ctx sdk.Context, entry types.OracleHistoryEntry) {
+	history := append(k.GetOracleHistory(ctx, entry.OracleDid), entry)
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetOracleHistoryKey(entry.OracleDid)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(history))
+}
+
+// GetAllPrices returns the latest prices submitted by all oracles, including
+// oracles that have since been suspended or lost the price capability
+func (k Keeper) GetAllPrices(ctx sdk.Context) (prices types.Prices) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PriceKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.Price
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+
+	return prices
+}
+
+// GetPrices returns the latest price of a token in a reference unit submitted
+// by each of the oracles that have submitted such a price. Only prices of
+// oracles that are registered, active and can currently submit prices of the
+// token are returned.
+func (k Keeper) GetPrices(ctx sdk.Context, denom, referenceUnit string) (prices types.Prices) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store,
+		types.GetPricesPrefixKey(denom, referenceUnit))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.Price
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &price)
+		if k.CanSubmitPrice(ctx, price.OracleDid, price.Denom) {
+			prices = append(prices, price)
+		}
+	}
+
+	return prices
+}
+
+// CanSubmitPrice indicates whether the oracle is registered, is active and
+// has the price capability for the token
+func (k Keeper) CanSubmitPrice(ctx sdk.Context, oracleDid did.Did, denom string) bool {
+	if !k.OracleExists(ctx, oracleDid) {
+		return false
+	}
+
+	oracle := k.MustGetOracle(ctx, oracleDid)
+	return oracle.IsActive() && oracle.Capabilities.Includes(denom) &&
+		oracle.Capabilities.MustGet(denom).Capabilities.Includes(types.PriceCap)
+}
+
+// GetFreshPrices returns the prices of a token in a reference unit that were
+// submitted no longer than maxAge ago, or an error if there are none
+func (k Keeper) GetFreshPrices(ctx sdk.Context, denom, referenceUnit string,
+	maxAge time.Duration) (types.Prices, sdk.Error) {
+	prices := k.GetPrices(ctx, denom, referenceUnit).Fresh(ctx, maxAge)
+	if len(prices) == 0 {
+		return nil, types.ErrNoFreshPrice(types.DefaultCodespace, denom, referenceUnit)
+	}
+	return prices, nil
+}
+
+// SetPrice stores an oracle's price, replacing its previous price (if any),
+// and indexes it by oracle so that it can be deleted if the oracle is removed
+func (k Keeper) SetPrice(ctx sdk.Context, price types.Price) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPriceKey(price.Denom, price.ReferenceUnit, price.OracleDid)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(price))
+
+	indexKey := types.GetOraclePriceKey(price.OracleDid, price.Denom, price.ReferenceUnit)
+	store.Set(indexKey, key)
+}
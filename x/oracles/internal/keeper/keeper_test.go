@@ -0,0 +1,333 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles/internal/types"
+)
+
+var (
+	testAmount    = sdk.NewCoins(sdk.NewInt64Coin("uixo", 10))
+	testStatement = types.NewProofStatement(testOracleDid, types.MintCap, "", testFromDid, testAmount)
+)
+
+func setTestOracle(ctx sdk.Context, k Keeper, scheme types.ProofScheme, proofKey string) {
+	oracle := types.NewOracle(testOracleDid, nil)
+	oracle.ProofScheme = scheme
+	oracle.ProofKey = proofKey
+	k.SetOracle(ctx, oracle)
+}
+
+// statementHash returns the SHA-256 hash of the statement with the nonce, as
+// signed on the chain with the chain ID
+func statementHash(statement types.ProofStatement, nonce, chainId string) []byte {
+	statement.Nonce = nonce
+	statement.ChainId = chainId
+	hash := sha256.Sum256(statement.GetSignBytes())
+	return hash[:]
+}
+
+func merkleParent(a, b []byte) []byte {
+	if strings.Compare(hex.EncodeToString(a), hex.EncodeToString(b)) > 0 {
+		a, b = b, a
+	}
+	hash := sha256.Sum256(append(append([]byte{}, a...), b...))
+	return hash[:]
+}
+
+func TestKeeperEd25519Proofs(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+
+	// Some keys encode to fewer than 44 base58 characters and are not valid
+	privKey := ed25519.GenPrivKey()
+	for !did.IsValidPubKey(base58.Encode(privKey[32:])) {
+		privKey = ed25519.GenPrivKey()
+	}
+	pubKey := base58.Encode(privKey[32:])
+	require.NotNil(t, k.ValidateProofScheme(types.ProofSchemeEd25519, "abc"))
+	require.Nil(t, k.ValidateProofScheme(types.ProofSchemeEd25519, pubKey))
+	setTestOracle(ctx, k, types.ProofSchemeEd25519, pubKey)
+
+	sign := func(statement types.ProofStatement, nonce, chainId string) string {
+		statement.Nonce = nonce
+		statement.ChainId = chainId
+		sig, err := privKey.Sign(statement.GetSignBytes())
+		require.Nil(t, err)
+		return nonce + ":" + base64.StdEncoding.EncodeToString(sig)
+	}
+
+	// Invalid signatures (by another key, of another statement or for
+	// another chain) and malformed proofs are rejected
+	otherKey := ed25519.GenPrivKey()
+	otherStatement := testStatement
+	otherStatement.ChainId = testChainId
+	otherStatement.Nonce = "1"
+	otherSig, sigErr := otherKey.Sign(otherStatement.GetSignBytes())
+	require.Nil(t, sigErr)
+	otherAmount := types.NewProofStatement(testOracleDid, types.MintCap, "",
+		testFromDid, sdk.NewCoins(sdk.NewInt64Coin("uixo", 11)))
+	for _, proof := range []string{
+		"1:" + base64.StdEncoding.EncodeToString(otherSig),
+		sign(otherAmount, "1", testChainId),
+		sign(testStatement, "1", "other-chain"),
+		"1:not-base64!",
+		"no-nonce",
+	} {
+		_, err := k.VerifyOracleProof(ctx, testStatement, proof)
+		require.Equal(t, types.CodeInvalidProof, err.Code())
+	}
+
+	// A valid signature is accepted, but is not recorded as used until used
+	proof := sign(testStatement, "1", testChainId)
+	verified, err := k.VerifyOracleProof(ctx, testStatement, proof)
+	require.Nil(t, err)
+	require.Equal(t, statementHash(testStatement, "1", testChainId), verified.Hash)
+	require.False(t, k.IsProofUsed(ctx, verified.Hash))
+	k.UseOracleProof(ctx, testOracleDid, verified)
+	require.True(t, k.IsProofUsed(ctx, verified.Hash))
+	require.Len(t, k.GetAllUsedProofs(ctx), 1)
+
+	// The proof cannot be replayed, but the same operation can be backed by a
+	// proof with another nonce
+	_, err = k.VerifyOracleProof(ctx, testStatement, proof)
+	require.Equal(t, types.CodeInvalidProof, err.Code())
+	_, err = k.VerifyOracleProof(ctx, testStatement, sign(testStatement, "2", testChainId))
+	require.Nil(t, err)
+}
+
+func TestKeeperMerkleProofs(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	require.NotNil(t, k.ValidateProofScheme(types.ProofSchemeMerkle, "abc"))
+
+	// Depth 0: the root is the leaf itself and the proof has no siblings
+	leaf := statementHash(testStatement, "1", testChainId)
+	setTestOracle(ctx, k, types.ProofSchemeMerkle, hex.EncodeToString(leaf))
+	verified, err := k.VerifyOracleProof(ctx, testStatement, "1:")
+	require.Nil(t, err)
+	require.Equal(t, leaf, verified.Hash)
+	_, err = k.VerifyOracleProof(ctx, testStatement, "2:")
+	require.Equal(t, types.CodeInvalidProof, err.Code())
+
+	// Depth 2: four leaves, of which the statement is the third
+	leaves := [][]byte{
+		statementHash(testStatement, "10", testChainId),
+		statementHash(testStatement, "11", testChainId),
+		statementHash(testStatement, "12", testChainId),
+		statementHash(testStatement, "13", testChainId),
+	}
+	left := merkleParent(leaves[0], leaves[1])
+	right := merkleParent(leaves[2], leaves[3])
+	root := merkleParent(left, right)
+	setTestOracle(ctx, k, types.ProofSchemeMerkle, hex.EncodeToString(root))
+
+	proof := "12:" + hex.EncodeToString(leaves[3]) + "," + hex.EncodeToString(left)
+	verified, err = k.VerifyOracleProof(ctx, testStatement, proof)
+	require.Nil(t, err)
+	require.Equal(t, leaves[2], verified.Hash)
+
+	// Wrong or missing siblings, and leaves for another chain, are rejected
+	for _, p := range []string{
+		"12:" + hex.EncodeToString(leaves[3]),
+		"12:" + hex.EncodeToString(leaves[0]) + "," + hex.EncodeToString(left),
+		"12:" + hex.EncodeToString(leaves[3]) + ",zz",
+		"13:" + hex.EncodeToString(leaves[3]) + "," + hex.EncodeToString(left),
+	} {
+		_, err := k.VerifyOracleProof(ctx, testStatement, p)
+		require.Equal(t, types.CodeInvalidProof, err.Code())
+	}
+	_, err = k.VerifyOracleProof(ctx.WithChainID("other-chain"), testStatement, proof)
+	require.Equal(t, types.CodeInvalidProof, err.Code())
+
+	// Once used, the leaf cannot be replayed
+	k.UseOracleProof(ctx, testOracleDid, verified)
+	_, err = k.VerifyOracleProof(ctx, testStatement, proof)
+	require.Equal(t, types.CodeInvalidProof, err.Code())
+}
+
+func TestKeeperHashPreimageProofs(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+
+	// Hash chain seed -> link1 -> link2 -> commitment
+	hash := func(b []byte) []byte { h := sha256.Sum256(b); return h[:] }
+	seed := []byte("seed")
+	link1 := hash(seed)
+	link2 := hash(link1)
+	commitment := hash(link2)
+	require.Nil(t, k.ValidateProofScheme(types.ProofSchemeHashPreimage, hex.EncodeToString(commitment)))
+	setTestOracle(ctx, k, types.ProofSchemeHashPreimage, hex.EncodeToString(commitment))
+
+	// Only the preimage of the current commitment is accepted
+	_, err := k.VerifyOracleProof(ctx, testStatement, hex.EncodeToString(link1))
+	require.Equal(t, types.CodeInvalidProof, err.Code())
+
+	// Revealing a link advances the oracle's proof key, but only when used
+	verified, err := k.VerifyOracleProof(ctx, testStatement, hex.EncodeToString(link2))
+	require.Nil(t, err)
+	require.Equal(t, hex.EncodeToString(commitment), k.MustGetOracle(ctx, testOracleDid).ProofKey)
+	k.UseOracleProof(ctx, testOracleDid, verified)
+	require.Equal(t, hex.EncodeToString(link2), k.MustGetOracle(ctx, testOracleDid).ProofKey)
+
+	// The revealed link cannot be replayed, but the next one can be revealed
+	_, err = k.VerifyOracleProof(ctx, testStatement, hex.EncodeToString(link2))
+	require.Equal(t, types.CodeInvalidProof, err.Code())
+	verified, err = k.VerifyOracleProof(ctx, testStatement, hex.EncodeToString(link1))
+	require.Nil(t, err)
+	k.UseOracleProof(ctx, testOracleDid, verified)
+	require.Equal(t, hex.EncodeToString(link1), k.MustGetOracle(ctx, testOracleDid).ProofKey)
+
+	// The seed is not accepted, since it would not be a valid proof key
+	_, err = k.VerifyOracleProof(ctx, testStatement, hex.EncodeToString(seed))
+	require.Equal(t, types.CodeInvalidProof, err.Code())
+	require.Equal(t, hex.EncodeToString(link1), k.MustGetOracle(ctx, testOracleDid).ProofKey)
+}
+
+func TestKeeperProofsOfOracleWithoutScheme(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	setTestOracle(ctx, k, types.ProofSchemeNone, "")
+	require.NotNil(t, k.ValidateProofScheme(types.ProofSchemeNone, "abc"))
+	require.NotNil(t, k.ValidateProofScheme("unknown", "abc"))
+
+	// Proofs are not verified nor recorded
+	verified, err := k.VerifyOracleProof(ctx, testStatement, "anything")
+	require.Nil(t, err)
+	k.UseOracleProof(ctx, testOracleDid, verified)
+	require.Len(t, k.GetAllUsedProofs(ctx), 0)
+}
+
+func setTestOracleCaps(t *testing.T, ctx sdk.Context, k Keeper, capsStr string) {
+	caps, err := types.ParseOracleTokenCaps(capsStr)
+	require.Nil(t, err)
+	k.SetOracle(ctx, types.NewOracle(testOracleDid, caps))
+}
+
+func TestKeeperOracleAllowanceWindowEdges(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	setTestOracleCaps(t, ctx, k, "uixo:mint:tx=60/window=100@10/lifetime=250")
+
+	consume := func(height, amount int64) sdk.Error {
+		return k.ConsumeOracleAllowance(ctx.WithBlockHeight(height), testOracleDid,
+			types.MintCap, sdk.NewCoins(sdk.NewInt64Coin("uixo", amount)))
+	}
+
+	// Per-transaction limit is inclusive
+	require.Equal(t, types.CodeLimitExceeded, consume(1, 61).Code())
+	require.Nil(t, consume(1, 60))
+
+	// Usage at height 1 is within the window of 10 blocks up to height 10...
+	require.Nil(t, consume(10, 40))
+	require.Equal(t, types.CodeLimitExceeded, consume(10, 1).Code())
+
+	// ...but not within the window up to height 11
+	require.Nil(t, consume(11, 60))
+	usage := k.GetOracleTokenUsage(ctx, testOracleDid, "uixo", types.MintCap)
+	require.Equal(t, sdk.NewInt(100), usage.WindowTotal(11, 10))
+	require.Len(t, usage.Recent, 2)
+
+	// Lifetime limit is reached regardless of the window
+	require.Nil(t, consume(30, 60))
+	require.Nil(t, consume(40, 30))
+	require.Equal(t, types.CodeLimitExceeded, consume(50, 1).Code())
+	usage = k.GetOracleTokenUsage(ctx, testOracleDid, "uixo", types.MintCap)
+	require.Equal(t, sdk.NewInt(250), usage.Lifetime)
+
+	// Usage is tracked separately per capability
+	require.Nil(t, k.ConsumeOracleAllowance(ctx.WithBlockHeight(40), testOracleDid,
+		types.BurnCap, sdk.NewCoins(sdk.NewInt64Coin("uixo", 60))))
+}
+
+func TestKeeperOracleAllowanceLimitUpdates(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+
+	consume := func(height, amount int64) sdk.Error {
+		return k.ConsumeOracleAllowance(ctx.WithBlockHeight(height), testOracleDid,
+			types.MintCap, sdk.NewCoins(sdk.NewInt64Coin("uixo", amount)))
+	}
+
+	// Usage under a large window
+	setTestOracleCaps(t, ctx, k, "uixo:mint:window=100@50")
+	require.Nil(t, consume(1, 100))
+
+	// Lowering the window allows more usage, without discarding usage that is
+	// still within the largest window
+	setTestOracleCaps(t, ctx, k, "uixo:mint:window=100@5")
+	require.Nil(t, consume(20, 100))
+	usage := k.GetOracleTokenUsage(ctx, testOracleDid, "uixo", types.MintCap)
+	require.Len(t, usage.Recent, 2)
+	require.Equal(t, int64(50), usage.RetainBlocks)
+
+	// Raising the window again takes all of the usage within it into account
+	setTestOracleCaps(t, ctx, k, "uixo:mint:window=250@50")
+	require.Equal(t, types.CodeLimitExceeded, consume(30, 51).Code())
+	require.Nil(t, consume(30, 50))
+	require.Nil(t, consume(51, 100))
+
+	// Removing the limits keeps track of lifetime usage
+	setTestOracleCaps(t, ctx, k, "uixo:mint")
+	require.Nil(t, consume(60, 1000))
+	usage = k.GetOracleTokenUsage(ctx, testOracleDid, "uixo", types.MintCap)
+	require.Equal(t, sdk.NewInt(1350), usage.Lifetime)
+
+	// Adding a lifetime limit applies to the usage so far
+	setTestOracleCaps(t, ctx, k, "uixo:mint:lifetime=1350")
+	require.Equal(t, types.CodeLimitExceeded, consume(70, 1).Code())
+}
+
+func TestKeeperOracleAllowanceMultiDenomRollback(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	setTestOracleCaps(t, ctx, k, "uixo:mint:tx=100,uatom:mint:tx=10")
+
+	// The uatom amount is over its limit, so the uixo usage is not recorded
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatom", 11), sdk.NewInt64Coin("uixo", 50))
+	err := k.ConsumeOracleAllowance(ctx, testOracleDid, types.MintCap, amount)
+	require.Equal(t, types.CodeLimitExceeded, err.Code())
+	require.Len(t, k.GetAllOracleTokenUsage(ctx), 0)
+
+	// A denomination without the capability also leaves usage untouched
+	amount = sdk.NewCoins(sdk.NewInt64Coin("uixo", 50), sdk.NewInt64Coin("xyz", 1))
+	err = k.ConsumeOracleAllowance(ctx, testOracleDid, types.MintCap, amount)
+	require.NotNil(t, err)
+	require.Len(t, k.GetAllOracleTokenUsage(ctx), 0)
+
+	// Within limits, the usage of both denominations is recorded
+	amount = sdk.NewCoins(sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("uixo", 50))
+	require.Nil(t, k.ConsumeOracleAllowance(ctx, testOracleDid, types.MintCap, amount))
+	require.Len(t, k.GetAllOracleTokenUsage(ctx), 2)
+}
+
+func TestKeeperRemoveOracleDeletesItsPrices(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	setTestOracleCaps(t, ctx, k, "uixo:price,uatom:price")
+	k.SetOracle(ctx, types.NewOracle(testFromDid, nil))
+
+	price := sdk.NewDec(2)
+	k.SetPrice(ctx, types.NewPrice(ctx, "uixo", "usd", price, testOracleDid))
+	k.SetPrice(ctx, types.NewPrice(ctx, "uixo", "eur", price, testOracleDid))
+	k.SetPrice(ctx, types.NewPrice(ctx, "uatom", "usd", price, testOracleDid))
+	k.SetPrice(ctx, types.NewPrice(ctx, "uixo", "usd", price, testFromDid))
+	require.Len(t, k.GetAllPrices(ctx), 4)
+
+	// Prices are deleted even if the oracle has since lost the capability
+	setTestOracleCaps(t, ctx, k, "uixo:price")
+	k.RemoveOracle(ctx, testOracleDid)
+	require.False(t, k.OracleExists(ctx, testOracleDid))
+
+	// Only the other oracle's price remains
+	prices := k.GetAllPrices(ctx)
+	require.Len(t, prices, 1)
+	require.Equal(t, testFromDid, prices[0].OracleDid)
+
+	// Re-adding the oracle does not bring back its prices
+	setTestOracleCaps(t, ctx, k, "uixo:price,uatom:price")
+	require.Len(t, k.GetPrices(ctx, "uixo", "eur"), 0)
+	require.Len(t, k.GetPrices(ctx, "uatom", "usd"), 0)
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles/internal/types"
+)
+
+// RegisterProofVerifier adds a proof scheme that oracles can use. Panics if
+// the scheme is already registered or is the none scheme.
+func (k Keeper) RegisterProofVerifier(scheme types.ProofScheme, verifier types.ProofVerifier) {
+	if scheme.IsNone() {
+		panic("cannot register a verifier for the none proof scheme")
+	} else if _, ok := k.proofVerifiers[scheme]; ok {
+		panic(fmt.Sprintf("proof verifier for %s already registered", scheme))
+	}
+	k.proofVerifiers[scheme] = verifier
+}
+
+// ValidateProofScheme checks that the proof scheme is registered (or is the
+// none scheme) and that the proof key is valid for the scheme
+func (k Keeper) ValidateProofScheme(scheme types.ProofScheme, proofKey string) sdk.Error {
+	if err := types.ValidateProofScheme(scheme, proofKey, k.proofVerifiers); err != nil {
+		return types.ErrInvalidProof(types.DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// IsProofUsed checks if a proof with the hash has already been used
+func (k Keeper) IsProofUsed(ctx sdk.Context, hash []byte) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetUsedProofKey(hash))
+}
+
+// GetAllUsedProofs returns the hashes of all proofs that have been used
+func (k Keeper) GetAllUsedProofs(ctx sdk.Context) (usedProofs []types.UsedProof) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UsedProofKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var usedProof types.UsedProof
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &usedProof)
+		usedProofs = append(usedProofs, usedProof)
+	}
+
+	return usedProofs
+}
+
+// SetUsedProof records a proof as used. Panics if the hash is not hex-encoded.
+func (k Keeper) SetUsedProof(ctx sdk.Context, usedProof types.UsedProof) {
+	hash, err := hex.DecodeString(usedProof.Hash)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUsedProofKey(hash), k.cdc.MustMarshalBinaryLengthPrefixed(usedProof))
+}
+
+// VerifyOracleProof verifies the proof of the statement's oracle using the
+// oracle's proof scheme, with the statement bound to the current chain, and
+// checks that the proof has not been used yet. The proof is not recorded as
+// used until UseOracleProof is called, which should only be done once the
+// operation that the proof backs has been performed. Proofs of oracles
+// without a proof scheme are not verified.
+func (k Keeper) VerifyOracleProof(ctx sdk.Context, statement types.ProofStatement,
+	proof string) (types.VerifiedProof, sdk.Error) {
+	oracle := k.MustGetOracle(ctx, statement.OracleDid)
+	if oracle.ProofScheme.IsNone() {
+		return types.VerifiedProof{}, nil
+	}
+
+	verifier, ok := k.proofVerifiers[oracle.ProofScheme]
+	if !ok {
+		return types.VerifiedProof{}, types.ErrInvalidProof(types.DefaultCodespace,
+			fmt.Sprintf("unknown proof scheme %s", oracle.ProofScheme))
+	}
+
+	statement.ChainId = ctx.ChainID()
+	verified, err := verifier.VerifyProof(oracle.ProofKey, statement, proof)
+	if err != nil {
+		return types.VerifiedProof{}, types.ErrInvalidProof(types.DefaultCodespace, err.Error())
+	} else if k.IsProofUsed(ctx, verified.Hash) {
+		return types.VerifiedProof{}, types.ErrInvalidProof(types.DefaultCodespace,
+			"proof has already been used")
+	}
+
+	return verified, nil
+}
+
+// UseOracleProof records a proof verified using VerifyOracleProof as used, so
+// that it cannot be replayed, and advances the oracle's proof key if the proof
+// reveals the next one. Does nothing for oracles without a proof scheme.
+func (k Keeper) UseOracleProof(ctx sdk.Context, oracleDid did.Did, verified types.VerifiedProof) {
+	if len(verified.Hash) == 0 {
+		return
+	}
+
+	k.SetUsedProof(ctx, types.UsedProof{
+		Hash:      hex.EncodeToString(verified.Hash),
+		OracleDid: oracleDid,
+		Height:    ctx.BlockHeight(),
+	})
+
+	if len(verified.NextProofKey) != 0 {
+		oracle := k.MustGetOracle(ctx, oracleDid)
+		oracle.ProofKey = verified.NextProofKey
+		k.SetOracle(ctx, oracle)
+	}
+}
@@ -1,13 +1,21 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ixofoundation/ixo-blockchain/x/oracles/internal/types"
 )
 
 const (
-	QueryOracles = "queryOracles"
+	QueryOracles       = "queryOracles"
+	QueryOracleHistory = "queryOracleHistory"
+	QueryOracleUsage   = "queryOracleUsage"
+	QueryPrices        = "queryPrices"
+	QueryParams        = "queryParams"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -15,16 +23,135 @@ func NewQuerier(k Keeper) sdk.Querier {
 		switch path[0] {
 		case QueryOracles:
 			return queryOracles(ctx, k)
+		case QueryOracleHistory:
+			return queryOracleHistory(ctx, path[1:], k)
+		case QueryOracleUsage:
+			return queryOracleUsage(ctx, path[1:], k)
+		case QueryPrices:
+			return queryPrices(ctx, path[1:], k)
+		case QueryParams:
+			return queryParams(ctx, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown oracles query endpoint")
 		}
 	}
 }
 
+// queryOracles returns the registered oracles, each with its status and the
+// changes made to it by admins
 func queryOracles(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	oracles := k.GetOracles(ctx)
 
-	res, err := codec.MarshalJSONIndent(k.cdc, oracles)
+	responses := make([]types.QueryOracleResponse, len(oracles))
+	for i, o := range oracles {
+		responses[i] = types.QueryOracleResponse{
+			Oracle:  o,
+			History: k.GetOracleHistory(ctx, o.OracleDid),
+		}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, responses)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+// queryOracleUsage expects a path of the form [oracle-did] and returns, for
+// each of the oracle's mint, burn and transfer capabilities, the amount used
+// and the largest amount that can currently be used in a single transaction
+func queryOracleUsage(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) < 1 {
+		return nil, sdk.ErrUnknownRequest("expected oracle did but got no arguments")
+	}
+	oracleDid := path[0]
+
+	if !k.OracleExists(ctx, oracleDid) {
+		return nil, types.ErrInvalidOracle(types.DefaultCodespace,
+			"oracle is not a registered oracle")
+	}
+	oracle := k.MustGetOracle(ctx, oracleDid)
+
+	var responses []types.QueryOracleUsageResponse
+	for _, tokenCap := range oracle.Capabilities {
+		for _, capability := range tokenCap.Capabilities {
+			if capability == types.PriceCap {
+				continue
+			}
+
+			usage := k.GetOracleTokenUsage(ctx, oracleDid, tokenCap.Denom, capability)
+			headroom, limited := usage.Headroom(ctx.BlockHeight(), tokenCap.Limits)
+			if !limited {
+				headroom = sdk.ZeroInt()
+			}
+
+			windowUsed := sdk.ZeroInt()
+			if tokenCap.Limits.WindowBlocks > 0 {
+				windowUsed = usage.WindowTotal(ctx.BlockHeight(), tokenCap.Limits.WindowBlocks)
+			}
+
+			responses = append(responses, types.QueryOracleUsageResponse{
+				Denom:        tokenCap.Denom,
+				Capability:   capability,
+				Limits:       tokenCap.Limits,
+				WindowUsed:   windowUsed,
+				LifetimeUsed: usage.Lifetime,
+				Unlimited:    !limited,
+				Headroom:     headroom,
+			})
+		}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, responses)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+// queryPrices expects a path of the form [denom, reference-unit] and returns
+// the latest price submitted by each oracle, whether fresh or not
+func queryPrices(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) < 2 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf(
+			"expected denom and reference unit but got %d arguments", len(path)))
+	}
+	denom := path[0]
+	referenceUnit := path[1]
+
+	prices := k.GetPrices(ctx, denom, referenceUnit)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, prices)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+// queryOracleHistory expects a path of the form [oracle-did] and returns the
+// changes made to the oracle by admins, even if the oracle has been removed
+func queryOracleHistory(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) < 1 {
+		return nil, sdk.ErrUnknownRequest("expected oracle did but got no arguments")
+	}
+
+	history := k.GetOracleHistory(ctx, path[0])
+
+	res, err := codec.MarshalJSONIndent(k.cdc, history)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, params)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
 	}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles/internal/types"
+)
+
+// GetOracleTokenUsage returns the amount of a token that an oracle has used
+// through one of its capabilities
+func (k Keeper) GetOracleTokenUsage(ctx sdk.Context, oracleDid did.Did,
+	denom string, capability types.TokenCap) types.OracleTokenUsage {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOracleUsageKey(oracleDid, denom, capability))
+	if bz == nil {
+		return types.NewOracleTokenUsage(oracleDid, denom, capability)
+	}
+
+	var usage types.OracleTokenUsage
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &usage)
+	return usage
+}
+
+// GetAllOracleTokenUsage returns the usage of all tokens by all oracles,
+// including removed ones
+func (k Keeper) GetAllOracleTokenUsage(ctx sdk.Context) (usages []types.OracleTokenUsage) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OracleUsageKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.OracleTokenUsage
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &usage)
+		usages = append(usages, usage)
+	}
+
+	return usages
+}
+
+func (k Keeper) SetOracleTokenUsage(ctx sdk.Context, usage types.OracleTokenUsage) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetOracleUsageKey(usage.OracleDid, usage.Denom, usage.Capability)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(usage))
+}
+
+// ConsumeOracleAllowance checks that using the amount through the capability
+// is within the oracle's limits for each of the tokens and records the usage.
+// The usage is only recorded if all of the tokens are within the limits.
+// The oracle is expected to have the capability for each of the tokens.
+func (k Keeper) ConsumeOracleAllowance(ctx sdk.Context, oracleDid did.Did,
+	capability types.TokenCap, amount sdk.Coins) sdk.Error {
+	oracle := k.MustGetOracle(ctx, oracleDid)
+	height := ctx.BlockHeight()
+
+	usages := make([]types.OracleTokenUsage, len(amount))
+	for i, c := range amount {
+		if !oracle.Capabilities.Includes(c.Denom) {
+			return types.ErrMissingOracleCapability(
+				types.DefaultCodespace, c.Denom, capability)
+		}
+		limits := oracle.Capabilities.MustGet(c.Denom).Limits
+		usage := k.GetOracleTokenUsage(ctx, oracleDid, c.Denom, capability)
+
+		// Check limits
+		if limits.HasMaxPerTx() && c.Amount.GT(limits.MaxPerTx) {
+			return types.ErrLimitExceeded(
+				types.DefaultCodespace, c.Denom, capability, "per-transaction")
+		} else if limits.HasMaxPerWindow() && usage.WindowTotal(
+			height, limits.WindowBlocks).Add(c.Amount).GT(limits.MaxPerWindow) {
+			return types.ErrLimitExceeded(
+				types.DefaultCodespace, c.Denom, capability, "rolling window")
+		} else if limits.HasLifetimeMax() &&
+			usage.Lifetime.Add(c.Amount).GT(limits.LifetimeMax) {
+			return types.ErrLimitExceeded(
+				types.DefaultCodespace, c.Denom, capability, "lifetime")
+		}
+
+		usages[i] = usage.Add(height, limits.WindowBlocks, c.Amount)
+	}
+
+	// Record usage
+	for _, usage := range usages {
+		k.SetOracleTokenUsage(ctx, usage)
+	}
+
+	return nil
+}
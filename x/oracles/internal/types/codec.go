@@ -10,6 +10,13 @@ var ModuleCdc *codec.Codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(Oracle{}, "oracles/Oracle", nil)
 	cdc.RegisterConcrete(OracleTokenCap{}, "oracles/OracleTokenCap", nil)
+
+	cdc.RegisterConcrete(MsgSubmitPrice{}, "oracles/MsgSubmitPrice", nil)
+	cdc.RegisterConcrete(MsgAddOracle{}, "oracles/MsgAddOracle", nil)
+	cdc.RegisterConcrete(MsgUpdateOracle{}, "oracles/MsgUpdateOracle", nil)
+	cdc.RegisterConcrete(MsgSuspendOracle{}, "oracles/MsgSuspendOracle", nil)
+	cdc.RegisterConcrete(MsgResumeOracle{}, "oracles/MsgResumeOracle", nil)
+	cdc.RegisterConcrete(MsgRemoveOracle{}, "oracles/MsgRemoveOracle", nil)
 }
 
 func init() {
@@ -1,9 +1,59 @@
 package types
 
 import (
-	"github.com/cosmos/cosmos-sdk/types"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
-	DefaultCodespace types.CodespaceType = ModuleName
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidPrice     sdk.CodeType = 401
+	CodeNoFreshPrice     sdk.CodeType = 402
+	CodeInvalidOracle    sdk.CodeType = 403
+	CodeMissingOracleCap sdk.CodeType = 404
+	CodeOracleSuspended  sdk.CodeType = 405
+	CodeUnauthorizedDid  sdk.CodeType = 406
+	CodeLimitExceeded    sdk.CodeType = 407
+	CodeInvalidProof     sdk.CodeType = 408
 )
+
+func ErrInvalidPrice(codespace sdk.CodespaceType, errMsg string) sdk.Error {
+	errMsg = fmt.Sprintf("price invalid; %s", errMsg)
+	return sdk.NewError(codespace, CodeInvalidPrice, errMsg)
+}
+
+func ErrNoFreshPrice(codespace sdk.CodespaceType, denom, referenceUnit string) sdk.Error {
+	errMsg := fmt.Sprintf("no fresh price of %s in %s", denom, referenceUnit)
+	return sdk.NewError(codespace, CodeNoFreshPrice, errMsg)
+}
+
+func ErrInvalidOracle(codespace sdk.CodespaceType, errMsg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidOracle, errMsg)
+}
+
+func ErrMissingOracleCapability(codespace sdk.CodespaceType, denom string, capability TokenCap) sdk.Error {
+	errMsg := fmt.Sprintf("oracle does not have the %s capability for %s", capability, denom)
+	return sdk.NewError(codespace, CodeMissingOracleCap, errMsg)
+}
+
+func ErrOracleSuspended(codespace sdk.CodespaceType, oracleDid string) sdk.Error {
+	errMsg := fmt.Sprintf("oracle %s is suspended", oracleDid)
+	return sdk.NewError(codespace, CodeOracleSuspended, errMsg)
+}
+
+func ErrUnauthorizedDid(codespace sdk.CodespaceType, adminDid string) sdk.Error {
+	errMsg := fmt.Sprintf("%s is not an oracles admin", adminDid)
+	return sdk.NewError(codespace, CodeUnauthorizedDid, errMsg)
+}
+
+func ErrLimitExceeded(codespace sdk.CodespaceType, denom string, capability TokenCap, limit string) sdk.Error {
+	errMsg := fmt.Sprintf("oracle %s limit for %s %s exceeded", limit, capability, denom)
+	return sdk.NewError(codespace, CodeLimitExceeded, errMsg)
+}
+
+func ErrInvalidProof(codespace sdk.CodespaceType, errMsg string) sdk.Error {
+	errMsg = fmt.Sprintf("proof invalid; %s", errMsg)
+	return sdk.NewError(codespace, CodeInvalidProof, errMsg)
+}
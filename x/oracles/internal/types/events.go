@@ -0,0 +1,20 @@
+package types
+
+const (
+	EventTypeSubmitPrice   = "submit_price"
+	EventTypeAddOracle     = "add_oracle"
+	EventTypeUpdateOracle  = "update_oracle"
+	EventTypeSuspendOracle = "suspend_oracle"
+	EventTypeResumeOracle  = "resume_oracle"
+	EventTypeRemoveOracle  = "remove_oracle"
+
+	AttributeKeyOracleDid     = "oracle_did"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyReferenceUnit = "reference_unit"
+	AttributeKeyPrice         = "price"
+	AttributeKeyAdminDid      = "admin_did"
+	AttributeKeyCapabilities  = "capabilities"
+	AttributeKeyProofScheme   = "proof_scheme"
+
+	AttributeValueCategory = ModuleName
+)
@@ -1,21 +1,86 @@
 package types
 
+import "fmt"
+
 type GenesisState struct {
-	Oracles Oracles `json:"oracles" yaml:"oracles"`
+	Oracles       Oracles            `json:"oracles" yaml:"oracles"`
+	Prices        Prices             `json:"prices" yaml:"prices"`
+	OracleHistory OracleHistory      `json:"oracle_history" yaml:"oracle_history"`
+	Params        Params             `json:"params" yaml:"params"`
+	OracleUsage   []OracleTokenUsage `json:"oracle_usage" yaml:"oracle_usage"`
+	UsedProofs    []UsedProof        `json:"used_proofs" yaml:"used_proofs"`
 }
 
-func NewGenesisState(oracles Oracles) GenesisState {
+func NewGenesisState(oracles Oracles, prices Prices, oracleHistory OracleHistory,
+	params Params, oracleUsage []OracleTokenUsage, usedProofs []UsedProof) GenesisState {
 	return GenesisState{
-		Oracles: oracles,
+		Oracles:       oracles,
+		Prices:        prices,
+		OracleHistory: oracleHistory,
+		Params:        params,
+		OracleUsage:   oracleUsage,
+		UsedProofs:    usedProofs,
 	}
 }
 
 func ValidateGenesis(data GenesisState) error {
+	// Validate params
+	if err := ValidateParams(data.Params); err != nil {
+		return err
+	}
+
+	// Validate oracles, which can only use the built-in proof schemes
+	seen := make(map[string]bool)
+	verifiers := DefaultProofVerifiers()
+	for _, o := range data.Oracles {
+		if err := o.Validate(); err != nil {
+			return err
+		} else if err := ValidateProofScheme(o.ProofScheme, o.ProofKey, verifiers); err != nil {
+			return fmt.Errorf("oracle %s: %s", o.OracleDid, err)
+		} else if seen[o.OracleDid] {
+			return fmt.Errorf("oracle %s is duplicate", o.OracleDid)
+		}
+		seen[o.OracleDid] = true
+	}
+
+	// Validate prices
+	for _, p := range data.Prices {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate oracle history
+	for _, e := range data.OracleHistory {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate oracle usage
+	for _, u := range data.OracleUsage {
+		if err := u.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate used proofs
+	for _, p := range data.UsedProofs {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Oracles: nil,
+		Oracles:       nil,
+		Prices:        nil,
+		OracleHistory: nil,
+		Params:        DefaultParams(),
+		OracleUsage:   nil,
+		UsedProofs:    nil,
 	}
 }
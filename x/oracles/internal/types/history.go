@@ -0,0 +1,68 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+)
+
+// --------------------------------------- OracleAction
+
+type OracleAction string
+
+const (
+	OracleActionAdd     OracleAction = "add"
+	OracleActionUpdate  OracleAction = "update"
+	OracleActionSuspend OracleAction = "suspend"
+	OracleActionResume  OracleAction = "resume"
+	OracleActionRemove  OracleAction = "remove"
+)
+
+func (a OracleAction) IsValid() bool {
+	return a == OracleActionAdd || a == OracleActionUpdate ||
+		a == OracleActionSuspend || a == OracleActionResume ||
+		a == OracleActionRemove
+}
+
+// --------------------------------------- OracleHistoryEntry/OracleHistory
+
+// OracleHistoryEntry records a change made to an oracle by an admin, together
+// with the oracle's capabilities and proof scheme after the change. The
+// history of an oracle is kept even after the oracle is removed.
+type (
+	OracleHistoryEntry struct {
+		OracleDid    exported.Did    `json:"oracle_did" yaml:"oracle_did"`
+		Action       OracleAction    `json:"action" yaml:"action"`
+		Capabilities OracleTokenCaps `json:"capabilities" yaml:"capabilities"`
+		AdminDid     exported.Did    `json:"admin_did" yaml:"admin_did"`
+		Height       int64           `json:"height" yaml:"height"`
+		Time         time.Time       `json:"time" yaml:"time"`
+		ProofScheme  ProofScheme     `json:"proof_scheme" yaml:"proof_scheme"`
+		ProofKey     string          `json:"proof_key" yaml:"proof_key"`
+	}
+	OracleHistory []OracleHistoryEntry
+)
+
+func NewOracleHistoryEntry(ctx sdk.Context, oracle Oracle,
+	action OracleAction, adminDid exported.Did) OracleHistoryEntry {
+	return OracleHistoryEntry{
+		OracleDid:    oracle.OracleDid,
+		Action:       action,
+		Capabilities: oracle.Capabilities,
+		AdminDid:     adminDid,
+		Height:       ctx.BlockHeight(),
+		Time:         ctx.BlockTime(),
+		ProofScheme:  oracle.ProofScheme,
+		ProofKey:     oracle.ProofKey,
+	}
+}
+
+func (e OracleHistoryEntry) Validate() sdk.Error {
+	if len(e.OracleDid) == 0 {
+		return ErrInvalidOracle(DefaultCodespace, "empty oracle did in history entry")
+	} else if !e.Action.IsValid() {
+		return ErrInvalidOracle(DefaultCodespace, "invalid action in history entry")
+	}
+	return nil
+}
@@ -5,16 +5,71 @@ import (
 )
 
 const (
-	ModuleName   = "oracles"
-	StoreKey     = ModuleName
-	RouterKey    = ModuleName
-	QuerierRoute = ModuleName
+	ModuleName        = "oracles"
+	DefaultParamspace = ModuleName
+	StoreKey          = ModuleName
+	RouterKey         = ModuleName
+	QuerierRoute      = ModuleName
 )
 
 var (
 	OracleKey = []byte{0x00}
+	PriceKey  = []byte{0x01}
+
+	OracleHistoryKey = []byte{0x02}
+	OracleUsageKey   = []byte{0x03}
+	UsedProofKey     = []byte{0x04}
+	OraclePriceKey   = []byte{0x05}
 )
 
 func GetOraclePrefixKey(did exported.Did) []byte {
 	return append(OracleKey, []byte(did)...)
 }
+
+func GetOracleHistoryKey(did exported.Did) []byte {
+	return append(OracleHistoryKey, []byte(did)...)
+}
+
+// GetOracleUsagePrefixKey returns the prefix under which the usage of all of
+// an oracle's tokens is stored
+func GetOracleUsagePrefixKey(did exported.Did) []byte {
+	key := append(OracleUsageKey, []byte(did)...)
+	return append(key, 0x00)
+}
+
+func GetOracleUsageKey(did exported.Did, denom string, capability TokenCap) []byte {
+	key := append(GetOracleUsagePrefixKey(did), []byte(denom)...)
+	key = append(key, 0x00)
+	return append(key, []byte(capability)...)
+}
+
+func GetUsedProofKey(hash []byte) []byte {
+	return append(UsedProofKey, hash...)
+}
+
+// GetPricesPrefixKey returns the prefix under which all oracles' prices of a
+// token in a reference unit are stored. The 0x00 separators stop a prefix from
+// matching denoms or reference units that start with the same characters.
+func GetPricesPrefixKey(denom, referenceUnit string) []byte {
+	key := append(PriceKey, []byte(denom)...)
+	key = append(key, 0x00)
+	key = append(key, []byte(referenceUnit)...)
+	return append(key, 0x00)
+}
+
+func GetPriceKey(denom, referenceUnit string, oracleDid exported.Did) []byte {
+	return append(GetPricesPrefixKey(denom, referenceUnit), []byte(oracleDid)...)
+}
+
+// GetOraclePricesPrefixKey returns the prefix under which the price keys of all
+// of the prices submitted by an oracle are indexed
+func GetOraclePricesPrefixKey(oracleDid exported.Did) []byte {
+	key := append(OraclePriceKey, []byte(oracleDid)...)
+	return append(key, 0x00)
+}
+
+func GetOraclePriceKey(oracleDid exported.Did, denom, referenceUnit string) []byte {
+	key := append(GetOraclePricesPrefixKey(oracleDid), []byte(denom)...)
+	key = append(key, 0x00)
+	return append(key, []byte(referenceUnit)...)
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// --------------------------------------- OracleTokenLimits
+
+// OracleTokenLimits are the optional limits on the amounts of a token that an
+// oracle can mint, burn or transfer. Each limit applies to each capability
+// separately, e.g. a lifetime limit of 1000 allows an oracle to mint 1000 and
+// also burn 1000. A zero (or empty) limit means that there is no limit.
+type OracleTokenLimits struct {
+	MaxPerTx     sdk.Int `json:"max_per_tx" yaml:"max_per_tx"`
+	MaxPerWindow sdk.Int `json:"max_per_window" yaml:"max_per_window"`
+	WindowBlocks int64   `json:"window_blocks" yaml:"window_blocks"`
+	LifetimeMax  sdk.Int `json:"lifetime_max" yaml:"lifetime_max"`
+}
+
+func NewOracleTokenLimits(maxPerTx, maxPerWindow sdk.Int, windowBlocks int64,
+	lifetimeMax sdk.Int) OracleTokenLimits {
+	return OracleTokenLimits{
+		MaxPerTx:     maxPerTx,
+		MaxPerWindow: maxPerWindow,
+		WindowBlocks: windowBlocks,
+		LifetimeMax:  lifetimeMax,
+	}
+}
+
+// isLimit True if the value is set and positive. Values are not set if the
+// limits were stored before limits were introduced.
+func isLimit(value sdk.Int) bool {
+	return value != (sdk.Int{}) && value.IsPositive()
+}
+
+func (l OracleTokenLimits) HasMaxPerTx() bool     { return isLimit(l.MaxPerTx) }
+func (l OracleTokenLimits) HasMaxPerWindow() bool { return isLimit(l.MaxPerWindow) }
+func (l OracleTokenLimits) HasLifetimeMax() bool  { return isLimit(l.LifetimeMax) }
+
+// IsUnlimited True if none of the limits is set
+func (l OracleTokenLimits) IsUnlimited() bool {
+	return !l.HasMaxPerTx() && !l.HasMaxPerWindow() && !l.HasLifetimeMax()
+}
+
+func (l OracleTokenLimits) Validate() error {
+	for _, value := range []sdk.Int{l.MaxPerTx, l.MaxPerWindow, l.LifetimeMax} {
+		if value != (sdk.Int{}) && value.IsNegative() {
+			return fmt.Errorf("limits cannot be negative")
+		}
+	}
+	if l.WindowBlocks < 0 {
+		return fmt.Errorf("window blocks cannot be negative")
+	} else if l.HasMaxPerWindow() != (l.WindowBlocks > 0) {
+		return fmt.Errorf("max per window and window blocks must be set together")
+	}
+	return nil
+}
+
+// String returns the limits in the format accepted by ParseOracleTokenLimits,
+// or an empty string if there are no limits
+func (l OracleTokenLimits) String() string {
+	var strs []string
+	if l.HasMaxPerTx() {
+		strs = append(strs, fmt.Sprintf("tx=%s", l.MaxPerTx))
+	}
+	if l.HasMaxPerWindow() {
+		strs = append(strs, fmt.Sprintf("window=%s@%d", l.MaxPerWindow, l.WindowBlocks))
+	}
+	if l.HasLifetimeMax() {
+		strs = append(strs, fmt.Sprintf("lifetime=%s", l.LifetimeMax))
+	}
+	return strings.Join(strs, "/")
+}
+
+// ParseOracleTokenLimits parses limits of the form
+// [tx=<amount>][/window=<amount>@<blocks>][/lifetime=<amount>],
+// e.g. tx=1000/window=5000@100 limits an oracle to 1000 per transaction and
+// to 5000 in any 100 consecutive blocks.
+func ParseOracleTokenLimits(limitsStr string) (OracleTokenLimits, error) {
+	limits := NewOracleTokenLimits(sdk.ZeroInt(), sdk.ZeroInt(), 0, sdk.ZeroInt())
+
+	limitsStr = strings.TrimSpace(limitsStr)
+	if len(limitsStr) == 0 {
+		return limits, nil
+	}
+
+	for _, limitStr := range strings.Split(limitsStr, "/") {
+		keyValue := strings.Split(limitStr, "=")
+		if len(keyValue) != 2 {
+			return OracleTokenLimits{}, fmt.Errorf("invalid limit: %s", limitStr)
+		}
+
+		var ok bool
+		switch key, value := keyValue[0], keyValue[1]; key {
+		case "tx":
+			limits.MaxPerTx, ok = sdk.NewIntFromString(value)
+		case "lifetime":
+			limits.LifetimeMax, ok = sdk.NewIntFromString(value)
+		case "window":
+			amountBlocks := strings.Split(value, "@")
+			if len(amountBlocks) != 2 {
+				return OracleTokenLimits{}, fmt.Errorf("invalid limit: %s", limitStr)
+			}
+			limits.MaxPerWindow, ok = sdk.NewIntFromString(amountBlocks[0])
+			blocks, err := strconv.ParseInt(amountBlocks[1], 10, 64)
+			if err != nil {
+				return OracleTokenLimits{}, fmt.Errorf("invalid limit: %s", limitStr)
+			}
+			limits.WindowBlocks = blocks
+		default:
+			return OracleTokenLimits{}, fmt.Errorf("invalid limit: %s", limitStr)
+		}
+		if !ok {
+			return OracleTokenLimits{}, fmt.Errorf("invalid limit: %s", limitStr)
+		}
+	}
+
+	if err := limits.Validate(); err != nil {
+		return OracleTokenLimits{}, err
+	}
+
+	return limits, nil
+}
@@ -0,0 +1,319 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/ixo"
+)
+
+const (
+	TypeMsgSubmitPrice   = "submit-price"
+	TypeMsgAddOracle     = "add-oracle"
+	TypeMsgUpdateOracle  = "update-oracle"
+	TypeMsgSuspendOracle = "suspend-oracle"
+	TypeMsgResumeOracle  = "resume-oracle"
+	TypeMsgRemoveOracle  = "remove-oracle"
+)
+
+var (
+	_ ixo.IxoMsg = MsgSubmitPrice{}
+	_ ixo.IxoMsg = MsgAddOracle{}
+	_ ixo.IxoMsg = MsgUpdateOracle{}
+	_ ixo.IxoMsg = MsgSuspendOracle{}
+	_ ixo.IxoMsg = MsgResumeOracle{}
+	_ ixo.IxoMsg = MsgRemoveOracle{}
+)
+
+type MsgSubmitPrice struct {
+	OracleDid     did.Did `json:"oracle_did" yaml:"oracle_did"`
+	Denom         string  `json:"denom" yaml:"denom"`
+	ReferenceUnit string  `json:"reference_unit" yaml:"reference_unit"`
+	Price         sdk.Dec `json:"price" yaml:"price"`
+}
+
+func NewMsgSubmitPrice(denom, referenceUnit string, price sdk.Dec,
+	oracleDid did.Did) MsgSubmitPrice {
+	return MsgSubmitPrice{
+		OracleDid:     oracleDid,
+		Denom:         denom,
+		ReferenceUnit: referenceUnit,
+		Price:         price,
+	}
+}
+
+func (msg MsgSubmitPrice) Type() string  { return TypeMsgSubmitPrice }
+func (msg MsgSubmitPrice) Route() string { return RouterKey }
+func (msg MsgSubmitPrice) ValidateBasic() sdk.Error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(msg.OracleDid, "OracleDid"); !valid {
+		return err
+	}
+
+	// Check that DIDs valid
+	if !did.IsValidDid(msg.OracleDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "oracle did is invalid")
+	}
+
+	// Check denom, reference unit and price
+	if !IsValidDenom(msg.Denom) {
+		return ErrInvalidPrice(DefaultCodespace, "invalid denom")
+	} else if !IsValidDenom(msg.ReferenceUnit) {
+		return ErrInvalidPrice(DefaultCodespace, "invalid reference unit")
+	} else if msg.Price.IsNil() || !msg.Price.IsPositive() {
+		return ErrInvalidPrice(DefaultCodespace, "price must be positive")
+	}
+
+	return nil
+}
+
+func (msg MsgSubmitPrice) GetSignerDid() did.Did { return msg.OracleDid }
+func (msg MsgSubmitPrice) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgSubmitPrice) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgSubmitPrice) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// validateAdminAndOracleDids checks the DIDs common to all of the messages
+// that are used by oracle admins to manage oracles
+func validateAdminAndOracleDids(adminDid, oracleDid did.Did) sdk.Error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(adminDid, "AdminDid"); !valid {
+		return err
+	} else if valid, err := CheckNotEmpty(oracleDid, "OracleDid"); !valid {
+		return err
+	}
+
+	// Check that DIDs valid
+	if !did.IsValidDid(adminDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "admin did is invalid")
+	} else if !did.IsValidDid(oracleDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "oracle did is invalid")
+	}
+
+	return nil
+}
+
+type MsgAddOracle struct {
+	AdminDid     did.Did         `json:"admin_did" yaml:"admin_did"`
+	OracleDid    did.Did         `json:"oracle_did" yaml:"oracle_did"`
+	Capabilities OracleTokenCaps `json:"capabilities" yaml:"capabilities"`
+	ProofScheme  ProofScheme     `json:"proof_scheme" yaml:"proof_scheme"`
+	ProofKey     string          `json:"proof_key" yaml:"proof_key"`
+}
+
+func NewMsgAddOracle(oracleDid did.Did, caps OracleTokenCaps, proofScheme ProofScheme,
+	proofKey string, adminDid did.Did) MsgAddOracle {
+	return MsgAddOracle{
+		AdminDid:     adminDid,
+		OracleDid:    oracleDid,
+		Capabilities: caps,
+		ProofScheme:  proofScheme,
+		ProofKey:     proofKey,
+	}
+}
+
+func (msg MsgAddOracle) Type() string  { return TypeMsgAddOracle }
+func (msg MsgAddOracle) Route() string { return RouterKey }
+func (msg MsgAddOracle) ValidateBasic() sdk.Error {
+	if err := validateAdminAndOracleDids(msg.AdminDid, msg.OracleDid); err != nil {
+		return err
+	} else if err := msg.Capabilities.Validate(); err != nil {
+		return ErrInvalidOracle(DefaultCodespace, err.Error())
+	} else if msg.ProofScheme.IsNone() && len(msg.ProofKey) != 0 {
+		return ErrInvalidProof(DefaultCodespace, "proof key specified without a proof scheme")
+	}
+	return nil
+}
+
+func (msg MsgAddOracle) GetSignerDid() did.Did { return msg.AdminDid }
+func (msg MsgAddOracle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgAddOracle) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgAddOracle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+type MsgUpdateOracle struct {
+	AdminDid     did.Did         `json:"admin_did" yaml:"admin_did"`
+	OracleDid    did.Did         `json:"oracle_did" yaml:"oracle_did"`
+	Capabilities OracleTokenCaps `json:"capabilities" yaml:"capabilities"`
+	ProofScheme  ProofScheme     `json:"proof_scheme" yaml:"proof_scheme"`
+	ProofKey     string          `json:"proof_key" yaml:"proof_key"`
+}
+
+func NewMsgUpdateOracle(oracleDid did.Did, caps OracleTokenCaps, proofScheme ProofScheme,
+	proofKey string, adminDid did.Did) MsgUpdateOracle {
+	return MsgUpdateOracle{
+		AdminDid:     adminDid,
+		OracleDid:    oracleDid,
+		Capabilities: caps,
+		ProofScheme:  proofScheme,
+		ProofKey:     proofKey,
+	}
+}
+
+func (msg MsgUpdateOracle) Type() string  { return TypeMsgUpdateOracle }
+func (msg MsgUpdateOracle) Route() string { return RouterKey }
+func (msg MsgUpdateOracle) ValidateBasic() sdk.Error {
+	if err := validateAdminAndOracleDids(msg.AdminDid, msg.OracleDid); err != nil {
+		return err
+	} else if err := msg.Capabilities.Validate(); err != nil {
+		return ErrInvalidOracle(DefaultCodespace, err.Error())
+	} else if msg.ProofScheme.IsNone() && len(msg.ProofKey) != 0 {
+		return ErrInvalidProof(DefaultCodespace, "proof key specified without a proof scheme")
+	}
+	return nil
+}
+
+func (msg MsgUpdateOracle) GetSignerDid() did.Did { return msg.AdminDid }
+func (msg MsgUpdateOracle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgUpdateOracle) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgUpdateOracle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+type MsgSuspendOracle struct {
+	AdminDid  did.Did `json:"admin_did" yaml:"admin_did"`
+	OracleDid did.Did `json:"oracle_did" yaml:"oracle_did"`
+}
+
+func NewMsgSuspendOracle(oracleDid, adminDid did.Did) MsgSuspendOracle {
+	return MsgSuspendOracle{
+		AdminDid:  adminDid,
+		OracleDid: oracleDid,
+	}
+}
+
+func (msg MsgSuspendOracle) Type() string  { return TypeMsgSuspendOracle }
+func (msg MsgSuspendOracle) Route() string { return RouterKey }
+func (msg MsgSuspendOracle) ValidateBasic() sdk.Error {
+	return validateAdminAndOracleDids(msg.AdminDid, msg.OracleDid)
+}
+
+func (msg MsgSuspendOracle) GetSignerDid() did.Did { return msg.AdminDid }
+func (msg MsgSuspendOracle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgSuspendOracle) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgSuspendOracle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+type MsgResumeOracle struct {
+	AdminDid  did.Did `json:"admin_did" yaml:"admin_did"`
+	OracleDid did.Did `json:"oracle_did" yaml:"oracle_did"`
+}
+
+func NewMsgResumeOracle(oracleDid, adminDid did.Did) MsgResumeOracle {
+	return MsgResumeOracle{
+		AdminDid:  adminDid,
+		OracleDid: oracleDid,
+	}
+}
+
+func (msg MsgResumeOracle) Type() string  { return TypeMsgResumeOracle }
+func (msg MsgResumeOracle) Route() string { return RouterKey }
+func (msg MsgResumeOracle) ValidateBasic() sdk.Error {
+	return validateAdminAndOracleDids(msg.AdminDid, msg.OracleDid)
+}
+
+func (msg MsgResumeOracle) GetSignerDid() did.Did { return msg.AdminDid }
+func (msg MsgResumeOracle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgResumeOracle) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgResumeOracle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+type MsgRemoveOracle struct {
+	AdminDid  did.Did `json:"admin_did" yaml:"admin_did"`
+	OracleDid did.Did `json:"oracle_did" yaml:"oracle_did"`
+}
+
+func NewMsgRemoveOracle(oracleDid, adminDid did.Did) MsgRemoveOracle {
+	return MsgRemoveOracle{
+		AdminDid:  adminDid,
+		OracleDid: oracleDid,
+	}
+}
+
+func (msg MsgRemoveOracle) Type() string  { return TypeMsgRemoveOracle }
+func (msg MsgRemoveOracle) Route() string { return RouterKey }
+func (msg MsgRemoveOracle) ValidateBasic() sdk.Error {
+	return validateAdminAndOracleDids(msg.AdminDid, msg.OracleDid)
+}
+
+func (msg MsgRemoveOracle) GetSignerDid() did.Did { return msg.AdminDid }
+func (msg MsgRemoveOracle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgRemoveOracle) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgRemoveOracle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func CheckNotEmpty(value string, name string) (valid bool, err sdk.Error) {
+	if strings.TrimSpace(value) == "" {
+		return false, sdk.ErrUnknownRequest(name + " is empty.")
+	} else {
+		return true, nil
+	}
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+)
+
+// Parameter store keys
+var (
+	KeyAdminDids = []byte("AdminDids")
+)
+
+// oracles parameters
+type Params struct {
+	// The DIDs that are allowed to add, update, suspend, resume and remove
+	// oracles. Can be changed through governance.
+	AdminDids []exported.Did `json:"admin_dids" yaml:"admin_dids"`
+}
+
+// ParamTable for oracles module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(adminDids []exported.Did) Params {
+	return Params{
+		AdminDids: adminDids,
+	}
+}
+
+// default oracles module parameters
+func DefaultParams() Params {
+	return Params{
+		AdminDids: []exported.Did{}, // no admins
+	}
+}
+
+// validate params
+func ValidateParams(params Params) error {
+	seen := make(map[exported.Did]bool)
+	for _, adminDid := range params.AdminDids {
+		if !did.IsValidDid(adminDid) {
+			return fmt.Errorf("admin did %s is invalid", adminDid)
+		} else if seen[adminDid] {
+			return fmt.Errorf("admin did %s is duplicate", adminDid)
+		}
+		seen[adminDid] = true
+	}
+	return nil
+}
+
+// IsAdmin True if the DID is one of the oracle admins
+func (p Params) IsAdmin(adminDid exported.Did) bool {
+	for _, a := range p.AdminDids {
+		if a == adminDid {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Oracles Params:
+  Admin Dids: %s
+`, strings.Join(p.AdminDids, ", "))
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyAdminDids, Value: &p.AdminDids},
+	}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+)
+
+// --------------------------------------- Price/s
+
+// Price is the latest price of a token submitted by an oracle, expressed as
+// the amount of the reference unit (e.g. usd) that one unit of the token
+// (e.g. uixo) is worth.
+type (
+	Price struct {
+		Denom         string       `json:"denom" yaml:"denom"`
+		ReferenceUnit string       `json:"reference_unit" yaml:"reference_unit"`
+		Price         sdk.Dec      `json:"price" yaml:"price"`
+		OracleDid     exported.Did `json:"oracle_did" yaml:"oracle_did"`
+		Height        int64        `json:"height" yaml:"height"`
+		Time          time.Time    `json:"time" yaml:"time"`
+	}
+	Prices []Price
+)
+
+func NewPrice(ctx sdk.Context, denom, referenceUnit string, price sdk.Dec,
+	oracleDid exported.Did) Price {
+	return Price{
+		Denom:         denom,
+		ReferenceUnit: referenceUnit,
+		Price:         price,
+		OracleDid:     oracleDid,
+		Height:        ctx.BlockHeight(),
+		Time:          ctx.BlockTime(),
+	}
+}
+
+func (p Price) Validate() sdk.Error {
+	if !IsValidDenom(p.Denom) {
+		return ErrInvalidPrice(DefaultCodespace, "invalid denom")
+	} else if !IsValidDenom(p.ReferenceUnit) {
+		return ErrInvalidPrice(DefaultCodespace, "invalid reference unit")
+	} else if p.Price.IsNil() || !p.Price.IsPositive() {
+		return ErrInvalidPrice(DefaultCodespace, "price must be positive")
+	} else if len(p.OracleDid) == 0 {
+		return ErrInvalidPrice(DefaultCodespace, "empty oracle did")
+	}
+
+	return nil
+}
+
+// IsFresh True if the price was submitted no longer than maxAge ago
+func (p Price) IsFresh(ctx sdk.Context, maxAge time.Duration) bool {
+	return ctx.BlockTime().Sub(p.Time) <= maxAge
+}
+
+// Fresh returns the prices submitted no longer than maxAge ago
+func (ps Prices) Fresh(ctx sdk.Context, maxAge time.Duration) (fresh Prices) {
+	for _, p := range ps {
+		if p.IsFresh(ctx, maxAge) {
+			fresh = append(fresh, p)
+		}
+	}
+	return fresh
+}
+
+// Median returns the median of the prices (the mean of the middle two prices
+// if there is an even number of prices). Panics if there are no prices.
+func (ps Prices) Median() sdk.Dec {
+	if len(ps) == 0 {
+		panic("median of empty list of prices")
+	}
+
+	values := make([]sdk.Dec, len(ps))
+	for i, p := range ps {
+		values[i] = p.Price
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].LT(values[j]) })
+
+	mid := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[mid]
+	}
+	return values[mid-1].Add(values[mid]).QuoInt64(2)
+}
+
+// IsValidDenom True if the denom is valid according to the SDK coin rules
+func IsValidDenom(denom string) bool {
+	return sdk.Coin{Denom: denom, Amount: sdk.ZeroInt()}.IsValid()
+}
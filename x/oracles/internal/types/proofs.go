@@ -0,0 +1,255 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+)
+
+// --------------------------------------- ProofScheme
+
+// ProofScheme is the way in which the proofs backing an oracle's treasury
+// operations (mints, burns and transfers) are verified. An oracle with no
+// proof scheme (or the none scheme) does not have its proofs verified.
+type ProofScheme string
+
+const (
+	ProofSchemeNone         ProofScheme = "none"
+	ProofSchemeEd25519      ProofScheme = "ed25519"
+	ProofSchemeMerkle       ProofScheme = "merkle"
+	ProofSchemeHashPreimage ProofScheme = "hash"
+)
+
+// IsNone True if proofs are not verified under the scheme
+func (s ProofScheme) IsNone() bool {
+	return s == "" || s == ProofSchemeNone
+}
+
+// --------------------------------------- ProofStatement
+
+// ProofStatement is the treasury operation that a proof is expected to back.
+// The nonce is taken from the proof, so that the same operation can be backed
+// more than once, with a different proof each time. The chain ID is that of
+// the chain on which the operation is performed (see VerifyOracleProof), so
+// that a proof cannot be replayed on another chain with the same oracle.
+type ProofStatement struct {
+	OracleDid   exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	Operation   TokenCap     `json:"operation" yaml:"operation"`
+	FromDid     exported.Did `json:"from_did" yaml:"from_did"`
+	ToDidOrAddr string       `json:"to_did" yaml:"to_did"`
+	Amount      sdk.Coins    `json:"amount" yaml:"amount"`
+	Nonce       string       `json:"nonce" yaml:"nonce"`
+	ChainId     string       `json:"chain_id" yaml:"chain_id"`
+}
+
+func NewProofStatement(oracleDid exported.Did, operation TokenCap,
+	fromDid exported.Did, toDidOrAddr string, amount sdk.Coins) ProofStatement {
+	return ProofStatement{
+		OracleDid:   oracleDid,
+		Operation:   operation,
+		FromDid:     fromDid,
+		ToDidOrAddr: toDidOrAddr,
+		Amount:      amount,
+	}
+}
+
+// GetSignBytes returns the bytes that are signed (for the ed25519 scheme) or
+// hashed into a Merkle tree leaf (for the merkle scheme)
+func (s ProofStatement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(s))
+}
+
+// splitNonce splits a proof of the form <nonce>:<data>
+func splitNonce(proof string) (nonce, data string, err error) {
+	parts := strings.SplitN(proof, ":", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 {
+		return "", "", fmt.Errorf("proof must be of the form <nonce>:<data>")
+	}
+	return parts[0], parts[1], nil
+}
+
+// --------------------------------------- ProofVerifier
+
+// VerifiedProof is the result of verifying a proof. The hash identifies the
+// proof and is recorded so that the proof cannot be replayed. If the next
+// proof key is not empty, it replaces the oracle's proof key.
+type VerifiedProof struct {
+	Hash         []byte
+	NextProofKey string
+}
+
+// ProofVerifier verifies the proofs of a proof scheme. The proof key is the
+// oracle's public key, root or commitment, depending on the scheme.
+type ProofVerifier interface {
+	ValidateProofKey(proofKey string) error
+	VerifyProof(proofKey string, statement ProofStatement, proof string) (VerifiedProof, error)
+}
+
+// DefaultProofVerifiers returns the verifiers of the built-in proof schemes
+func DefaultProofVerifiers() map[ProofScheme]ProofVerifier {
+	return map[ProofScheme]ProofVerifier{
+		ProofSchemeEd25519:      Ed25519ProofVerifier{},
+		ProofSchemeMerkle:       MerkleProofVerifier{},
+		ProofSchemeHashPreimage: HashPreimageProofVerifier{},
+	}
+}
+
+// ValidateProofScheme checks that the proof scheme has a verifier (or is the
+// none scheme) and that the proof key is valid for the scheme
+func ValidateProofScheme(scheme ProofScheme, proofKey string,
+	verifiers map[ProofScheme]ProofVerifier) error {
+	if scheme.IsNone() {
+		if len(proofKey) != 0 {
+			return fmt.Errorf("proof key specified without a proof scheme")
+		}
+		return nil
+	}
+
+	verifier, ok := verifiers[scheme]
+	if !ok {
+		return fmt.Errorf("unknown proof scheme %s", scheme)
+	}
+	return verifier.ValidateProofKey(proofKey)
+}
+
+// Ed25519ProofVerifier verifies attestations signed using an off-chain ed25519
+// key. The proof key is the base58-encoded public key and proofs are of the
+// form <nonce>:<base64-encoded signature of the statement>.
+type Ed25519ProofVerifier struct{}
+
+func (Ed25519ProofVerifier) ValidateProofKey(proofKey string) error {
+	if !did.IsValidPubKey(proofKey) {
+		return fmt.Errorf("proof key is not a valid base58 ed25519 public key")
+	}
+	return nil
+}
+
+func (Ed25519ProofVerifier) VerifyProof(proofKey string,
+	statement ProofStatement, proof string) (VerifiedProof, error) {
+	nonce, sigStr, err := splitNonce(proof)
+	if err != nil {
+		return VerifiedProof{}, err
+	}
+	sig, err := base64.StdEncoding.DecodeString(sigStr)
+	if err != nil {
+		return VerifiedProof{}, fmt.Errorf("signature is not valid base64")
+	}
+
+	statement.Nonce = nonce
+	signBytes := statement.GetSignBytes()
+	if !exported.VerifyKeyToPubKey(proofKey).VerifyBytes(signBytes, sig) {
+		return VerifiedProof{}, fmt.Errorf("signature verification failed")
+	}
+
+	hash := sha256.Sum256(signBytes)
+	return VerifiedProof{Hash: hash[:]}, nil
+}
+
+// MerkleProofVerifier verifies that the statement is included in a Merkle tree
+// with a committed root. The proof key is the hex-encoded root and proofs are
+// of the form <nonce>:[<hex-encoded sibling>[,<hex-encoded sibling>]]. Leaves
+// are the SHA-256 hash of the statement and each pair of nodes is hashed in
+// sorted order, so that proofs do not need to specify positions.
+type MerkleProofVerifier struct{}
+
+func (MerkleProofVerifier) ValidateProofKey(proofKey string) error {
+	if root, err := hex.DecodeString(proofKey); err != nil || len(root) != sha256.Size {
+		return fmt.Errorf("proof key is not a hex-encoded SHA-256 root")
+	}
+	return nil
+}
+
+func (MerkleProofVerifier) VerifyProof(proofKey string,
+	statement ProofStatement, proof string) (VerifiedProof, error) {
+	nonce, siblingsStr, err := splitNonce(proof)
+	if err != nil {
+		return VerifiedProof{}, err
+	}
+
+	statement.Nonce = nonce
+	leaf := sha256.Sum256(statement.GetSignBytes())
+
+	node := leaf[:]
+	if len(siblingsStr) > 0 {
+		for _, siblingStr := range strings.Split(siblingsStr, ",") {
+			sibling, err := hex.DecodeString(siblingStr)
+			if err != nil || len(sibling) != sha256.Size {
+				return VerifiedProof{}, fmt.Errorf("sibling %s is not a hex-encoded SHA-256 hash", siblingStr)
+			}
+			node = hashMerklePair(node, sibling)
+		}
+	}
+
+	root, _ := hex.DecodeString(proofKey)
+	if !bytes.Equal(node, root) {
+		return VerifiedProof{}, fmt.Errorf("statement is not included in the committed root")
+	}
+
+	return VerifiedProof{Hash: leaf[:]}, nil
+}
+
+func hashMerklePair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	hash := sha256.Sum256(append(append([]byte{}, a...), b...))
+	return hash[:]
+}
+
+// HashPreimageProofVerifier verifies that a proof is the preimage of the
+// oracle's committed hash. The proof key is the hex-encoded SHA-256 hash and
+// proofs are the hex-encoded preimage (itself a SHA-256 hash), which then
+// becomes the oracle's proof key, so that the oracle can reveal a hash chain
+// one link at a time. Proofs under this scheme are not bound to the statement.
+type HashPreimageProofVerifier struct{}
+
+func (HashPreimageProofVerifier) ValidateProofKey(proofKey string) error {
+	if hash, err := hex.DecodeString(proofKey); err != nil || len(hash) != sha256.Size {
+		return fmt.Errorf("proof key is not a hex-encoded SHA-256 hash")
+	}
+	return nil
+}
+
+func (HashPreimageProofVerifier) VerifyProof(proofKey string,
+	_ ProofStatement, proof string) (VerifiedProof, error) {
+	preimage, err := hex.DecodeString(proof)
+	if err != nil {
+		return VerifiedProof{}, fmt.Errorf("proof is not a hex-encoded preimage")
+	} else if len(preimage) != sha256.Size {
+		// The preimage becomes the next proof key, so it must be a valid one
+		return VerifiedProof{}, fmt.Errorf("proof is not a hex-encoded SHA-256 hash")
+	}
+
+	hash := sha256.Sum256(preimage)
+	if hex.EncodeToString(hash[:]) != strings.ToLower(proofKey) {
+		return VerifiedProof{}, fmt.Errorf("proof is not the preimage of the committed hash")
+	}
+
+	return VerifiedProof{Hash: hash[:], NextProofKey: hex.EncodeToString(preimage)}, nil
+}
+
+// --------------------------------------- UsedProof
+
+// UsedProof records the hash of a proof that has been used by an oracle
+type UsedProof struct {
+	Hash      string       `json:"hash" yaml:"hash"`
+	OracleDid exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	Height    int64        `json:"height" yaml:"height"`
+}
+
+func (p UsedProof) Validate() sdk.Error {
+	if hash, err := hex.DecodeString(p.Hash); err != nil || len(hash) == 0 {
+		return ErrInvalidProof(DefaultCodespace, "used proof hash is not hex-encoded")
+	} else if len(p.OracleDid) == 0 {
+		return ErrInvalidProof(DefaultCodespace, "empty oracle did in used proof")
+	}
+	return nil
+}
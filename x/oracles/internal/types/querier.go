@@ -0,0 +1,22 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// QueryOracleResponse is an oracle together with the changes made to it
+type QueryOracleResponse struct {
+	Oracle  Oracle        `json:"oracle" yaml:"oracle"`
+	History OracleHistory `json:"history" yaml:"history"`
+}
+
+// QueryOracleUsageResponse is the amount of a token that an oracle has used
+// through one of its capabilities, its limits, and the largest amount that it
+// can currently use in a single transaction (if it has any limits)
+type QueryOracleUsageResponse struct {
+	Denom        string            `json:"denom" yaml:"denom"`
+	Capability   TokenCap          `json:"capability" yaml:"capability"`
+	Limits       OracleTokenLimits `json:"limits" yaml:"limits"`
+	WindowUsed   sdk.Int           `json:"window_used" yaml:"window_used"`
+	LifetimeUsed sdk.Int           `json:"lifetime_used" yaml:"lifetime_used"`
+	Unlimited    bool              `json:"unlimited" yaml:"unlimited"`
+	Headroom     sdk.Int           `json:"headroom" yaml:"headroom"`
+}
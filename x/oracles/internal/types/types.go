@@ -2,8 +2,10 @@ package types
 
 import (
 	"fmt"
-	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
 	"strings"
+
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
 )
 
 // --------------------------------------- Oracle/s
@@ -12,6 +14,9 @@ type (
 	Oracle struct {
 		OracleDid    exported.Did    `json:"oracle_did" yaml:"oracle_did"`
 		Capabilities OracleTokenCaps `json:"capabilities" yaml:"capabilities"`
+		Status       OracleStatus    `json:"status" yaml:"status"`
+		ProofScheme  ProofScheme     `json:"proof_scheme" yaml:"proof_scheme"`
+		ProofKey     string          `json:"proof_key" yaml:"proof_key"`
 	}
 	Oracles []Oracle
 )
@@ -20,9 +25,29 @@ func NewOracle(oracleDid exported.Did, caps OracleTokenCaps) Oracle {
 	return Oracle{
 		OracleDid:    oracleDid,
 		Capabilities: caps,
+		Status:       OracleStatusActive,
+		ProofScheme:  ProofSchemeNone,
+		ProofKey:     "",
 	}
 }
 
+// IsActive True if the oracle is not suspended. Oracles registered before
+// statuses were introduced have an empty status and are considered active.
+func (o Oracle) IsActive() bool {
+	return o.Status != OracleStatusSuspended
+}
+
+func (o Oracle) Validate() error {
+	if !did.IsValidDid(o.OracleDid) {
+		return fmt.Errorf("oracle did %s is invalid", o.OracleDid)
+	} else if !o.Status.IsValid() {
+		return fmt.Errorf("oracle %s has invalid status %s", o.OracleDid, o.Status)
+	} else if err := o.Capabilities.Validate(); err != nil {
+		return fmt.Errorf("oracle %s: %s", o.OracleDid, err)
+	}
+	return nil
+}
+
 func (os Oracles) Includes(oracle Oracle) bool {
 	for _, o := range os {
 		if oracle.OracleDid == o.OracleDid {
@@ -32,20 +57,35 @@ func (os Oracles) Includes(oracle Oracle) bool {
 	return false
 }
 
+// --------------------------------------- OracleStatus
+
+type OracleStatus string
+
+const (
+	OracleStatusActive    OracleStatus = "active"
+	OracleStatusSuspended OracleStatus = "suspended"
+)
+
+func (s OracleStatus) IsValid() bool {
+	return s == "" || s == OracleStatusActive || s == OracleStatusSuspended
+}
+
 // --------------------------------------- OracleTokenCap/s
 
 type (
 	OracleTokenCap struct {
-		Denom        string    `json:"denom" yaml:"denom"`
-		Capabilities TokenCaps `json:"capabilities" yaml:"capabilities"`
+		Denom        string            `json:"denom" yaml:"denom"`
+		Capabilities TokenCaps         `json:"capabilities" yaml:"capabilities"`
+		Limits       OracleTokenLimits `json:"limits" yaml:"limits"`
 	}
 	OracleTokenCaps []OracleTokenCap
 )
 
-func NewOracleTokenCap(denom string, caps TokenCaps) OracleTokenCap {
+func NewOracleTokenCap(denom string, caps TokenCaps, limits OracleTokenLimits) OracleTokenCap {
 	return OracleTokenCap{
 		Denom:        denom,
 		Capabilities: caps,
+		Limits:       limits,
 	}
 }
 
@@ -58,6 +98,46 @@ func (otcs OracleTokenCaps) Includes(denom string) bool {
 	return false
 }
 
+// Validate checks that all denoms are valid and unique and that each denom
+// has at least one valid capability
+func (otcs OracleTokenCaps) Validate() error {
+	seen := make(map[string]bool)
+	for _, oc := range otcs {
+		if !IsValidDenom(oc.Denom) {
+			return fmt.Errorf("invalid denom: %s", oc.Denom)
+		} else if seen[oc.Denom] {
+			return fmt.Errorf("duplicate denom: %s", oc.Denom)
+		} else if len(oc.Capabilities) == 0 {
+			return fmt.Errorf("no capabilities for denom: %s", oc.Denom)
+		}
+		for _, c := range oc.Capabilities {
+			if !c.IsValid() {
+				return fmt.Errorf("invalid capability: %s", c)
+			}
+		}
+		if err := oc.Limits.Validate(); err != nil {
+			return fmt.Errorf("invalid limits for denom %s: %s", oc.Denom, err)
+		}
+		seen[oc.Denom] = true
+	}
+	return nil
+}
+
+func (otcs OracleTokenCaps) String() string {
+	strs := make([]string, len(otcs))
+	for i, oc := range otcs {
+		capStrs := make([]string, len(oc.Capabilities))
+		for j, c := range oc.Capabilities {
+			capStrs[j] = string(c)
+		}
+		strs[i] = oc.Denom + ":" + strings.Join(capStrs, "/")
+		if !oc.Limits.IsUnlimited() {
+			strs[i] += ":" + oc.Limits.String()
+		}
+	}
+	return strings.Join(strs, ",")
+}
+
 func (otcs OracleTokenCaps) MustGet(denom string) OracleTokenCap {
 	for _, oc := range otcs {
 		if oc.Denom == denom {
@@ -87,12 +167,16 @@ func ParseOracleTokenCap(capStr string) (OracleTokenCap, error) {
 	capStr = strings.TrimSpace(capStr)
 
 	capsStrs := strings.Split(capStr, ":")
-	if len(capsStrs) != 2 {
+	if len(capsStrs) != 2 && len(capsStrs) != 3 {
 		return OracleTokenCap{}, fmt.Errorf("invalid capability: %s", capStr)
 	}
 
 	denom := capsStrs[0]
 	capsStr := capsStrs[1]
+	limitsStr := ""
+	if len(capsStrs) == 3 {
+		limitsStr = capsStrs[2]
+	}
 
 	if len(denom) == 0 {
 		return OracleTokenCap{}, fmt.Errorf("invalid empty token: %s", capStr)
@@ -103,7 +187,12 @@ func ParseOracleTokenCap(capStr string) (OracleTokenCap, error) {
 		return OracleTokenCap{}, err
 	}
 
-	return NewOracleTokenCap(denom, tokenCaps), nil
+	limits, err := ParseOracleTokenLimits(limitsStr)
+	if err != nil {
+		return OracleTokenCap{}, err
+	}
+
+	return NewOracleTokenCap(denom, tokenCaps, limits), nil
 }
 
 func ParseOracleTokenCaps(capsStr string) (OracleTokenCaps, error) {
@@ -123,7 +212,9 @@ func ParseOracleTokenCaps(capsStr string) (OracleTokenCaps, error) {
 		caps[i] = capability
 	}
 
-	// TODO: consider sorting and validating
+	if err := caps.Validate(); err != nil {
+		return nil, err
+	}
 
 	return caps, nil
 }
@@ -148,8 +239,9 @@ const (
 	MintCap     TokenCap = "mint"
 	BurnCap     TokenCap = "burn"
 	TransferCap TokenCap = "transfer"
+	PriceCap    TokenCap = "price"
 )
 
 func (tc TokenCap) IsValid() bool {
-	return tc == MintCap || tc == BurnCap || tc == TransferCap
+	return tc == MintCap || tc == BurnCap || tc == TransferCap || tc == PriceCap
 }
@@ -0,0 +1,135 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
+)
+
+// --------------------------------------- OracleTokenUsage
+
+// OracleUsageEntry is the amount of a token used by an oracle in a block
+type OracleUsageEntry struct {
+	Height int64   `json:"height" yaml:"height"`
+	Amount sdk.Int `json:"amount" yaml:"amount"`
+}
+
+// OracleTokenUsage is the amount of a token that an oracle has used (minted,
+// burned or transferred) through one of its capabilities. Recent only holds
+// the usage within the largest rolling window that has ever applied to the
+// oracle's usage of the token (RetainBlocks), so that the window can later be
+// raised up to that size without losing track of usage that is within it.
+type OracleTokenUsage struct {
+	OracleDid    exported.Did       `json:"oracle_did" yaml:"oracle_did"`
+	Denom        string             `json:"denom" yaml:"denom"`
+	Capability   TokenCap           `json:"capability" yaml:"capability"`
+	Lifetime     sdk.Int            `json:"lifetime" yaml:"lifetime"`
+	Recent       []OracleUsageEntry `json:"recent" yaml:"recent"`
+	RetainBlocks int64              `json:"retain_blocks,omitempty" yaml:"retain_blocks"`
+}
+
+func NewOracleTokenUsage(oracleDid exported.Did, denom string,
+	capability TokenCap) OracleTokenUsage {
+	return OracleTokenUsage{
+		OracleDid:  oracleDid,
+		Denom:      denom,
+		Capability: capability,
+		Lifetime:   sdk.ZeroInt(),
+		Recent:     nil,
+	}
+}
+
+// windowStart returns the first height that is within a window of
+// windowBlocks blocks ending at (and including) the specified height
+func windowStart(height, windowBlocks int64) int64 {
+	return height - windowBlocks + 1
+}
+
+// WindowTotal returns the amount used in the window of windowBlocks blocks
+// ending at (and including) the specified height
+func (u OracleTokenUsage) WindowTotal(height, windowBlocks int64) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, e := range u.Recent {
+		if e.Height >= windowStart(height, windowBlocks) {
+			total = total.Add(e.Amount)
+		}
+	}
+	return total
+}
+
+// Retain extends the recent usage that is kept to windows of windowBlocks
+// blocks, if this is larger than any window retained so far
+func (u OracleTokenUsage) Retain(windowBlocks int64) OracleTokenUsage {
+	if windowBlocks > u.RetainBlocks {
+		u.RetainBlocks = windowBlocks
+	}
+	return u
+}
+
+// Add records that the amount was used at the specified height, discarding
+// the recent usage that is no longer within the largest window retained,
+// which is extended to windowBlocks blocks if necessary
+func (u OracleTokenUsage) Add(height, windowBlocks int64, amount sdk.Int) OracleTokenUsage {
+	u = u.Retain(windowBlocks)
+	u.Lifetime = u.Lifetime.Add(amount)
+
+	var recent []OracleUsageEntry
+	for _, e := range u.Recent {
+		if e.Height >= windowStart(height, u.RetainBlocks) {
+			recent = append(recent, e)
+		}
+	}
+	if u.RetainBlocks > 0 {
+		if len(recent) > 0 && recent[len(recent)-1].Height == height {
+			recent[len(recent)-1].Amount = recent[len(recent)-1].Amount.Add(amount)
+		} else {
+			recent = append(recent, OracleUsageEntry{Height: height, Amount: amount})
+		}
+	}
+	u.Recent = recent
+
+	return u
+}
+
+// Headroom returns the largest amount that can currently be used in a single
+// transaction given the limits, and false if there is no limit
+func (u OracleTokenUsage) Headroom(height int64, limits OracleTokenLimits) (sdk.Int, bool) {
+	var headroom sdk.Int
+	limited := false
+
+	min := func(value sdk.Int) {
+		if value.IsNegative() {
+			value = sdk.ZeroInt()
+		}
+		if !limited || value.LT(headroom) {
+			headroom = value
+		}
+		limited = true
+	}
+
+	if limits.HasMaxPerTx() {
+		min(limits.MaxPerTx)
+	}
+	if limits.HasMaxPerWindow() {
+		min(limits.MaxPerWindow.Sub(u.WindowTotal(height, limits.WindowBlocks)))
+	}
+	if limits.HasLifetimeMax() {
+		min(limits.LifetimeMax.Sub(u.Lifetime))
+	}
+
+	return headroom, limited
+}
+
+func (u OracleTokenUsage) Validate() sdk.Error {
+	if len(u.OracleDid) == 0 {
+		return ErrInvalidOracle(DefaultCodespace, "empty oracle did in usage")
+	} else if !IsValidDenom(u.Denom) {
+		return ErrInvalidOracle(DefaultCodespace, "invalid denom in usage")
+	} else if !u.Capability.IsValid() {
+		return ErrInvalidOracle(DefaultCodespace, "invalid capability in usage")
+	} else if u.Lifetime == (sdk.Int{}) || u.Lifetime.IsNegative() {
+		return ErrInvalidOracle(DefaultCodespace, "invalid lifetime usage")
+	} else if u.RetainBlocks < 0 {
+		return ErrInvalidOracle(DefaultCodespace, "invalid retained window in usage")
+	}
+	return nil
+}
@@ -39,7 +39,12 @@ func (AppModuleBasic) DefaultGenesis() json.RawMessage {
 }
 
 func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
-	return nil
+	var data GenesisState
+	err := ModuleCdc.UnmarshalJSON(bz, &data)
+	if err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
 }
 
 func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
@@ -47,7 +52,24 @@ func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router
 }
 
 func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
-	return nil
+	oraclesTxCmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      "oracles transaction sub commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	oraclesTxCmd.AddCommand(client.PostCommands(
+		cli.GetCmdSubmitPrice(cdc),
+		cli.GetCmdAddOracle(cdc),
+		cli.GetCmdUpdateOracle(cdc),
+		cli.GetCmdSuspendOracle(cdc),
+		cli.GetCmdResumeOracle(cdc),
+		cli.GetCmdRemoveOracle(cdc),
+	)...)
+
+	return oraclesTxCmd
 }
 
 func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
@@ -61,6 +83,11 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	oraclesQueryCmd.AddCommand(client.GetCommands(
 		cli.GetOraclesRequestHandler(cdc),
+		cli.GetCmdOracleHistory(cdc),
+		cli.GetCmdOracleUsage(cdc),
+		cli.GetCmdProofStatement(cdc),
+		cli.GetCmdPrices(cdc),
+		cli.GetCmdParams(cdc),
 	)...)
 
 	return oraclesQueryCmd
@@ -90,7 +117,7 @@ func (AppModule) Route() string {
 }
 
 func (am AppModule) NewHandler() sdk.Handler {
-	return nil
+	return NewHandler(am.keeper)
 }
 
 func (AppModule) QuerierRoute() string {
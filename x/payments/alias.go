@@ -22,6 +22,11 @@ const (
 
 	FeeClaimTransaction      = types.FeeClaimTransaction
 	FeeEvaluationTransaction = types.FeeEvaluationTransaction
+
+	RoundingCarryForward     = types.RoundingCarryForward
+	RoundingLargestRemainder = types.RoundingLargestRemainder
+	RoundingFirstRecipient   = types.RoundingFirstRecipient
+	DefaultRoundingPolicy    = types.DefaultRoundingPolicy
 )
 
 type (
@@ -31,6 +36,8 @@ type (
 	FeeType = types.FeeType
 
 	PaymentTemplate   = types.PaymentTemplate
+	RoundingPolicy    = types.RoundingPolicy
+	OraclePricing     = types.OraclePricing
 	PaymentContract   = types.PaymentContract
 	Distribution      = types.Distribution
 	DistributionShare = types.DistributionShare
@@ -38,6 +45,9 @@ type (
 	Discount  = types.Discount
 	Discounts = types.Discounts
 
+	PaymentReceipt      = types.PaymentReceipt
+	ReceiptDistribution = types.ReceiptDistribution
+
 	Subscription = types.Subscription
 	Period       = types.Period
 	BlockPeriod  = types.BlockPeriod
@@ -50,6 +60,7 @@ type (
 	MsgGrantDiscount                   = types.MsgGrantDiscount
 	MsgRevokeDiscount                  = types.MsgRevokeDiscount
 	MsgEffectPayment                   = types.MsgEffectPayment
+	MsgApplyPromoCode                  = types.MsgApplyPromoCode
 )
 
 var (
@@ -64,13 +75,19 @@ var (
 	ValidateGenesis     = types.ValidateGenesis
 
 	NewPaymentTemplate           = types.NewPaymentTemplate
+	NewOraclePricing             = types.NewOraclePricing
 	NewPaymentContract           = types.NewPaymentContract
 	NewPaymentContractNoDiscount = types.NewPaymentContractNoDiscount
 	NewDistribution              = types.NewDistribution
 	NewDistributionShare         = types.NewDistributionShare
 
-	NewDiscount  = types.NewDiscount
-	NewDiscounts = types.NewDiscounts
+	NewDiscount        = types.NewDiscount
+	NewLimitedDiscount = types.NewLimitedDiscount
+	NewDiscounts       = types.NewDiscounts
+	HashPromoCode      = types.HashPromoCode
+
+	NewPaymentReceipt      = types.NewPaymentReceipt
+	NewReceiptDistribution = types.NewReceiptDistribution
 
 	NewSubscription = types.NewSubscription
 	NewBlockPeriod  = types.NewBlockPeriod
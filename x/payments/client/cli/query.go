@@ -1,7 +1,11 @@
 package cli
 
 import (
+	"encoding/csv"
 	"fmt"
+	"os"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
@@ -135,3 +139,213 @@ func GetCmdSubscription(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetCmdPaymentReceipts(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "payment-receipts [payment-contract-id] [page] [limit]",
+		Short: "Query receipts of payments effected for a payment contract",
+		Args:  cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var out []types.PaymentReceipt
+			err := queryPaginatedList(cliCtx, cdc,
+				keeper.QueryPaymentReceipts, args, &out)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			output, err := cdc.MarshalJSONIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdExportPaymentReceipts(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "export-payment-receipts [payment-contract-id] [output-file]",
+		Short: "Export all receipts of a payment contract to a CSV file",
+		Long: `Export all receipts of a payment contract to a CSV file. One row is
+written for each recipient of each payment, so that the file can be used to
+reconcile amounts received by every address in the wallet distribution.
+Payments that were not distributed to any recipient are written as a single
+row with empty recipient columns.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			contractId := args[0]
+			outputFile := args[1]
+
+			file, err := os.Create(outputFile)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			w := csv.NewWriter(file)
+			if err = w.Write(types.PaymentReceiptCsvHeader); err != nil {
+				return err
+			}
+
+			// Page through all receipts of the payment contract
+			limit := uint64(keeper.MaxQueryPageLimit)
+			for page := uint64(1); ; page++ {
+				var receipts []types.PaymentReceipt
+				err := queryPaginatedList(cliCtx, cdc, keeper.QueryPaymentReceipts,
+					[]string{contractId, strconv.FormatUint(page, 10),
+						strconv.FormatUint(limit, 10)}, &receipts)
+				if err != nil {
+					return err
+				}
+
+				for _, r := range receipts {
+					if err = w.WriteAll(r.CsvRows()); err != nil {
+						return err
+					}
+				}
+
+				if uint64(len(receipts)) < limit {
+					break
+				}
+			}
+
+			w.Flush()
+			return w.Error()
+		},
+	}
+}
+
+// queryPaginatedList queries one of the paginated list routes, where args
+// is [id, page, limit] with page and limit being optional, and unmarshals the
+// result into out.
+func queryPaginatedList(cliCtx context.CLIContext, cdc *codec.Codec,
+	route string, args []string, out interface{}) error {
+	path := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, route, args[0])
+	for _, arg := range args[1:] {
+		if _, err := strconv.ParseUint(arg, 10, 64); err != nil {
+			return fmt.Errorf("'%s' is not a valid positive integer", arg)
+		}
+		path = fmt.Sprintf("%s/%s", path, arg)
+	}
+
+	res, _, err := cliCtx.QueryWithData(path, nil)
+	if err != nil {
+		return err
+	}
+
+	return cdc.UnmarshalJSON(res, out)
+}
+
+func GetCmdPaymentContractsByPayer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "payment-contracts-by-payer [payer-addr] [page] [limit]",
+		Short: "Query payment contracts that have the specified payer",
+		Args:  cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var out []types.PaymentContract
+			err := queryPaginatedList(cliCtx, cdc,
+				keeper.QueryPaymentContractsByPayer, args, &out)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			output, err := cdc.MarshalJSONIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdPaymentContractsByCreator(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "payment-contracts-by-creator [creator-addr] [page] [limit]",
+		Short: "Query payment contracts that were created by the specified address",
+		Args:  cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var out []types.PaymentContract
+			err := queryPaginatedList(cliCtx, cdc,
+				keeper.QueryPaymentContractsByCreator, args, &out)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			output, err := cdc.MarshalJSONIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdPaymentContractsByTemplate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "payment-contracts-by-template [payment-template-id] [page] [limit]",
+		Short: "Query payment contracts that use the specified payment template",
+		Args:  cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var out []types.PaymentContract
+			err := queryPaginatedList(cliCtx, cdc,
+				keeper.QueryPaymentContractsByTemplate, args, &out)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			output, err := cdc.MarshalJSONIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdSubscriptionsByContract(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "subscriptions-by-contract [payment-contract-id] [page] [limit]",
+		Short: "Query subscriptions for the specified payment contract",
+		Args:  cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var out []types.Subscription
+			err := queryPaginatedList(cliCtx, cdc,
+				keeper.QuerySubscriptionsByContract, args, &out)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			output, err := cdc.MarshalJSONIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
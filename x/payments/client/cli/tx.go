@@ -271,3 +271,29 @@ func GetCmdEffectPayment(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetCmdApplyPromoCode(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "apply-promo-code [payment-contract-id] [promo-code] [payer-ixo-did]",
+		Short: "Create and sign an apply-promo-code tx using DIDs",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contractIdStr := args[0]
+			promoCodeStr := args[1]
+			ixoDidStr := args[2]
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgApplyPromoCode(
+				contractIdStr, promoCodeStr, ixoDid.Did)
+
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+}
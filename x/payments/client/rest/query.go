@@ -22,8 +22,23 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/payments/contracts/{%s}", RestPaymentContractId),
 		queryPaymentContractHandler(cliCtx)).Methods("GET")
 
+	r.HandleFunc(fmt.Sprintf("/payments/contracts/{%s}/receipts", RestPaymentContractId),
+		queryPaymentReceiptsHandler(cliCtx)).Methods("GET")
+
 	r.HandleFunc(fmt.Sprintf("/payments/subscriptions/{%s}", RestSubscriptionId),
 		querySubscriptionHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/payments/payers/{%s}/contracts", RestPayerAddress),
+		queryPaymentContractsByPayerHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/payments/creators/{%s}/contracts", RestCreatorAddress),
+		queryPaymentContractsByCreatorHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/payments/templates/{%s}/contracts", RestPaymentTemplateId),
+		queryPaymentContractsByTemplateHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/payments/contracts/{%s}/subscriptions", RestPaymentContractId),
+		querySubscriptionsByContractHandler(cliCtx)).Methods("GET")
 }
 
 func queryParamsHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -96,6 +111,31 @@ func queryPaymentContractHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func queryPaymentReceiptsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		contractId := vars[RestPaymentContractId]
+
+		// Optional pagination, e.g. ?page=2&limit=50
+		path := paginatedListPath(r, keeper.QueryPaymentReceipts, contractId)
+		bz, _, err := cliCtx.QueryWithData(path, nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't get query data %s", err.Error())))
+			return
+		}
+
+		var receipts []types.PaymentReceipt
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &receipts); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't Unmarshal data %s", err.Error())))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, receipts)
+	}
+}
+
 func querySubscriptionHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -119,3 +159,119 @@ func querySubscriptionHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, subscription)
 	}
 }
+
+// paginatedListPath returns the querier path for one of the paginated list
+// routes, including the optional page and limit URL query parameters.
+func paginatedListPath(r *http.Request, route, id string) string {
+	path := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, route, id)
+
+	page := r.URL.Query().Get("page")
+	limit := r.URL.Query().Get("limit")
+	if page != "" || limit != "" {
+		if page == "" {
+			page = "1"
+		}
+		path = fmt.Sprintf("%s/%s", path, page)
+		if limit != "" {
+			path = fmt.Sprintf("%s/%s", path, limit)
+		}
+	}
+
+	return path
+}
+
+func queryPaymentContractsByPayerHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		payerAddr := vars[RestPayerAddress]
+
+		bz, _, err := cliCtx.QueryWithData(paginatedListPath(r,
+			keeper.QueryPaymentContractsByPayer, payerAddr), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't get query data %s", err.Error())))
+			return
+		}
+
+		var contracts []types.PaymentContract
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &contracts); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't Unmarshal data %s", err.Error())))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, contracts)
+	}
+}
+
+func queryPaymentContractsByCreatorHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		creatorAddr := vars[RestCreatorAddress]
+
+		bz, _, err := cliCtx.QueryWithData(paginatedListPath(r,
+			keeper.QueryPaymentContractsByCreator, creatorAddr), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't get query data %s", err.Error())))
+			return
+		}
+
+		var contracts []types.PaymentContract
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &contracts); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't Unmarshal data %s", err.Error())))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, contracts)
+	}
+}
+
+func queryPaymentContractsByTemplateHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		templateId := vars[RestPaymentTemplateId]
+
+		bz, _, err := cliCtx.QueryWithData(paginatedListPath(r,
+			keeper.QueryPaymentContractsByTemplate, templateId), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't get query data %s", err.Error())))
+			return
+		}
+
+		var contracts []types.PaymentContract
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &contracts); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't Unmarshal data %s", err.Error())))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, contracts)
+	}
+}
+
+func querySubscriptionsByContractHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		contractId := vars[RestPaymentContractId]
+
+		bz, _, err := cliCtx.QueryWithData(paginatedListPath(r,
+			keeper.QuerySubscriptionsByContract, contractId), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't get query data %s", err.Error())))
+			return
+		}
+
+		var subscriptions []types.Subscription
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &subscriptions); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't Unmarshal data %s", err.Error())))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, subscriptions)
+	}
+}
@@ -9,6 +9,8 @@ const (
 	RestPaymentTemplateId = "payment_template_id"
 	RestPaymentContractId = "payment_contract_id"
 	RestSubscriptionId    = "subscription_id"
+	RestPayerAddress      = "payer_address"
+	RestCreatorAddress    = "creator_address"
 )
 
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
@@ -22,6 +22,7 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/payments/grantDiscount", grantDiscountHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/payments/revokeDiscount", revokeDiscountHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/payments/effectPayment", effectPaymentHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/payments/applyPromoCode", applyPromoCodeHandler(cliCtx)).Methods("POST")
 }
 
 const (
@@ -337,3 +338,34 @@ func effectPaymentHandler(ctx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, ctx, output)
 	}
 }
+
+func applyPromoCodeHandler(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		contractIdParam := r.URL.Query().Get("paymentContractId")
+		promoCodeParam := r.URL.Query().Get("promoCode")
+		ixoDidParam := r.URL.Query().Get("ixoDid")
+
+		mode := r.URL.Query().Get("mode")
+		ctx = ctx.WithBroadcastMode(mode)
+
+		ixoDid, err := did.UnmarshalIxoDid(ixoDidParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		msg := types.NewMsgApplyPromoCode(contractIdParam, promoCodeParam, ixoDid.Did)
+
+		output, err := ixo.CompleteAndBroadcastTxRest(ctx, msg, ixoDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, output)
+	}
+}
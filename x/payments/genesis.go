@@ -23,6 +23,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	for _, s := range data.Subscriptions {
 		keeper.SetSubscription(ctx, s)
 	}
+
+	// Init payment receipts
+	for _, r := range data.PaymentReceipts {
+		keeper.SetPaymentReceipt(ctx, r)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -54,5 +59,13 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		subscriptions = append(subscriptions, subscription)
 	}
 
-	return NewGenesisState(params, templates, contracts, subscriptions)
+	// Export payment receipts
+	var receipts []PaymentReceipt
+	iterator = keeper.GetPaymentReceiptIterator(ctx)
+	for ; iterator.Valid(); iterator.Next() {
+		receipt := keeper.MustGetPaymentReceiptByKey(ctx, iterator.Key())
+		receipts = append(receipts, receipt)
+	}
+
+	return NewGenesisState(params, templates, contracts, subscriptions, receipts)
 }
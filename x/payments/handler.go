@@ -64,6 +64,8 @@ func NewHandler(k Keeper, bk bank.Keeper) sdk.Handler {
 			return handleMsgRevokeDiscount(ctx, k, msg)
 		case MsgEffectPayment:
 			return handleMsgEffectPayment(ctx, k, bk, msg)
+		case MsgApplyPromoCode:
+			return handleMsgApplyPromoCode(ctx, k, msg)
 		default:
 			return sdk.ErrUnknownRequest("No match for message type.").Result()
 		}
@@ -253,6 +255,19 @@ func handleMsgGrantDiscount(ctx sdk.Context, k Keeper, msg MsgGrantDiscount) sdk
 			"discount ID not in payment template's discount list").Result()
 	}
 
+	// Confirm that the discount has not expired and has not been used up
+	template, err := k.GetPaymentTemplate(ctx, contract.PaymentTemplateId)
+	if err != nil {
+		return err.Result()
+	}
+	discount, err := template.GetDiscount(msg.DiscountId)
+	if err != nil {
+		return err.Result()
+	} else if discount.HasExpired(ctx) ||
+		discount.IsUsedUp(contract.GetDiscountUses(discount.Id)) {
+		return types.ErrDiscountHasLapsed(types.DefaultCodespace).Result()
+	}
+
 	// Grant the discount
 	err = k.GrantDiscount(ctx, contract.Id, msg.DiscountId)
 	if err != nil {
@@ -324,3 +339,48 @@ func handleMsgEffectPayment(ctx sdk.Context, k Keeper, bk bank.Keeper, msg MsgEf
 
 	return sdk.Result{}
 }
+
+func handleMsgApplyPromoCode(ctx sdk.Context, k Keeper, msg MsgApplyPromoCode) sdk.Result {
+
+	// Get PaymentContract
+	contract, err := k.GetPaymentContract(ctx, msg.PaymentContractId)
+	if err != nil {
+		return err.Result()
+	}
+
+	// Get payer address
+	payerDidDoc, err := k.DidKeeper.GetDidDoc(ctx, msg.PayerDid)
+	if err != nil {
+		return err.Result()
+	}
+	payerAddr := payerDidDoc.Address()
+
+	// Confirm that signer is actually the payer in the payment contract
+	if !payerAddr.Equals(contract.Payer) {
+		return sdk.ErrInvalidAddress("signer must be payment contract payer").Result()
+	}
+
+	// Find the discount that the promo code is for
+	template, err := k.GetPaymentTemplate(ctx, contract.PaymentTemplateId)
+	if err != nil {
+		return err.Result()
+	}
+	discount, err := template.GetDiscountByPromoCode(msg.PromoCode)
+	if err != nil {
+		return err.Result()
+	}
+
+	// Confirm that the discount has not expired and has not been used up
+	if discount.HasExpired(ctx) ||
+		discount.IsUsedUp(contract.GetDiscountUses(discount.Id)) {
+		return types.ErrDiscountHasLapsed(types.DefaultCodespace).Result()
+	}
+
+	// Grant the discount
+	err = k.GrantDiscount(ctx, contract.Id, discount.Id)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{}
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/payments/internal/types"
+)
+
+// -------------------------------------------------------- Secondary indexes
+
+// pageOffset returns the number of entries that come before the specified page
+// (starting at 1) of at most limit entries each, or false if there is no such
+// page (including if the offset does not fit in a uint64).
+func pageOffset(page, limit uint64) (uint64, bool) {
+	if page == 0 || limit == 0 || page-1 > math.MaxUint64/limit {
+		return 0, false
+	}
+	return (page - 1) * limit, true
+}
+
+// getIndexedIds returns the IDs stored in the index entries under the prefix,
+// for the specified page (starting at 1) of at most limit entries each.
+func (k Keeper) getIndexedIds(ctx sdk.Context, prefix []byte, page, limit uint64) []string {
+	ids := []string{}
+	skip, ok := pageOffset(page, limit)
+	if !ok {
+		return ids
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid() && uint64(len(ids)) < limit; iterator.Next() {
+		if skip > 0 {
+			skip--
+			continue
+		}
+		ids = append(ids, string(iterator.Value()))
+	}
+
+	return ids
+}
+
+func (k Keeper) setPaymentContractIndexes(ctx sdk.Context, contract types.PaymentContract) {
+	store := ctx.KVStore(k.storeKey)
+	id := []byte(contract.Id)
+	store.Set(types.GetContractByPayerKey(contract.Payer, contract.Id), id)
+	store.Set(types.GetContractByCreatorKey(contract.Creator, contract.Id), id)
+	store.Set(types.GetContractByTemplateKey(contract.PaymentTemplateId, contract.Id), id)
+}
+
+func (k Keeper) deletePaymentContractIndexes(ctx sdk.Context, contract types.PaymentContract) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContractByPayerKey(contract.Payer, contract.Id))
+	store.Delete(types.GetContractByCreatorKey(contract.Creator, contract.Id))
+	store.Delete(types.GetContractByTemplateKey(contract.PaymentTemplateId, contract.Id))
+}
+
+func (k Keeper) setSubscriptionIndexes(ctx sdk.Context, subscription types.Subscription) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSubscriptionByContractKey(
+		subscription.PaymentContractId, subscription.Id), []byte(subscription.Id))
+}
+
+func (k Keeper) deleteSubscriptionIndexes(ctx sdk.Context, subscription types.Subscription) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSubscriptionByContractKey(
+		subscription.PaymentContractId, subscription.Id))
+}
+
+func (k Keeper) getPaymentContractsByIndex(ctx sdk.Context, prefix []byte,
+	page, limit uint64) []types.PaymentContract {
+	contracts := []types.PaymentContract{}
+	for _, id := range k.getIndexedIds(ctx, prefix, page, limit) {
+		contracts = append(contracts, k.MustGetPaymentContractByKey(
+			ctx, types.GetPaymentContractKey(id)))
+	}
+	return contracts
+}
+
+func (k Keeper) GetPaymentContractsByPayer(ctx sdk.Context, payer sdk.AccAddress,
+	page, limit uint64) []types.PaymentContract {
+	return k.getPaymentContractsByIndex(ctx,
+		types.GetContractsByPayerKey(payer), page, limit)
+}
+
+func (k Keeper) GetPaymentContractsByCreator(ctx sdk.Context, creator sdk.AccAddress,
+	page, limit uint64) []types.PaymentContract {
+	return k.getPaymentContractsByIndex(ctx,
+		types.GetContractsByCreatorKey(creator), page, limit)
+}
+
+func (k Keeper) GetPaymentContractsByTemplate(ctx sdk.Context, templateId string,
+	page, limit uint64) []types.PaymentContract {
+	return k.getPaymentContractsByIndex(ctx,
+		types.GetContractsByTemplateKey(templateId), page, limit)
+}
+
+func (k Keeper) GetSubscriptionsByContract(ctx sdk.Context, contractId string,
+	page, limit uint64) []types.Subscription {
+	subscriptions := []types.Subscription{}
+	prefix := types.GetSubscriptionsByContractKey(contractId)
+	for _, id := range k.getIndexedIds(ctx, prefix, page, limit) {
+		subscriptions = append(subscriptions, k.MustGetSubscriptionByKey(
+			ctx, types.GetSubscriptionKey(id)))
+	}
+	return subscriptions
+}
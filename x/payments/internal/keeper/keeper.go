@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles"
 	"github.com/ixofoundation/ixo-blockchain/x/payments/internal/types"
 	"strings"
 )
@@ -16,18 +17,21 @@ type Keeper struct {
 	paramSpace         params.Subspace
 	bankKeeper         bank.Keeper
 	DidKeeper          did.Keeper
+	oraclesKeeper      oracles.Keeper
 	reservedIdPrefixes []string
 }
 
 func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey,
 	paramSpace params.Subspace, bankKeeper bank.Keeper,
-	didKeeper did.Keeper, reservedIdPrefixes []string) Keeper {
+	didKeeper did.Keeper, oraclesKeeper oracles.Keeper,
+	reservedIdPrefixes []string) Keeper {
 	return Keeper{
 		cdc:                cdc,
 		storeKey:           storeKey,
 		paramSpace:         paramSpace.WithKeyTable(types.ParamKeyTable()),
 		bankKeeper:         bankKeeper,
 		DidKeeper:          didKeeper,
+		oraclesKeeper:      oraclesKeeper,
 		reservedIdPrefixes: reservedIdPrefixes,
 	}
 }
@@ -1,11 +1,18 @@
 package keeper
 
 import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles"
 	"github.com/ixofoundation/ixo-blockchain/x/payments/internal/types"
 	"github.com/stretchr/testify/require"
-	"testing"
-	"time"
+	"github.com/tendermint/tendermint/crypto"
 )
 
 func TestKeeperIdReserver(t *testing.T) {
@@ -354,3 +361,505 @@ func TestKeeperEffectSubscriptionPayment(t *testing.T) {
 	err = k.EffectSubscriptionPayment(ctx, testSubscription.Id)
 	require.Nil(t, err)
 }
+
+func TestKeeperPaymentReceipts(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+
+	// Create and submit PaymentTemplate (!!double pay!!) and PaymentContract
+	template := validDoublePayTemplate
+	contract := validContract
+	k.SetPaymentTemplate(ctx, template)
+	k.SetPaymentContract(ctx, contract)
+
+	// Set payer balance and discount
+	balance, err2 := sdk.ParseCoins("10uixo,10res")
+	require.Nil(t, err2)
+	err := k.bankKeeper.SetCoins(ctx, contract.Payer, balance)
+	require.Nil(t, err)
+	err = k.GrantDiscount(ctx, contract.Id, fiftyPercentOffId)
+	require.Nil(t, err)
+
+	// No receipts yet
+	require.Equal(t, uint64(0), k.GetReceiptCount(ctx, contract.Id))
+	require.Len(t, k.GetPaymentReceipts(ctx, contract.Id, 1, 10), 0)
+
+	// Effect three payments (see TestKeeperEffectPaymentWithDiscounts)
+	for i := 0; i < 3; i++ {
+		effected, err := k.EffectPayment(ctx, k.bankKeeper, contract.Id)
+		require.Nil(t, err)
+		require.True(t, effected)
+	}
+	require.Equal(t, uint64(3), k.GetReceiptCount(ctx, contract.Id))
+
+	// First receipt: 3res,1uixo paid (3res due to PayMin), split 50/50 with
+	// the 1res,1uixo remainder going to the PayRemainderPool
+	receipt, err := k.GetPaymentReceipt(ctx, contract.Id, 0)
+	require.Nil(t, err)
+	require.Equal(t, contract.Id, receipt.PaymentContractId)
+	require.Equal(t, uint64(0), receipt.Index)
+	require.Equal(t, "4res,2uixo", receipt.GrossAmount.String())
+	require.Equal(t, "2res,1uixo", receipt.DiscountAmount.String())
+	require.Equal(t, "3res,1uixo", receipt.PaidAmount.String())
+	require.Equal(t, fiftyPercentOffId, receipt.DiscountId)
+	require.Len(t, receipt.Distributions, 2)
+	require.Equal(t, shareAddr1, receipt.Distributions[0].Address)
+	require.Equal(t, "1res", receipt.Distributions[0].Amount.String())
+	require.Equal(t, shareAddr2, receipt.Distributions[1].Address)
+	require.Equal(t, "1res", receipt.Distributions[1].Amount.String())
+
+	// Second receipt: 2res,1uixo paid, which together with the remainder
+	// from the first payment is enough to pay out 1res,1uixo to each share
+	receipt, err = k.GetPaymentReceipt(ctx, contract.Id, 1)
+	require.Nil(t, err)
+	require.Equal(t, "2res,1uixo", receipt.PaidAmount.String())
+	require.Len(t, receipt.Distributions, 2)
+	require.Equal(t, "1res,1uixo", receipt.Distributions[0].Amount.String())
+	require.Equal(t, "1res,1uixo", receipt.Distributions[1].Amount.String())
+
+	// Pagination (pages starting at 1, as for the other list queries)
+	receipts := k.GetPaymentReceipts(ctx, contract.Id, 1, 2)
+	require.Len(t, receipts, 2)
+	require.Equal(t, uint64(0), receipts[0].Index)
+	require.Equal(t, uint64(1), receipts[1].Index)
+	receipts = k.GetPaymentReceipts(ctx, contract.Id, 2, 2)
+	require.Len(t, receipts, 1)
+	require.Equal(t, uint64(2), receipts[0].Index)
+	receipts = k.GetPaymentReceipts(ctx, contract.Id, 3, 2)
+	require.Len(t, receipts, 0)
+	receipts = k.GetPaymentReceipts(ctx, contract.Id, 1<<63+1, 2)
+	require.Len(t, receipts, 0)
+
+	// Receipts of other contracts are not included
+	require.Len(t, k.GetPaymentReceipts(ctx, validPaymentContractId2, 1, 10), 0)
+
+	// CSV export has a row per recipient, and a row with empty recipient
+	// columns for receipts without distributions
+	receipt, err = k.GetPaymentReceipt(ctx, contract.Id, 0)
+	require.Nil(t, err)
+	rows := receipt.CsvRows()
+	require.Len(t, rows, 2)
+	require.Len(t, rows[0], len(types.PaymentReceiptCsvHeader))
+	require.Equal(t, []string{shareAddr1.String(), "1res"}, rows[0][8:])
+	require.Equal(t, []string{shareAddr2.String(), "1res"}, rows[1][8:])
+
+	receipt.Distributions = nil
+	rows = receipt.CsvRows()
+	require.Len(t, rows, 1)
+	require.Equal(t, []string{contract.Id, "0"}, rows[0][:2])
+	require.Equal(t, []string{"", ""}, rows[0][8:])
+}
+
+func TestDistributionRoundingPolicies(t *testing.T) {
+	distribution := types.NewDistribution(
+		types.NewDistributionShare(shareAddr1, sdk.NewDec(50)),
+		types.NewDistributionShare(shareAddr2, sdk.NewDec(25)),
+		types.NewDistributionShare(templateCreatorAddr, sdk.NewDec(25)))
+	require.Nil(t, distribution.Validate())
+
+	// Exact shares of 3res,4uixo are [1.5res,2uixo], [0.75res,1uixo], [0.75res,1uixo]
+	amount, err := sdk.ParseCoins("3res,4uixo")
+	require.Nil(t, err)
+
+	testCases := []struct {
+		policy    types.RoundingPolicy
+		outputs   []string
+		remainder string
+	}{
+		{"", []string{"1res,2uixo", "1uixo", "1uixo"}, "2res"},
+		{types.RoundingCarryForward, []string{"1res,2uixo", "1uixo", "1uixo"}, "2res"},
+		{types.RoundingFirstRecipient, []string{"3res,2uixo", "1uixo", "1uixo"}, ""},
+		{types.RoundingLargestRemainder, []string{"1res,2uixo", "1res,1uixo", "1res,1uixo"}, ""},
+	}
+	for _, tc := range testCases {
+		outputs, remainder := distribution.GetOutputsFor(amount, tc.policy)
+		require.Len(t, outputs, len(tc.outputs), string(tc.policy))
+		for i, output := range outputs {
+			require.Equal(t, tc.outputs[i], output.String(), string(tc.policy))
+		}
+		require.Equal(t, tc.remainder, remainder.String(), string(tc.policy))
+	}
+
+	// Unknown rounding policies are not accepted in templates
+	template := validTemplate
+	template.RoundingPolicy = "round_up"
+	require.NotNil(t, template.Validate())
+}
+
+// randomDistribution returns a valid distribution with between one and five
+// shares, with percentages that have up to two decimal places
+func randomDistribution(r *rand.Rand) types.Distribution {
+	n := 1 + r.Intn(5)
+
+	// Split 10000 basis points into n non-zero parts
+	parts := make([]int64, n)
+	remaining := int64(10000)
+	for i := 0; i < n-1; i++ {
+		max := remaining - int64(n-1-i)
+		parts[i] = 1 + r.Int63n(max)
+		remaining -= parts[i]
+	}
+	parts[n-1] = remaining
+
+	shares := make([]types.DistributionShare, n)
+	for i, part := range parts {
+		addr := sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("share%d", i))))
+		shares[i] = types.NewDistributionShare(addr, sdk.NewDecWithPrec(part, 2))
+	}
+	return types.NewDistribution(shares...)
+}
+
+func TestKeeperRoundingPoliciesConserveValue(t *testing.T) {
+	policies := []types.RoundingPolicy{types.RoundingCarryForward,
+		types.RoundingLargestRemainder, types.RoundingFirstRecipient}
+	r := rand.New(rand.NewSource(1))
+	const templatesPerPolicy = 10
+	const paymentsPerTemplate = 100
+	poolAddr := supply.NewModuleAddress(types.PayRemainderPool)
+
+	for _, policy := range policies {
+		for n := 0; n < templatesPerPolicy; n++ {
+			ctx, k, _ := CreateTestInput()
+
+			// Random multi-denom payment amount and distribution
+			payAmount := sdk.NewCoins(
+				sdk.NewInt64Coin("res", 1+r.Int63n(1000)),
+				sdk.NewInt64Coin("uixo", 1+r.Int63n(10)))
+			distribution := randomDistribution(r)
+			template := types.NewPaymentTemplate(validTemplateId1, payAmount,
+				sdk.NewCoins(), sdk.NewCoins(), nil, distribution, policy)
+			require.Nil(t, template.Validate())
+			contract := validContract
+
+			k.SetPaymentTemplate(ctx, template)
+			k.SetPaymentContract(ctx, contract)
+
+			balance := sdk.NewCoins(
+				sdk.NewInt64Coin("res", 1000000),
+				sdk.NewInt64Coin("uixo", 1000000))
+			err := k.bankKeeper.SetCoins(ctx, contract.Payer, balance)
+			require.Nil(t, err)
+
+			paid := sdk.NewCoins()
+			for i := 0; i < paymentsPerTemplate; i++ {
+				effected, err := k.EffectPayment(ctx, k.bankKeeper, contract.Id)
+				require.Nil(t, err)
+				require.True(t, effected)
+
+				// Everything paid so far is either with a recipient or in the pool
+				paid = paid.Add(payAmount)
+				require.Equal(t, balance.Sub(paid).String(),
+					k.bankKeeper.GetCoins(ctx, contract.Payer).String())
+				received := k.bankKeeper.GetCoins(ctx, poolAddr)
+				for _, share := range distribution {
+					received = received.Add(k.bankKeeper.GetCoins(ctx, share.Address))
+				}
+				require.Equal(t, paid.String(), received.String())
+
+				// Only carry-forward leaves anything in the pool, and never
+				// more than one unit per denom per share
+				contract, err = k.GetPaymentContract(ctx, contract.Id)
+				require.Nil(t, err)
+				pool := k.bankKeeper.GetCoins(ctx, poolAddr)
+				require.Equal(t, pool.String(), contract.CurrentRemainder.String())
+				if policy != types.RoundingCarryForward {
+					require.True(t, pool.IsZero())
+				}
+				for _, coin := range pool {
+					require.True(t, coin.Amount.LT(sdk.NewInt(int64(len(distribution)))))
+				}
+
+				// Each receipt records the full payment amount
+				receipt, err := k.GetPaymentReceipt(ctx, contract.Id, uint64(i))
+				require.Nil(t, err)
+				require.Equal(t, payAmount.String(), receipt.PaidAmount.String())
+			}
+		}
+	}
+}
+
+func TestPaymentTemplateJSONOmitsUnsetFields(t *testing.T) {
+	// Templates without a rounding policy or oracle pricing keep their
+	// original JSON (and sign bytes) and use the legacy rounding policy
+	template := validTemplate
+	template.RoundingPolicy = ""
+	bz := types.ModuleCdc.MustMarshalJSON(template)
+	require.NotContains(t, string(bz), "rounding_policy")
+	require.NotContains(t, string(bz), "pricing")
+	require.Equal(t, types.DefaultRoundingPolicy, template.RoundingPolicy.OrDefault())
+
+	template.RoundingPolicy = types.RoundingLargestRemainder
+	bz = types.ModuleCdc.MustMarshalJSON(template)
+	require.Contains(t, string(bz), `"rounding_policy":"largest_remainder"`)
+}
+
+func TestKeeperLimitedDiscounts(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+
+	// 50% discount usable twice, 10% discount expiring at height 12
+	template := validDoublePayTemplate
+	template.PaymentMinimum = sdk.NewCoins()
+	template.Discounts = types.NewDiscounts(
+		types.NewLimitedDiscount(sdk.NewUint(1), sdk.NewDec(50),
+			0, nil, 2, ""),
+		types.NewLimitedDiscount(sdk.NewUint(2), sdk.NewDec(10),
+			12, nil, 0, ""))
+	require.Nil(t, template.Validate())
+	contract := validContract
+	k.SetPaymentTemplate(ctx, template)
+	k.SetPaymentContract(ctx, contract)
+
+	balance, err2 := sdk.ParseCoins("100uixo,100res")
+	require.Nil(t, err2)
+	err := k.bankKeeper.SetCoins(ctx, contract.Payer, balance)
+	require.Nil(t, err)
+
+	// Two discounted payments (1uixo,2res each) after which discount lapses
+	err = k.GrantDiscount(ctx, contract.Id, sdk.NewUint(1))
+	require.Nil(t, err)
+	for i := 0; i < 2; i++ {
+		effected, err := k.EffectPayment(ctx, k.bankKeeper, contract.Id)
+		require.Nil(t, err)
+		require.True(t, effected)
+	}
+	contract, err = k.GetPaymentContract(ctx, contract.Id)
+	require.Nil(t, err)
+	require.True(t, contract.DiscountId.IsZero())
+	require.Equal(t, uint64(0), contract.DiscountUses)
+
+	// Next payment is not discounted (2uixo,4res)
+	effected, err := k.EffectPayment(ctx, k.bankKeeper, contract.Id)
+	require.Nil(t, err)
+	require.True(t, effected)
+	expected, err2 := sdk.ParseCoins("96uixo,92res")
+	require.Nil(t, err2)
+	require.Equal(t, expected.String(), k.bankKeeper.GetCoins(ctx, contract.Payer).String())
+
+	// Re-granting the used-up discount does not reset its uses
+	err = k.GrantDiscount(ctx, contract.Id, sdk.NewUint(1))
+	require.Nil(t, err)
+	contract, err = k.GetPaymentContract(ctx, contract.Id)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), contract.DiscountUses)
+	require.Equal(t, uint64(2), contract.GetDiscountUses(sdk.NewUint(1)))
+	lapsed, err := k.DiscountHasLapsed(ctx, template, contract)
+	require.Nil(t, err)
+	require.True(t, lapsed)
+	err = k.RevokeDiscount(ctx, contract.Id)
+	require.Nil(t, err)
+
+	// Discount with expiry applies before the expiry height...
+	err = k.GrantDiscount(ctx, contract.Id, sdk.NewUint(2))
+	require.Nil(t, err)
+	ctx = ctx.WithBlockHeight(11)
+	effected, err = k.EffectPayment(ctx, k.bankKeeper, contract.Id)
+	require.Nil(t, err)
+	require.True(t, effected)
+	receipt, err := k.GetPaymentReceipt(ctx, contract.Id, 3)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewUint(2), receipt.DiscountId)
+
+	// ...but lapses once it is reached
+	ctx = ctx.WithBlockHeight(12)
+	effected, err = k.EffectPayment(ctx, k.bankKeeper, contract.Id)
+	require.Nil(t, err)
+	require.True(t, effected)
+	receipt, err = k.GetPaymentReceipt(ctx, contract.Id, 4)
+	require.Nil(t, err)
+	require.True(t, receipt.DiscountId.IsZero())
+	require.Equal(t, "4res,2uixo", receipt.PaidAmount.String())
+	contract, err = k.GetPaymentContract(ctx, contract.Id)
+	require.Nil(t, err)
+	require.True(t, contract.DiscountId.IsZero())
+
+	// Discounts that expire by time
+	expiryTime := time.Unix(2000, 0)
+	discount := types.NewLimitedDiscount(sdk.NewUint(1), sdk.NewDec(10),
+		0, &expiryTime, 0, "")
+	require.False(t, discount.HasExpired(ctx))
+	require.True(t, discount.HasExpired(ctx.WithBlockTime(time.Unix(2000, 0))))
+}
+
+func TestPromoCodeDiscounts(t *testing.T) {
+	promoHash := types.HashPromoCode("SUMMER50")
+	template := validTemplate
+	template.Discounts = types.NewDiscounts(
+		types.NewDiscount(sdk.NewUint(1), sdk.NewDec(10)),
+		types.NewLimitedDiscount(sdk.NewUint(2), sdk.NewDec(50),
+			0, nil, 0, promoHash))
+	require.Nil(t, template.Validate())
+
+	// Promo codes are matched by hash
+	discount, err := template.GetDiscountByPromoCode("SUMMER50")
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewUint(2), discount.Id)
+	_, err = template.GetDiscountByPromoCode("WINTER50")
+	require.NotNil(t, err)
+	_, err = template.GetDiscountByPromoCode("")
+	require.NotNil(t, err)
+
+	// Promo code hashes must be hex-encoded SHA-256 hashes
+	template.Discounts[1].PromoCodeHash = "SUMMER50"
+	require.NotNil(t, template.Validate())
+}
+
+func TestDiscountJSONOmitsUnsetLimits(t *testing.T) {
+	// Unlimited discounts keep their original JSON (and sign bytes)
+	discount := types.NewDiscount(sdk.NewUint(1), sdk.NewDec(10))
+	bz := types.ModuleCdc.MustMarshalJSON(discount)
+	require.Equal(t, `{"id":"1","percent":"10.000000000000000000"}`, string(bz))
+
+	// Limits are included once set
+	expiryTime := time.Unix(2000, 0).UTC()
+	discount = types.NewLimitedDiscount(sdk.NewUint(1), sdk.NewDec(10),
+		12, &expiryTime, 2, types.HashPromoCode("SUMMER50"))
+	bz = types.ModuleCdc.MustMarshalJSON(discount)
+	var decoded types.Discount
+	types.ModuleCdc.MustUnmarshalJSON(bz, &decoded)
+	require.Equal(t, discount.ExpiryHeight, decoded.ExpiryHeight)
+	require.True(t, expiryTime.Equal(*decoded.ExpiryTime))
+	require.Equal(t, discount.MaxUses, decoded.MaxUses)
+	require.Equal(t, discount.PromoCodeHash, decoded.PromoCodeHash)
+}
+
+func TestKeeperSecondaryIndexes(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+
+	// Two contracts for the same template and creator, with different payers
+	otherPayerAddr := sdk.AccAddress(crypto.AddressHash([]byte("otherPayerAddr")))
+	contract1 := validContract
+	contract2 := types.NewPaymentContractNoDiscount(
+		validPaymentContractId2, validTemplateId1,
+		templateCreatorAddr, otherPayerAddr, false, true)
+	k.SetPaymentTemplate(ctx, validTemplate)
+	k.SetPaymentContract(ctx, contract1)
+	k.SetPaymentContract(ctx, contract2)
+
+	byPayer := k.GetPaymentContractsByPayer(ctx, payerAddr, 1, 10)
+	require.Len(t, byPayer, 1)
+	require.Equal(t, contract1.Id, byPayer[0].Id)
+	byPayer = k.GetPaymentContractsByPayer(ctx, otherPayerAddr, 1, 10)
+	require.Len(t, byPayer, 1)
+	require.Equal(t, contract2.Id, byPayer[0].Id)
+
+	byCreator := k.GetPaymentContractsByCreator(ctx, templateCreatorAddr, 1, 10)
+	require.Len(t, byCreator, 2)
+	require.Len(t, k.GetPaymentContractsByCreator(ctx, payerAddr, 1, 10), 0)
+
+	byTemplate := k.GetPaymentContractsByTemplate(ctx, validTemplateId1, 1, 10)
+	require.Len(t, byTemplate, 2)
+	require.Len(t, k.GetPaymentContractsByTemplate(ctx, validTemplateId2, 1, 10), 0)
+
+	// Pagination
+	page1 := k.GetPaymentContractsByTemplate(ctx, validTemplateId1, 1, 1)
+	page2 := k.GetPaymentContractsByTemplate(ctx, validTemplateId1, 2, 1)
+	page3 := k.GetPaymentContractsByTemplate(ctx, validTemplateId1, 3, 1)
+	require.Len(t, page1, 1)
+	require.Len(t, page2, 1)
+	require.Len(t, page3, 0)
+	require.NotEqual(t, page1[0].Id, page2[0].Id)
+
+	// Pages whose offset overflows are empty rather than wrapping around
+	require.Len(t, k.GetPaymentContractsByTemplate(ctx, validTemplateId1, 1<<63+1, 2), 0)
+	require.Len(t, k.GetPaymentContractsByTemplate(ctx, validTemplateId1, math.MaxUint64, 10), 0)
+	require.Len(t, k.GetPaymentContractsByTemplate(ctx, validTemplateId1, 0, 10), 0)
+
+	// Updating a contract keeps its index entries up to date
+	contract2.Payer = payerAddr
+	k.SetPaymentContract(ctx, contract2)
+	require.Len(t, k.GetPaymentContractsByPayer(ctx, payerAddr, 1, 10), 2)
+	require.Len(t, k.GetPaymentContractsByPayer(ctx, otherPayerAddr, 1, 10), 0)
+
+	// Subscriptions by contract
+	testPeriod := types.NewTestPeriod(100, 0)
+	k.SetSubscription(ctx, types.NewSubscription(validSubscriptionId1,
+		contract1.Id, sdk.NewUint(10), testPeriod))
+	k.SetSubscription(ctx, types.NewSubscription(validSubscriptionId2,
+		contract2.Id, sdk.NewUint(10), testPeriod))
+	subscriptions := k.GetSubscriptionsByContract(ctx, contract1.Id, 1, 10)
+	require.Len(t, subscriptions, 1)
+	require.Equal(t, validSubscriptionId1, subscriptions[0].Id)
+}
+
+func TestKeeperOraclePricing(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+
+	// Template priced at 10usd, paid in uixo using prices at most 60s old
+	// which do not differ from the median by more than 5%
+	pricing := types.NewOraclePricing("usd", "uixo", 60*time.Second, sdk.NewDec(5))
+	template := validTemplate
+	template.PaymentAmount = sdk.NewCoins(sdk.NewInt64Coin("usd", 10))
+	template.PaymentMinimum = sdk.NewCoins()
+	template.Pricing = &pricing
+	require.Nil(t, template.Validate())
+
+	// Minimums cannot be used with oracle pricing
+	invalidTemplate := template
+	invalidTemplate.PaymentMinimum = sdk.NewCoins(sdk.NewInt64Coin("usd", 1))
+	require.NotNil(t, invalidTemplate.Validate())
+
+	contract := validContract
+	k.SetPaymentTemplate(ctx, template)
+	k.SetPaymentContract(ctx, contract)
+	err := k.bankKeeper.SetCoins(ctx, contract.Payer,
+		sdk.NewCoins(sdk.NewInt64Coin("uixo", 100)))
+	require.Nil(t, err)
+
+	// No prices yet
+	_, err = k.EffectPayment(ctx, k.bankKeeper, contract.Id)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeOraclePriceUnavailable, err.Code())
+
+	// Oracles that can submit uixo prices
+	priceCaps, err2 := oracles.ParseOracleTokenCaps("uixo:price")
+	require.Nil(t, err2)
+	for _, oracleDid := range []string{"did:ixo:oracle1", "did:ixo:oracle2", "did:ixo:oracle3"} {
+		k.oraclesKeeper.SetOracle(ctx, oracles.NewOracle(oracleDid, priceCaps))
+	}
+
+	// Median price is 0.31usd, so payment of 10usd is 32.26uixo rounded up
+	setPrice := func(oracleDid, price string) {
+		k.oraclesKeeper.SetPrice(ctx, oracles.NewPrice(ctx, "uixo", "usd",
+			sdk.MustNewDecFromStr(price), oracleDid))
+	}
+	setPrice("did:ixo:oracle1", "0.30")
+	setPrice("did:ixo:oracle2", "0.31")
+	setPrice("did:ixo:oracle3", "0.32")
+	effected, err := k.EffectPayment(ctx, k.bankKeeper, contract.Id)
+	require.Nil(t, err)
+	require.True(t, effected)
+	receipt, err := k.GetPaymentReceipt(ctx, contract.Id, 0)
+	require.Nil(t, err)
+	require.Equal(t, "33uixo", receipt.GrossAmount.String())
+	require.Equal(t, "67uixo", k.bankKeeper.GetCoins(ctx, contract.Payer).String())
+
+	// Prices become stale after 60s
+	ctx = ctx.WithBlockTime(time.Unix(1061, 0))
+	_, err = k.EffectPayment(ctx, k.bankKeeper, contract.Id)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeOraclePriceUnavailable, err.Code())
+
+	// Price more than 5% away from the median is rejected
+	setPrice("did:ixo:oracle1", "0.30")
+	setPrice("did:ixo:oracle2", "0.31")
+	setPrice("did:ixo:oracle3", "0.50")
+	_, err = k.EffectPayment(ctx, k.bankKeeper, contract.Id)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeOraclePriceSlippageExceeded, err.Code())
+	require.Equal(t, "67uixo", k.bankKeeper.GetCoins(ctx, contract.Payer).String())
+
+	// Prices of suspended oracles are ignored, so the median is 0.305usd
+	oracle3 := k.oraclesKeeper.MustGetOracle(ctx, "did:ixo:oracle3")
+	oracle3.Status = oracles.OracleStatusSuspended
+	k.oraclesKeeper.SetOracle(ctx, oracle3)
+	effected, err = k.EffectPayment(ctx, k.bankKeeper, contract.Id)
+	require.Nil(t, err)
+	require.True(t, effected)
+	require.Equal(t, "34uixo", k.bankKeeper.GetCoins(ctx, contract.Payer).String())
+
+	// Removing an oracle deletes its prices
+	k.oraclesKeeper.RemoveOracle(ctx, "did:ixo:oracle2")
+	require.Len(t, k.oraclesKeeper.GetAllPrices(ctx), 2)
+	require.Len(t, k.oraclesKeeper.GetPrices(ctx, "uixo", "usd"), 1)
+}
@@ -112,7 +112,14 @@ func (k Keeper) GetPaymentContract(ctx sdk.Context, contractId string) (types.Pa
 func (k Keeper) SetPaymentContract(ctx sdk.Context, contract types.PaymentContract) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetPaymentContractKey(contract.Id)
+
+	// Replace index entries of the previous version of the contract (if any)
+	if store.Has(key) {
+		k.deletePaymentContractIndexes(ctx, k.MustGetPaymentContractByKey(ctx, key))
+	}
+
 	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(contract))
+	k.setPaymentContractIndexes(ctx, contract)
 }
 
 func (k Keeper) SetPaymentContractAuthorised(ctx sdk.Context, contractId string,
@@ -141,8 +148,9 @@ func (k Keeper) GrantDiscount(ctx sdk.Context, contractId string, discountId sdk
 		return err
 	}
 
-	// Overwrite previous discount ID
-	contract.DiscountId = discountId
+	// Overwrite previous discount ID, carrying over any previous uses of the
+	// new discount so that re-granting it does not reset its uses
+	contract.SetDiscount(discountId)
 	k.SetPaymentContract(ctx, contract)
 	return nil
 }
@@ -154,14 +162,55 @@ func (k Keeper) RevokeDiscount(ctx sdk.Context, contractId string) sdk.Error {
 		return err
 	}
 
-	// Set discount ID to zero
-	contract.DiscountId = sdk.ZeroUint()
+	// Set discount ID to zero (past uses of the discount are kept)
+	contract.SetDiscount(sdk.ZeroUint())
 	k.SetPaymentContract(ctx, contract)
 	return nil
 }
 
+// DiscountHasLapsed True if the discount has expired or if the payment
+// contract has used up all of the uses of the discount.
+func (k Keeper) DiscountHasLapsed(ctx sdk.Context, template types.PaymentTemplate,
+	contract types.PaymentContract) (bool, sdk.Error) {
+	if contract.DiscountId.IsZero() {
+		return false, nil
+	}
+
+	discount, err := template.GetDiscount(contract.DiscountId)
+	if err != nil {
+		return false, err
+	}
+
+	return discount.HasExpired(ctx) || discount.IsUsedUp(contract.DiscountUses), nil
+}
+
 // -------------------------------------------------------- PaymentContracts payment
 
+// GetPaymentAmount returns the amount that a payment from the template is for,
+// before any discounts. If the template is priced in a reference unit, the
+// amount is converted to the settlement denom using the current oracle prices.
+func (k Keeper) GetPaymentAmount(ctx sdk.Context, template types.PaymentTemplate) (sdk.Coins, sdk.Error) {
+	if template.Pricing == nil {
+		return template.PaymentAmount, nil
+	}
+	pricing := *template.Pricing
+
+	// Only prices submitted within the template's max price age are used
+	prices, err := k.oraclesKeeper.GetFreshPrices(ctx, pricing.SettlementDenom,
+		pricing.ReferenceUnit, pricing.MaxPriceAgeNs)
+	if err != nil {
+		return nil, types.ErrOraclePriceUnavailable(types.DefaultCodespace,
+			pricing.SettlementDenom, pricing.ReferenceUnit)
+	}
+	price, err := pricing.GetSettlementPrice(prices)
+	if err != nil {
+		return nil, err
+	}
+
+	referenceAmount := template.PaymentAmount.AmountOf(pricing.ReferenceUnit)
+	return pricing.GetSettlementAmount(referenceAmount, price), nil
+}
+
 func applyDiscount(template types.PaymentTemplate, contract types.PaymentContract,
 	payAmount sdk.Coins) (sdk.Coins, sdk.Error) {
 
@@ -231,13 +280,26 @@ func (k Keeper) EffectPayment(ctx sdk.Context, bankKeeper bank.Keeper,
 		return false, nil
 	}
 
-	// Assume payer will pay PaymentAmount, apply discount (if any),
+	// Discount (if any) lapses if it has expired or if it has been used up
+	lapsed, err := k.DiscountHasLapsed(ctx, template, contract)
+	if err != nil {
+		return false, err
+	} else if lapsed {
+		contract.SetDiscount(sdk.ZeroUint())
+	}
+
+	// Assume payer will pay PaymentAmount (converted to the settlement denom
+	// if the template is priced in a reference unit), apply discount (if any),
 	// and calculate initial cumulative (before adjustments)
-	payAmount := template.PaymentAmount
-	payAmount, err = applyDiscount(template, contract, payAmount)
+	grossAmount, err := k.GetPaymentAmount(ctx, template)
+	if err != nil {
+		return false, err
+	}
+	payAmount, err := applyDiscount(template, contract, grossAmount)
 	if err != nil {
 		return false, err
 	}
+	discountAmount := grossAmount.Sub(payAmount)
 	cumulative := contract.CumulativePay.Add(payAmount)
 
 	// In-place cumulative adjustments (i.e. considering minimums and maximums)
@@ -259,25 +321,24 @@ func (k Keeper) EffectPayment(ctx sdk.Context, bankKeeper bank.Keeper,
 	inputFromPayRemainderPool := contract.CurrentRemainder
 	totalInputAmount := pay.Add(inputFromPayRemainderPool)
 
-	// Calculate list of outputs and calculate the total output to payees based
-	// on the calculated wallet distributions
-	var outputToPayees sdk.Coins
+	// Calculate list of outputs based on the wallet distribution. Rounding
+	// remainders are dealt with according to the template's rounding policy
+	// and any remainder left over is what goes to the PayRemainderPool
 	var outputs []bank.Output
-	distributions := template.WalletDistribution.GetDistributionsFor(totalInputAmount)
-	for i, share := range distributions {
-		// Get integer output
-		outputAmt, _ := share.TruncateDecimal()
-
-		// If amount not zero, update total and add as output
+	var receiptDistributions []types.ReceiptDistribution
+	outputAmts, outputToPayRemainderPool := template.WalletDistribution.GetOutputsFor(
+		totalInputAmount, template.RoundingPolicy)
+	for i, outputAmt := range outputAmts {
+		// If amount not zero, add as output
 		if !outputAmt.IsZero() {
-			outputToPayees = outputToPayees.Add(outputAmt)
 			address := template.WalletDistribution[i].Address
 			outputs = append(outputs, bank.NewOutput(address, outputAmt))
+			receiptDistributions = append(receiptDistributions,
+				types.NewReceiptDistribution(address, outputAmt))
 		}
 	}
 
 	// Remainder (not output to payees) goes to PayRemainderPool if not zero
-	outputToPayRemainderPool := totalInputAmount.Sub(outputToPayees)
 	if !outputToPayRemainderPool.IsZero() {
 		payRemainderPoolAddr := supply.NewModuleAddress(types.PayRemainderPool)
 		outputs = append(outputs, bank.NewOutput(payRemainderPoolAddr, outputToPayRemainderPool))
@@ -296,11 +357,26 @@ func (k Keeper) EffectPayment(ctx sdk.Context, bankKeeper bank.Keeper,
 		return false, err
 	}
 
-	// Update and save payment contract
+	// Update and save payment contract, counting this as a use of the
+	// discount (if any) and lapsing it straight away if now used up
+	discountId := contract.DiscountId
 	contract.CumulativePay = contract.CumulativePay.Add(pay)
 	contract.CurrentRemainder = contract.CurrentRemainder.Add(
 		outputToPayRemainderPool).Sub(inputFromPayRemainderPool)
+	if !discountId.IsZero() {
+		contract.UseDiscount()
+		lapsed, err := k.DiscountHasLapsed(ctx, template, contract)
+		if err != nil {
+			return false, err
+		} else if lapsed {
+			contract.SetDiscount(sdk.ZeroUint())
+		}
+	}
 	k.SetPaymentContract(ctx, contract)
 
+	// Record receipt of the payment
+	k.AddPaymentReceipt(ctx, types.NewPaymentReceipt(ctx, contract.Id,
+		grossAmount, discountId, discountAmount, pay, receiptDistributions))
+
 	return true, nil
 }
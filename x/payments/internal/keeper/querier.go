@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"strconv"
 )
 
 const (
@@ -12,6 +13,15 @@ const (
 	QueryPaymentTemplate = "queryPaymentTemplate"
 	QueryPaymentContract = "queryPaymentContract"
 	QuerySubscription    = "querySubscription"
+	QueryPaymentReceipts = "queryPaymentReceipts"
+
+	QueryPaymentContractsByPayer    = "queryPaymentContractsByPayer"
+	QueryPaymentContractsByCreator  = "queryPaymentContractsByCreator"
+	QueryPaymentContractsByTemplate = "queryPaymentContractsByTemplate"
+	QuerySubscriptionsByContract    = "querySubscriptionsByContract"
+
+	DefaultQueryPageLimit = 100
+	MaxQueryPageLimit     = 1000
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -25,6 +35,16 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryPaymentContract(ctx, path[1:], k)
 		case QuerySubscription:
 			return querySubscription(ctx, path[1:], k)
+		case QueryPaymentReceipts:
+			return queryPaymentReceipts(ctx, path[1:], k)
+		case QueryPaymentContractsByPayer:
+			return queryPaymentContractsByPayer(ctx, path[1:], k)
+		case QueryPaymentContractsByCreator:
+			return queryPaymentContractsByCreator(ctx, path[1:], k)
+		case QueryPaymentContractsByTemplate:
+			return queryPaymentContractsByTemplate(ctx, path[1:], k)
+		case QuerySubscriptionsByContract:
+			return querySubscriptionsByContract(ctx, path[1:], k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown payments query endpoint")
 		}
@@ -92,3 +112,152 @@ func querySubscription(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Er
 
 	return res, nil
 }
+
+// queryPaymentReceipts expects a path of the form [contract-id, page, limit],
+// where page and limit are optional.
+func queryPaymentReceipts(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	contractId := path[0]
+
+	if !k.PaymentContractExists(ctx, contractId) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf(
+			"payment contract '%s' does not exist", contractId))
+	}
+
+	page, limit, err := parsePageAndLimit(path)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := k.GetPaymentReceipts(ctx, contractId, page, limit)
+
+	res, err2 := codec.MarshalJSONIndent(k.cdc, receipts)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err2.Error()))
+	}
+
+	return res, nil
+}
+
+// parsePageAndLimit parses the optional page (starting at 1) and limit that
+// follow the first element of the path in paginated list queries.
+func parsePageAndLimit(path []string) (page, limit uint64, err sdk.Error) {
+	page = 1
+	if len(path) > 1 && path[1] != "" {
+		var err error
+		page, err = strconv.ParseUint(path[1], 10, 64)
+		if err != nil || page == 0 {
+			return 0, 0, sdk.ErrUnknownRequest(fmt.Sprintf(
+				"page '%s' is not a valid positive integer", path[1]))
+		}
+	}
+
+	limit = DefaultQueryPageLimit
+	if len(path) > 2 && path[2] != "" {
+		var err error
+		limit, err = strconv.ParseUint(path[2], 10, 64)
+		if err != nil || limit == 0 {
+			return 0, 0, sdk.ErrUnknownRequest(fmt.Sprintf(
+				"limit '%s' is not a valid positive integer", path[2]))
+		} else if limit > MaxQueryPageLimit {
+			limit = MaxQueryPageLimit
+		}
+	}
+
+	return page, limit, nil
+}
+
+// queryPaymentContractsByPayer expects a path of the form [payer, page, limit],
+// where page and limit are optional.
+func queryPaymentContractsByPayer(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	payer, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(err.Error())
+	}
+
+	page, limit, err2 := parsePageAndLimit(path)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	contracts := k.GetPaymentContractsByPayer(ctx, payer, page, limit)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, contracts)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+// queryPaymentContractsByCreator expects a path of the form [creator, page,
+// limit], where page and limit are optional.
+func queryPaymentContractsByCreator(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	creator, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(err.Error())
+	}
+
+	page, limit, err2 := parsePageAndLimit(path)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	contracts := k.GetPaymentContractsByCreator(ctx, creator, page, limit)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, contracts)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+// queryPaymentContractsByTemplate expects a path of the form [template-id,
+// page, limit], where page and limit are optional.
+func queryPaymentContractsByTemplate(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	templateId := path[0]
+
+	if !k.PaymentTemplateExists(ctx, templateId) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf(
+			"payment template '%s' does not exist", templateId))
+	}
+
+	page, limit, err := parsePageAndLimit(path)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := k.GetPaymentContractsByTemplate(ctx, templateId, page, limit)
+
+	res, err2 := codec.MarshalJSONIndent(k.cdc, contracts)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err2.Error()))
+	}
+
+	return res, nil
+}
+
+// querySubscriptionsByContract expects a path of the form [contract-id, page,
+// limit], where page and limit are optional.
+func querySubscriptionsByContract(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	contractId := path[0]
+
+	if !k.PaymentContractExists(ctx, contractId) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf(
+			"payment contract '%s' does not exist", contractId))
+	}
+
+	page, limit, err := parsePageAndLimit(path)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := k.GetSubscriptionsByContract(ctx, contractId, page, limit)
+
+	res, err2 := codec.MarshalJSONIndent(k.cdc, subscriptions)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err2.Error()))
+	}
+
+	return res, nil
+}
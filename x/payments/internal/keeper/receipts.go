@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/payments/internal/types"
+)
+
+// -------------------------------------------------------- PaymentReceipts Get/Set
+
+func (k Keeper) GetPaymentReceiptIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.PaymentReceiptKeyPrefix)
+}
+
+func (k Keeper) GetPaymentContractReceiptIterator(ctx sdk.Context, contractId string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetPaymentReceiptsKey(contractId))
+}
+
+func (k Keeper) MustGetPaymentReceiptByKey(ctx sdk.Context, key []byte) types.PaymentReceipt {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("payment receipt not found")
+	}
+
+	bz := store.Get(key)
+	var receipt types.PaymentReceipt
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &receipt)
+
+	return receipt
+}
+
+// GetReceiptCount returns the number of receipts issued for a payment
+// contract, which is also the index that the next receipt will be given.
+func (k Keeper) GetReceiptCount(ctx sdk.Context, contractId string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetReceiptCountKey(contractId))
+	if bz == nil {
+		return 0
+	}
+
+	var count uint64
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &count)
+	return count
+}
+
+func (k Keeper) setReceiptCount(ctx sdk.Context, contractId string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetReceiptCountKey(contractId), k.cdc.MustMarshalBinaryLengthPrefixed(count))
+}
+
+func (k Keeper) GetPaymentReceipt(ctx sdk.Context, contractId string,
+	index uint64) (types.PaymentReceipt, sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPaymentReceiptKey(contractId, index)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return types.PaymentReceipt{}, sdk.ErrInternal("invalid payment receipt")
+	}
+
+	var receipt types.PaymentReceipt
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &receipt)
+
+	return receipt, nil
+}
+
+// GetPaymentReceipts returns the receipts for a payment contract, ordered by
+// index, for the specified page (starting at 1) of at most limit receipts.
+func (k Keeper) GetPaymentReceipts(ctx sdk.Context, contractId string,
+	page, limit uint64) []types.PaymentReceipt {
+	receipts := []types.PaymentReceipt{}
+	skip, ok := pageOffset(page, limit)
+	if !ok {
+		return receipts
+	}
+
+	iterator := k.GetPaymentContractReceiptIterator(ctx, contractId)
+	defer iterator.Close()
+
+	for ; iterator.Valid() && uint64(len(receipts)) < limit; iterator.Next() {
+		if skip > 0 {
+			skip--
+			continue
+		}
+		var receipt types.PaymentReceipt
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &receipt)
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts
+}
+
+// SetPaymentReceipt stores a receipt under its existing index (e.g. when
+// importing from genesis) and makes sure that the next receipt issued for
+// the same contract is given a later index.
+func (k Keeper) SetPaymentReceipt(ctx sdk.Context, receipt types.PaymentReceipt) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPaymentReceiptKey(receipt.PaymentContractId, receipt.Index)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(receipt))
+
+	if receipt.Index >= k.GetReceiptCount(ctx, receipt.PaymentContractId) {
+		k.setReceiptCount(ctx, receipt.PaymentContractId, receipt.Index+1)
+	}
+}
+
+// AddPaymentReceipt assigns the next index to the receipt and stores it.
+func (k Keeper) AddPaymentReceipt(ctx sdk.Context, receipt types.PaymentReceipt) types.PaymentReceipt {
+	receipt.Index = k.GetReceiptCount(ctx, receipt.PaymentContractId)
+	k.SetPaymentReceipt(ctx, receipt)
+	return receipt
+}
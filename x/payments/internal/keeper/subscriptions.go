@@ -48,7 +48,14 @@ func (k Keeper) GetSubscription(ctx sdk.Context, subscriptionId string) (types.S
 func (k Keeper) SetSubscription(ctx sdk.Context, subscription types.Subscription) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetSubscriptionKey(subscription.Id)
+
+	// Replace index entries of the previous version of the subscription (if any)
+	if store.Has(key) {
+		k.deleteSubscriptionIndexes(ctx, k.MustGetSubscriptionByKey(ctx, key))
+	}
+
 	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(subscription))
+	k.setSubscriptionIndexes(ctx, subscription)
 }
 
 // -------------------------------------------------------- Subscriptions Payment
@@ -67,7 +74,7 @@ func (k Keeper) EffectSubscriptionPayment(ctx sdk.Context, subscriptionId string
 
 	// Effect payment
 	effected, err := k.EffectPayment(ctx, k.bankKeeper, subscription.PaymentContractId)
-	if err != nil {
+	if err != nil && !isOraclePriceError(err) {
 		return err
 	}
 
@@ -82,12 +89,23 @@ func (k Keeper) EffectSubscriptionPayment(ctx sdk.Context, subscriptionId string
 			subscription.PeriodsAccumulated.Sub(sdk.OneUint())
 	}
 
-	// If the payment was not effected (assuming err == nil) this is because (i)
-	// the payer does not have enough coins, or (ii) because the payment *cannot*
-	// be effected (i.e. maximum payment reached, contract not authorised, etc.)
+	// If the payment was not effected this is because (i) the payer does not
+	// have enough coins, (ii) because the payment *cannot* be effected (i.e.
+	// maximum payment reached, contract not authorised, etc.), or (iii) because
+	// no acceptable oracle price was available to price the payment, in which
+	// case the period accumulates as if the payer did not have enough coins
 
 	// Update subscription
 	k.SetSubscription(ctx, subscription)
 
 	return nil
 }
+
+// isOraclePriceError indicates whether the error is due to an oracle price
+// being unavailable or unacceptable, which are not considered to be errors
+// for subscriptions, since these will be retried in the next period.
+func isOraclePriceError(err sdk.Error) bool {
+	return err.Codespace() == types.DefaultCodespace &&
+		(err.Code() == types.CodeOraclePriceUnavailable ||
+			err.Code() == types.CodeOraclePriceSlippageExceeded)
+}
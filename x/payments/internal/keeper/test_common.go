@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles"
 	"github.com/ixofoundation/ixo-blockchain/x/payments/internal/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
@@ -51,7 +52,8 @@ var (
 		validPaymentMinimum,
 		validPaymentMaximum,
 		validDiscounts,
-		validDistribution)
+		validDistribution,
+		types.DefaultRoundingPolicy)
 
 	validDoublePayTemplate = types.NewPaymentTemplate(
 		validTemplateId1,
@@ -59,7 +61,8 @@ var (
 		validPaymentMinimum,
 		validPaymentMaximum,
 		validDiscounts,
-		validDistribution)
+		validDistribution,
+		types.DefaultRoundingPolicy)
 
 	validContract = types.NewPaymentContractNoDiscount(
 		validPaymentContractId1, validTemplateId1,
@@ -103,12 +106,14 @@ func CreateTestInput() (sdk.Context, Keeper, *codec.Codec) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	actStoreKey := sdk.NewKVStoreKey(auth.StoreKey)
 	keyDid := sdk.NewKVStoreKey(did.StoreKey)
+	keyOracles := sdk.NewKVStoreKey(oracles.StoreKey)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
 	ms.MountStoreWithDB(actStoreKey, sdk.StoreTypeIAVL, nil)
 	ms.MountStoreWithDB(keyDid, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyOracles, sdk.StoreTypeIAVL, nil)
 
 	_ = ms.LoadLatestVersion()
 	ctx := sdk.NewContext(ms, abci.Header{}, true, log.NewNopLogger())
@@ -128,8 +133,9 @@ func CreateTestInput() (sdk.Context, Keeper, *codec.Codec) {
 
 	accountKeeper := auth.NewAccountKeeper(cdc, actStoreKey, pk1.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
 	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk1.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, nil)
-	didKeeper := did.NewKeeper(cdc, keyDid)
-	keeper := NewKeeper(cdc, storeKey, paymentsSubspace, bankKeeper, didKeeper, nil)
+	didKeeper := did.NewKeeper(cdc, keyDid, pk1.Subspace(did.DefaultParamspace))
+	oraclesKeeper := oracles.NewKeeper(cdc, keyOracles, pk1.Subspace(oracles.DefaultParamspace))
+	keeper := NewKeeper(cdc, storeKey, paymentsSubspace, bankKeeper, didKeeper, oraclesKeeper, nil)
 
 	return ctx, keeper, cdc
 }
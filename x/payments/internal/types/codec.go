@@ -16,6 +16,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgGrantDiscount{}, "payments/MsgGrantDiscount", nil)
 	cdc.RegisterConcrete(MsgRevokeDiscount{}, "payments/MsgRevokeDiscount", nil)
 	cdc.RegisterConcrete(MsgEffectPayment{}, "payments/MsgEffectPayment", nil)
+	cdc.RegisterConcrete(MsgApplyPromoCode{}, "payments/MsgApplyPromoCode", nil)
 }
 
 // ModuleCdc is the codec for the module
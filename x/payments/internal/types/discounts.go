@@ -1,6 +1,12 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // --------------------------------------------- Discounts
 
@@ -35,9 +41,18 @@ func (ds Discounts) Validate() sdk.Error {
 	return nil
 }
 
+// Discount is a percentage off a template's payment amount. A discount can
+// optionally expire at a block height and/or time, be limited to a number of
+// discounted payments per contract, and have a promo code (stored as the hex
+// SHA-256 hash of the code) that payers can use to apply it themselves.
+// Zero values (and a nil expiry time) mean no expiry, unlimited uses, and no promo code respectively.
 type Discount struct {
-	Id      sdk.Uint `json:"id" yaml:"id"`
-	Percent sdk.Dec  `json:"percent" yaml:"percent"`
+	Id            sdk.Uint   `json:"id" yaml:"id"`
+	Percent       sdk.Dec    `json:"percent" yaml:"percent"`
+	ExpiryHeight  int64      `json:"expiry_height,omitempty" yaml:"expiry_height"`
+	ExpiryTime    *time.Time `json:"expiry_time,omitempty" yaml:"expiry_time"`
+	MaxUses       uint64     `json:"max_uses,omitempty" yaml:"max_uses"`
+	PromoCodeHash string     `json:"promo_code_hash,omitempty" yaml:"promo_code_hash"`
 }
 
 func NewDiscount(id sdk.Uint, percent sdk.Dec) Discount {
@@ -47,12 +62,67 @@ func NewDiscount(id sdk.Uint, percent sdk.Dec) Discount {
 	}
 }
 
+func NewLimitedDiscount(id sdk.Uint, percent sdk.Dec, expiryHeight int64,
+	expiryTime *time.Time, maxUses uint64, promoCodeHash string) Discount {
+	return Discount{
+		Id:            id,
+		Percent:       percent,
+		ExpiryHeight:  expiryHeight,
+		ExpiryTime:    expiryTime,
+		MaxUses:       maxUses,
+		PromoCodeHash: promoCodeHash,
+	}
+}
+
+// HashPromoCode returns the hash of a promo code as stored in a Discount.
+func HashPromoCode(promoCode string) string {
+	hash := sha256.Sum256([]byte(promoCode))
+	return hex.EncodeToString(hash[:])
+}
+
 func (d Discount) Validate() sdk.Error {
 	if !d.Percent.IsPositive() {
 		return ErrNegativeDiscountPercentage(DefaultCodespace)
 	} else if d.Percent.GT(sdk.NewDec(100)) {
 		return ErrDiscountPercentageGreaterThan100(DefaultCodespace)
+	} else if d.ExpiryHeight < 0 {
+		return ErrInvalidDiscount(DefaultCodespace, "expiry height cannot be negative")
+	}
+
+	if d.HasPromoCode() {
+		if bz, err := hex.DecodeString(d.PromoCodeHash); err != nil || len(bz) != sha256.Size {
+			return ErrInvalidDiscount(DefaultCodespace, "promo code hash must be a hex-encoded SHA-256 hash")
+		}
 	}
 
 	return nil
 }
+
+// HasExpiryTime True if the discount has an expiry time. Amino decodes a nil
+// time as the Unix epoch, so the epoch is also treated as no expiry time.
+func (d Discount) HasExpiryTime() bool {
+	return d.ExpiryTime != nil && !d.ExpiryTime.IsZero() && d.ExpiryTime.Unix() != 0
+}
+
+// HasExpired True if the discount has an expiry height or time that has passed
+func (d Discount) HasExpired(ctx sdk.Context) bool {
+	if d.ExpiryHeight != 0 && ctx.BlockHeight() >= d.ExpiryHeight {
+		return true
+	} else if d.HasExpiryTime() && !ctx.BlockTime().Before(*d.ExpiryTime) {
+		return true
+	}
+	return false
+}
+
+// IsUsedUp True if the discount has a maximum number of uses that has been reached
+func (d Discount) IsUsedUp(uses uint64) bool {
+	return d.MaxUses != 0 && uses >= d.MaxUses
+}
+
+func (d Discount) HasPromoCode() bool {
+	return d.PromoCodeHash != ""
+}
+
+func (d Discount) MatchesPromoCode(promoCode string) bool {
+	return d.HasPromoCode() && d.PromoCodeHash == HashPromoCode(promoCode)
+}
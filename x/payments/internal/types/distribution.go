@@ -1,9 +1,53 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 var oneHundred = sdk.NewDec(100)
 
+// RoundingPolicy decides what happens to the indivisible remainder (dust) of
+// each denomination when a payment is split between the shares of a wallet
+// distribution. Distributions are always calculated per denomination and
+// in integer amounts, so that the sum of the outputs and the remainder is
+// exactly equal to the amount being distributed.
+type RoundingPolicy string
+
+const (
+	// RoundingCarryForward keeps the remainder in the PayRemainderPool and
+	// includes it in the amount distributed in the contract's next payment.
+	RoundingCarryForward RoundingPolicy = "carry_forward"
+	// RoundingLargestRemainder gives the remainder out one unit at a time to
+	// the shares with the largest fractional parts (ties go to the share
+	// listed first), so nothing is left over.
+	RoundingLargestRemainder RoundingPolicy = "largest_remainder"
+	// RoundingFirstRecipient gives the whole remainder to the first share.
+	RoundingFirstRecipient RoundingPolicy = "first_recipient"
+
+	// DefaultRoundingPolicy is used by templates that do not specify a
+	// policy, and was the only behaviour before policies were introduced.
+	DefaultRoundingPolicy = RoundingCarryForward
+)
+
+func (p RoundingPolicy) IsValid() bool {
+	switch p {
+	case "", RoundingCarryForward, RoundingLargestRemainder, RoundingFirstRecipient:
+		return true
+	default:
+		return false
+	}
+}
+
+// OrDefault returns the policy, or DefaultRoundingPolicy if not specified.
+func (p RoundingPolicy) OrDefault() RoundingPolicy {
+	if p == "" {
+		return DefaultRoundingPolicy
+	}
+	return p
+}
+
 type Distribution []DistributionShare
 
 func NewDistribution(shares ...DistributionShare) Distribution {
@@ -52,6 +96,60 @@ func (d Distribution) GetDistributionsFor(amount sdk.Coins) []sdk.DecCoins {
 	return distributions
 }
 
+// GetOutputsFor splits amount between the shares of the distribution in
+// integer amounts, applying the rounding policy to the remainder of each
+// denomination. The returned outputs (one per share, in order) and the
+// remainder always add up exactly to amount. The remainder is only ever
+// non-zero for the carry-forward policy.
+func (d Distribution) GetOutputsFor(amount sdk.Coins,
+	policy RoundingPolicy) (outputs []sdk.Coins, remainder sdk.Coins) {
+	distributions := d.GetDistributionsFor(amount)
+
+	// Truncate each share, keeping the fractional parts for later
+	outputs = make([]sdk.Coins, len(distributions))
+	fractions := make([]sdk.DecCoins, len(distributions))
+	var distributed sdk.Coins
+	for i, share := range distributions {
+		outputs[i], fractions[i] = share.TruncateDecimal()
+		distributed = distributed.Add(outputs[i])
+	}
+	remainder = amount.Sub(distributed)
+
+	if remainder.IsZero() || len(outputs) == 0 {
+		return outputs, remainder
+	}
+
+	switch policy.OrDefault() {
+	case RoundingFirstRecipient:
+		outputs[0] = outputs[0].Add(remainder)
+		remainder = sdk.NewCoins()
+	case RoundingLargestRemainder:
+		for _, coin := range remainder {
+			// Order shares by their fractional part of this denom (largest
+			// first) keeping the original order for shares that are tied
+			order := make([]int, len(outputs))
+			for i := range order {
+				order[i] = i
+			}
+			sort.SliceStable(order, func(a, b int) bool {
+				return fractions[order[a]].AmountOf(coin.Denom).GT(
+					fractions[order[b]].AmountOf(coin.Denom))
+			})
+
+			// The remainder is the sum of the fractional parts, each of
+			// which is less than one, so it is less than the number of shares
+			unit := sdk.NewCoins(sdk.NewInt64Coin(coin.Denom, 1))
+			for j := int64(0); j < coin.Amount.Int64(); j++ {
+				i := order[j%int64(len(order))]
+				outputs[i] = outputs[i].Add(unit)
+			}
+		}
+		remainder = sdk.NewCoins()
+	}
+
+	return outputs, remainder
+}
+
 type DistributionShare struct {
 	Address    sdk.AccAddress `json:"address" yaml:"address"`
 	Percentage sdk.Dec        `json:"percentage" yaml:"percentage"`
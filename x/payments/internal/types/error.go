@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
 )
 
 const (
@@ -18,6 +19,9 @@ const (
 	CodeInvalidId                    sdk.CodeType      = 109
 	CodeInvalidArgument              sdk.CodeType      = 110
 	CodeAlreadyExists                sdk.CodeType      = 111
+	CodeInvalidPaymentReceipt        sdk.CodeType      = 112
+	CodeOraclePriceUnavailable       sdk.CodeType      = 113
+	CodeOraclePriceSlippageExceeded  sdk.CodeType      = 114
 )
 
 func ErrNegativeSharePercentage(codespace sdk.CodespaceType) sdk.Error {
@@ -55,6 +59,21 @@ func ErrDiscountPercentageGreaterThan100(codespace sdk.CodespaceType) sdk.Error
 	return sdk.NewError(codespace, CodeInvalidDiscount, errMsg)
 }
 
+func ErrInvalidDiscount(codespace sdk.CodespaceType, errMsg string) sdk.Error {
+	errMsg = fmt.Sprintf("discount invalid; %s", errMsg)
+	return sdk.NewError(codespace, CodeInvalidDiscount, errMsg)
+}
+
+func ErrDiscountHasLapsed(codespace sdk.CodespaceType) sdk.Error {
+	errMsg := fmt.Sprintf("discount has expired or has no uses left")
+	return sdk.NewError(codespace, CodeInvalidDiscountRequest, errMsg)
+}
+
+func ErrInvalidPromoCode(codespace sdk.CodespaceType) sdk.Error {
+	errMsg := fmt.Sprintf("promo code does not match any of the template's discounts")
+	return sdk.NewError(codespace, CodeInvalidDiscountRequest, errMsg)
+}
+
 func ErrDiscountIdIsNotInTemplate(codespace sdk.CodespaceType) sdk.Error {
 	errMsg := fmt.Sprintf("discount ID specified is not one of the template's discounts")
 	return sdk.NewError(codespace, CodeInvalidDiscountRequest, errMsg)
@@ -81,3 +100,20 @@ func ErrInvalidArgument(codespace sdk.CodespaceType, errMsg string) sdk.Error {
 func ErrAlreadyExists(codespace sdk.CodespaceType, errMsg string) sdk.Error {
 	return sdk.NewError(codespace, CodeAlreadyExists, errMsg)
 }
+
+func ErrInvalidPaymentReceipt(codespace sdk.CodespaceType, errMsg string) sdk.Error {
+	errMsg = fmt.Sprintf("payment receipt invalid; %s", errMsg)
+	return sdk.NewError(codespace, CodeInvalidPaymentReceipt, errMsg)
+}
+
+func ErrOraclePriceUnavailable(codespace sdk.CodespaceType, denom, referenceUnit string) sdk.Error {
+	errMsg := fmt.Sprintf("no fresh oracle price of %s in %s", denom, referenceUnit)
+	return sdk.NewError(codespace, CodeOraclePriceUnavailable, errMsg)
+}
+
+func ErrOraclePriceSlippageExceeded(codespace sdk.CodespaceType, oracleDid did.Did,
+	price, median sdk.Dec) sdk.Error {
+	errMsg := fmt.Sprintf("price %s by oracle %s differs from median price %s "+
+		"by more than the maximum slippage", price, oracleDid, median)
+	return sdk.NewError(codespace, CodeOraclePriceSlippageExceeded, errMsg)
+}
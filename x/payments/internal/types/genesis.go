@@ -5,15 +5,18 @@ type GenesisState struct {
 	PaymentTemplates []PaymentTemplate `json:"payment_templates" yaml:"payment_templates"`
 	PaymentContracts []PaymentContract `json:"payment_contracts" yaml:"payment_contracts"`
 	Subscriptions    []Subscription    `json:"subscriptions" yaml:"subscriptions"`
+	PaymentReceipts  []PaymentReceipt  `json:"payment_receipts" yaml:"payment_receipts"`
 }
 
 func NewGenesisState(params Params, templates []PaymentTemplate,
-	contracts []PaymentContract, subscriptions []Subscription) GenesisState {
+	contracts []PaymentContract, subscriptions []Subscription,
+	receipts []PaymentReceipt) GenesisState {
 	return GenesisState{
 		Params:           params,
 		PaymentTemplates: templates,
 		PaymentContracts: contracts,
 		Subscriptions:    subscriptions,
+		PaymentReceipts:  receipts,
 	}
 }
 
@@ -45,6 +48,13 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	// Validate payment receipts
+	for _, r := range data.PaymentReceipts {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -54,5 +64,6 @@ func DefaultGenesisState() GenesisState {
 		PaymentTemplates: nil,
 		PaymentContracts: nil,
 		Subscriptions:    nil,
+		PaymentReceipts:  nil,
 	}
 }
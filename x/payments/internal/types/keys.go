@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	ModuleName        = "payments"
 	DefaultParamspace = ModuleName
@@ -19,6 +21,15 @@ var (
 	PaymentTemplateKeyPrefix = []byte{0x00}
 	PaymentContractKeyPrefix = []byte{0x01}
 	SubscriptionKeyPrefix    = []byte{0x02}
+	PaymentReceiptKeyPrefix  = []byte{0x03}
+	ReceiptCountKeyPrefix    = []byte{0x04}
+
+	// Secondary indexes. Each entry's value is the ID of the indexed
+	// contract or subscription, and the entries are ordered by that ID.
+	ContractsByPayerKeyPrefix        = []byte{0x05}
+	ContractsByCreatorKeyPrefix      = []byte{0x06}
+	ContractsByTemplateKeyPrefix     = []byte{0x07}
+	SubscriptionsByContractKeyPrefix = []byte{0x08}
 )
 
 func GetPaymentTemplateKey(templateId string) []byte {
@@ -32,3 +43,57 @@ func GetPaymentContractKey(contractId string) []byte {
 func GetSubscriptionKey(subscriptionId string) []byte {
 	return append(SubscriptionKeyPrefix, []byte(subscriptionId)...)
 }
+
+// GetPaymentReceiptsKey returns the prefix under which all of a contract's
+// receipts are stored. Since contract IDs cannot contain a zero byte, the
+// trailing 0x00 separator stops a contract's prefix from also matching the
+// receipts of any contract whose ID starts with the same characters.
+func GetPaymentReceiptsKey(contractId string) []byte {
+	key := append(PaymentReceiptKeyPrefix, []byte(contractId)...)
+	return append(key, 0x00)
+}
+
+func GetPaymentReceiptKey(contractId string, index uint64) []byte {
+	return append(GetPaymentReceiptsKey(contractId), sdk.Uint64ToBigEndian(index)...)
+}
+
+func GetReceiptCountKey(contractId string) []byte {
+	return append(ReceiptCountKeyPrefix, []byte(contractId)...)
+}
+
+// Address-based index keys do not need a separator, since addresses have a
+// fixed length. ID-based index keys use the same 0x00 separator as receipts.
+
+func GetContractsByPayerKey(payer sdk.AccAddress) []byte {
+	return append(ContractsByPayerKeyPrefix, payer.Bytes()...)
+}
+
+func GetContractByPayerKey(payer sdk.AccAddress, contractId string) []byte {
+	return append(GetContractsByPayerKey(payer), []byte(contractId)...)
+}
+
+func GetContractsByCreatorKey(creator sdk.AccAddress) []byte {
+	return append(ContractsByCreatorKeyPrefix, creator.Bytes()...)
+}
+
+func GetContractByCreatorKey(creator sdk.AccAddress, contractId string) []byte {
+	return append(GetContractsByCreatorKey(creator), []byte(contractId)...)
+}
+
+func GetContractsByTemplateKey(templateId string) []byte {
+	key := append(ContractsByTemplateKeyPrefix, []byte(templateId)...)
+	return append(key, 0x00)
+}
+
+func GetContractByTemplateKey(templateId, contractId string) []byte {
+	return append(GetContractsByTemplateKey(templateId), []byte(contractId)...)
+}
+
+func GetSubscriptionsByContractKey(contractId string) []byte {
+	key := append(SubscriptionsByContractKeyPrefix, []byte(contractId)...)
+	return append(key, 0x00)
+}
+
+func GetSubscriptionByContractKey(contractId, subscriptionId string) []byte {
+	return append(GetSubscriptionsByContractKey(contractId), []byte(subscriptionId)...)
+}
@@ -17,6 +17,7 @@ const (
 	TypeMsgGrantDiscount                   = "grant-discount"
 	TypeMsgRevokeDiscount                  = "revoke-discount"
 	TypeMsgEffectPayment                   = "effect-payment"
+	TypeMsgApplyPromoCode                  = "apply-promo-code"
 )
 
 var (
@@ -27,6 +28,7 @@ var (
 	_ ixo.IxoMsg = MsgGrantDiscount{}
 	_ ixo.IxoMsg = MsgRevokeDiscount{}
 	_ ixo.IxoMsg = MsgEffectPayment{}
+	_ ixo.IxoMsg = MsgApplyPromoCode{}
 )
 
 type MsgCreatePaymentTemplate struct {
@@ -355,3 +357,49 @@ func (msg MsgEffectPayment) String() string {
 func (msg MsgEffectPayment) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
+
+type MsgApplyPromoCode struct {
+	PayerDid          did.Did `json:"payer_did" yaml:"payer_did"`
+	PaymentContractId string  `json:"payment_contract_id" yaml:"payment_contract_id"`
+	PromoCode         string  `json:"promo_code" yaml:"promo_code"`
+}
+
+func (msg MsgApplyPromoCode) Type() string  { return TypeMsgApplyPromoCode }
+func (msg MsgApplyPromoCode) Route() string { return RouterKey }
+func (msg MsgApplyPromoCode) ValidateBasic() sdk.Error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(msg.PayerDid, "PayerDid"); !valid {
+		return err
+	} else if valid, err := CheckNotEmpty(msg.PromoCode, "PromoCode"); !valid {
+		return err
+	}
+
+	// Check that DIDs valid
+	if !did.IsValidDid(msg.PayerDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "payer did is invalid")
+	}
+
+	// Check that IDs valid
+	if !IsValidPaymentContractId(msg.PaymentContractId) {
+		return ErrInvalidId(DefaultCodespace, "payment contract id invalid")
+	}
+
+	return nil
+}
+
+func (msg MsgApplyPromoCode) GetSignerDid() did.Did { return msg.PayerDid }
+func (msg MsgApplyPromoCode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgApplyPromoCode) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgApplyPromoCode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
@@ -3,16 +3,19 @@ package types
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
 type PaymentTemplate struct {
-	Id                 string       `json:"id" yaml:"id"`
-	PaymentAmount      sdk.Coins    `json:"payment_amount" yaml:"payment_amount"`
-	PaymentMinimum     sdk.Coins    `json:"payment_minimum" yaml:"payment_minimum"`
-	PaymentMaximum     sdk.Coins    `json:"payment_maximum" yaml:"payment_maximum"`
-	Discounts          Discounts    `json:"discounts" yaml:"discounts"`
-	WalletDistribution Distribution `json:"wallet_distribution" yaml:"wallet_distribution"`
+	Id                 string         `json:"id" yaml:"id"`
+	PaymentAmount      sdk.Coins      `json:"payment_amount" yaml:"payment_amount"`
+	PaymentMinimum     sdk.Coins      `json:"payment_minimum" yaml:"payment_minimum"`
+	PaymentMaximum     sdk.Coins      `json:"payment_maximum" yaml:"payment_maximum"`
+	Discounts          Discounts      `json:"discounts" yaml:"discounts"`
+	WalletDistribution Distribution   `json:"wallet_distribution" yaml:"wallet_distribution"`
+	RoundingPolicy     RoundingPolicy `json:"rounding_policy,omitempty" yaml:"rounding_policy"`
+	Pricing            *OraclePricing `json:"pricing,omitempty" yaml:"pricing"`
 }
 
 func NewPaymentTemplate(id string, paymentAmount, paymentMinimum, paymentMaximum sdk.Coins,
-	discounts Discounts, walletDistribution Distribution) PaymentTemplate {
+	discounts Discounts, walletDistribution Distribution,
+	roundingPolicy RoundingPolicy) PaymentTemplate {
 	return PaymentTemplate{
 		Id:                 id,
 		PaymentAmount:      paymentAmount,
@@ -20,16 +23,36 @@ func NewPaymentTemplate(id string, paymentAmount, paymentMinimum, paymentMaximum
 		PaymentMaximum:     paymentMaximum,
 		Discounts:          discounts,
 		WalletDistribution: walletDistribution,
+		RoundingPolicy:     roundingPolicy,
 	}
 }
 
-func (pt PaymentTemplate) GetDiscountPercent(discountId sdk.Uint) (sdk.Dec, sdk.Error) {
+func (pt PaymentTemplate) GetDiscount(discountId sdk.Uint) (Discount, sdk.Error) {
 	for _, discount := range pt.Discounts {
 		if discount.Id.Equal(discountId) {
-			return discount.Percent, nil
+			return discount, nil
 		}
 	}
-	return sdk.Dec{}, ErrDiscountIdIsNotInTemplate(DefaultCodespace)
+	return Discount{}, ErrDiscountIdIsNotInTemplate(DefaultCodespace)
+}
+
+func (pt PaymentTemplate) GetDiscountPercent(discountId sdk.Uint) (sdk.Dec, sdk.Error) {
+	discount, err := pt.GetDiscount(discountId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return discount.Percent, nil
+}
+
+// GetDiscountByPromoCode returns the discount whose promo code hash matches
+// the hash of the specified promo code.
+func (pt PaymentTemplate) GetDiscountByPromoCode(promoCode string) (Discount, sdk.Error) {
+	for _, discount := range pt.Discounts {
+		if discount.MatchesPromoCode(promoCode) {
+			return discount, nil
+		}
+	}
+	return Discount{}, ErrInvalidPromoCode(DefaultCodespace)
 }
 
 func (pt PaymentTemplate) Validate() sdk.Error {
@@ -66,6 +89,26 @@ func (pt PaymentTemplate) Validate() sdk.Error {
 		return err
 	}
 
+	// Validate oracle pricing (if any). Amounts are in the reference unit,
+	// which is why payment minimums and maximums cannot be used, since these
+	// are applied to the cumulative pay in the settlement denom
+	if pt.Pricing != nil {
+		if err := pt.Pricing.Validate(); err != nil {
+			return err
+		} else if len(*amt) != 1 || (*amt)[0].Denom != pt.Pricing.ReferenceUnit {
+			return ErrInvalidPaymentTemplate(DefaultCodespace, "PaymentAmount must "+
+				"be a single amount in the pricing reference unit")
+		} else if !min.IsZero() || !max.IsZero() {
+			return ErrInvalidPaymentTemplate(DefaultCodespace, "PaymentMinimum and "+
+				"PaymentMaximum cannot be used with oracle pricing")
+		}
+	}
+
+	// Validate rounding policy (empty means default policy)
+	if !pt.RoundingPolicy.IsValid() {
+		return ErrInvalidPaymentTemplate(DefaultCodespace, "rounding policy invalid")
+	}
+
 	return nil
 }
 
@@ -79,6 +122,16 @@ type PaymentContract struct {
 	CanDeauthorise    bool           `json:"can_deauthorise" yaml:"can_deauthorise"`
 	Authorised        bool           `json:"authorised" yaml:"authorised"`
 	DiscountId        sdk.Uint       `json:"discount_id" yaml:"discount_id"`
+	DiscountUses      uint64         `json:"discount_uses" yaml:"discount_uses"`
+	UsedDiscounts     []DiscountUses `json:"used_discounts,omitempty" yaml:"used_discounts"`
+}
+
+// DiscountUses is the number of payments of a payment contract to which a
+// discount was applied. Uses are kept per discount ID (even after the
+// discount is revoked or lapses) so that a used-up discount cannot be reused.
+type DiscountUses struct {
+	DiscountId sdk.Uint `json:"discount_id" yaml:"discount_id"`
+	Uses       uint64   `json:"uses" yaml:"uses"`
 }
 
 func NewPaymentContract(id, templateId string, creator, payer sdk.AccAddress,
@@ -132,6 +185,45 @@ func (pc PaymentContract) Validate() sdk.Error {
 	return nil
 }
 
+// GetDiscountUses returns the number of payments to which the discount was
+// applied, including uses before the discount was last granted.
+func (pc PaymentContract) GetDiscountUses(discountId sdk.Uint) uint64 {
+	for _, used := range pc.UsedDiscounts {
+		if used.DiscountId.Equal(discountId) {
+			return used.Uses
+		}
+	}
+	return 0
+}
+
+// SetDiscount sets the contract's discount, carrying over any previous uses
+// of the discount. A zero discount ID removes the discount.
+func (pc *PaymentContract) SetDiscount(discountId sdk.Uint) {
+	pc.DiscountId = discountId
+	if discountId.IsZero() {
+		pc.DiscountUses = 0
+	} else {
+		pc.DiscountUses = pc.GetDiscountUses(discountId)
+	}
+}
+
+// UseDiscount counts a use of the contract's discount (if any).
+func (pc *PaymentContract) UseDiscount() {
+	if pc.DiscountId.IsZero() {
+		return
+	}
+
+	pc.DiscountUses += 1
+	for i, used := range pc.UsedDiscounts {
+		if used.DiscountId.Equal(pc.DiscountId) {
+			pc.UsedDiscounts[i].Uses = pc.DiscountUses
+			return
+		}
+	}
+	pc.UsedDiscounts = append(pc.UsedDiscounts,
+		DiscountUses{DiscountId: pc.DiscountId, Uses: pc.DiscountUses})
+}
+
 func (pc PaymentContract) IsFirstPayment() bool {
 	return pc.CumulativePay.IsZero()
 }
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles"
+)
+
+// OraclePricing makes a payment template's amounts be expressed in a
+// reference unit (e.g. usd) rather than in on-chain denoms. At the time of
+// each payment, the amount is converted to the settlement denom using the
+// median of the fresh prices of the settlement denom in the reference unit
+// submitted by oracles in x/oracles. Prices older than MaxPriceAgeNs are not
+// considered, and the payment fails if any of the fresh prices differs from
+// the median by more than MaxSlippage percent (0 meaning that all oracles
+// must agree on the price exactly).
+type OraclePricing struct {
+	ReferenceUnit   string        `json:"reference_unit" yaml:"reference_unit"`
+	SettlementDenom string        `json:"settlement_denom" yaml:"settlement_denom"`
+	MaxPriceAgeNs   time.Duration `json:"max_price_age_ns" yaml:"max_price_age_ns"`
+	MaxSlippage     sdk.Dec       `json:"max_slippage" yaml:"max_slippage"`
+}
+
+func NewOraclePricing(referenceUnit, settlementDenom string,
+	maxPriceAgeNs time.Duration, maxSlippage sdk.Dec) OraclePricing {
+	return OraclePricing{
+		ReferenceUnit:   referenceUnit,
+		SettlementDenom: settlementDenom,
+		MaxPriceAgeNs:   maxPriceAgeNs,
+		MaxSlippage:     maxSlippage,
+	}
+}
+
+func (p OraclePricing) Validate() sdk.Error {
+	if !sdk.NewCoins(sdk.NewInt64Coin(p.ReferenceUnit, 1)).IsValid() {
+		return ErrInvalidPaymentTemplate(DefaultCodespace, "pricing reference unit invalid")
+	} else if !sdk.NewCoins(sdk.NewInt64Coin(p.SettlementDenom, 1)).IsValid() {
+		return ErrInvalidPaymentTemplate(DefaultCodespace, "pricing settlement denom invalid")
+	} else if p.ReferenceUnit == p.SettlementDenom {
+		return ErrInvalidPaymentTemplate(DefaultCodespace, "pricing reference unit cannot be the settlement denom")
+	} else if p.MaxPriceAgeNs <= 0 {
+		return ErrInvalidPaymentTemplate(DefaultCodespace, "pricing max price age must be positive")
+	} else if p.MaxSlippage.IsNil() || p.MaxSlippage.IsNegative() {
+		return ErrInvalidPaymentTemplate(DefaultCodespace, "pricing max slippage cannot be negative")
+	} else if p.MaxSlippage.GT(oneHundred) {
+		return ErrInvalidPaymentTemplate(DefaultCodespace, "pricing max slippage cannot exceed 100%")
+	}
+
+	return nil
+}
+
+// GetSettlementPrice returns the median of the prices, which are expected to
+// be no older than MaxPriceAgeNs, or an error if there are no prices or if any
+// of them differs from the median by more than the maximum slippage.
+func (p OraclePricing) GetSettlementPrice(prices oracles.Prices) (sdk.Dec, sdk.Error) {
+	if len(prices) == 0 {
+		return sdk.Dec{}, ErrOraclePriceUnavailable(DefaultCodespace,
+			p.SettlementDenom, p.ReferenceUnit)
+	}
+
+	median := prices.Median()
+	maxDeviation := median.Mul(p.MaxSlippage).Quo(oneHundred)
+	for _, price := range prices {
+		if price.Price.Sub(median).Abs().GT(maxDeviation) {
+			return sdk.Dec{}, ErrOraclePriceSlippageExceeded(DefaultCodespace,
+				price.OracleDid, price.Price, median)
+		}
+	}
+
+	return median, nil
+}
+
+// GetSettlementAmount converts an amount in the reference unit to the
+// settlement denom at the specified price (reference units per unit of the
+// settlement denom). The result is rounded up, so that the payees are never
+// paid less than the value agreed in the reference unit.
+func (p OraclePricing) GetSettlementAmount(referenceAmount sdk.Int, price sdk.Dec) sdk.Coins {
+	amount := sdk.NewDecFromInt(referenceAmount).Quo(price).Ceil().TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(p.SettlementDenom, amount))
+}
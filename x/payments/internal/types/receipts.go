@@ -0,0 +1,126 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"strconv"
+	"time"
+)
+
+// --------------------------------------------- PaymentReceipt
+
+type PaymentReceipt struct {
+	PaymentContractId string                `json:"payment_contract_id" yaml:"payment_contract_id"`
+	Index             uint64                `json:"index" yaml:"index"`
+	Height            int64                 `json:"height" yaml:"height"`
+	Time              time.Time             `json:"time" yaml:"time"`
+	GrossAmount       sdk.Coins             `json:"gross_amount" yaml:"gross_amount"`
+	DiscountId        sdk.Uint              `json:"discount_id" yaml:"discount_id"`
+	DiscountAmount    sdk.Coins             `json:"discount_amount" yaml:"discount_amount"`
+	PaidAmount        sdk.Coins             `json:"paid_amount" yaml:"paid_amount"`
+	Distributions     []ReceiptDistribution `json:"distributions" yaml:"distributions"`
+}
+
+// NewPaymentReceipt creates a receipt for a payment effected in the current
+// block. The index is assigned by the keeper when the receipt is stored.
+func NewPaymentReceipt(ctx sdk.Context, contractId string, grossAmount sdk.Coins,
+	discountId sdk.Uint, discountAmount, paidAmount sdk.Coins,
+	distributions []ReceiptDistribution) PaymentReceipt {
+	return PaymentReceipt{
+		PaymentContractId: contractId,
+		Height:            ctx.BlockHeight(),
+		Time:              ctx.BlockTime(),
+		GrossAmount:       grossAmount,
+		DiscountId:        discountId,
+		DiscountAmount:    discountAmount,
+		PaidAmount:        paidAmount,
+		Distributions:     distributions,
+	}
+}
+
+func (r PaymentReceipt) Validate() sdk.Error {
+	// Validate ID
+	if !IsValidPaymentContractId(r.PaymentContractId) {
+		return ErrInvalidId(DefaultCodespace, "payment contract id invalid")
+	}
+
+	// Validate coins
+	if !r.GrossAmount.IsValid() {
+		return ErrInvalidPaymentReceipt(DefaultCodespace, "GrossAmount coins invalid")
+	} else if !r.DiscountAmount.IsValid() {
+		return ErrInvalidPaymentReceipt(DefaultCodespace, "DiscountAmount coins invalid")
+	} else if !r.PaidAmount.IsValid() {
+		return ErrInvalidPaymentReceipt(DefaultCodespace, "PaidAmount coins invalid")
+	}
+
+	// Validate distributions
+	for _, d := range r.Distributions {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PaymentReceiptCsvHeader is the header of the CSV export of receipts, in
+// which each row is as returned by PaymentReceipt.CsvRows.
+var PaymentReceiptCsvHeader = []string{"payment_contract_id", "index", "height",
+	"time", "gross_amount", "discount_id", "discount_amount", "paid_amount",
+	"recipient", "recipient_amount"}
+
+// CsvRows returns the CSV rows of the receipt, one for each recipient of the
+// payment, or a single row with empty recipient columns if nothing was
+// distributed (e.g. if the payment went to the remainder pool), so that every
+// receipt appears in the export.
+func (r PaymentReceipt) CsvRows() [][]string {
+	row := func(recipient, recipientAmount string) []string {
+		return []string{
+			r.PaymentContractId,
+			strconv.FormatUint(r.Index, 10),
+			strconv.FormatInt(r.Height, 10),
+			r.Time.UTC().Format(time.RFC3339),
+			r.GrossAmount.String(),
+			r.DiscountId.String(),
+			r.DiscountAmount.String(),
+			r.PaidAmount.String(),
+			recipient,
+			recipientAmount,
+		}
+	}
+
+	if len(r.Distributions) == 0 {
+		return [][]string{row("", "")}
+	}
+
+	rows := make([][]string, len(r.Distributions))
+	for i, d := range r.Distributions {
+		rows[i] = row(d.Address.String(), d.Amount.String())
+	}
+	return rows
+}
+
+// --------------------------------------------- ReceiptDistribution
+
+// ReceiptDistribution is the amount actually sent to one of the addresses in
+// a payment template's wallet distribution as part of a single payment.
+type ReceiptDistribution struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+func NewReceiptDistribution(address sdk.AccAddress, amount sdk.Coins) ReceiptDistribution {
+	return ReceiptDistribution{
+		Address: address,
+		Amount:  amount,
+	}
+}
+
+func (d ReceiptDistribution) Validate() sdk.Error {
+	if d.Address.Empty() {
+		return sdk.ErrInvalidAddress("empty receipt distribution address")
+	} else if !d.Amount.IsValid() {
+		return ErrInvalidPaymentReceipt(DefaultCodespace, "distribution amount coins invalid")
+	}
+
+	return nil
+}
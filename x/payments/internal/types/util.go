@@ -73,6 +73,15 @@ func NewMsgEffectPayment(contractId string, creatorDid did.Did) MsgEffectPayment
 	}
 }
 
+func NewMsgApplyPromoCode(contractId, promoCode string,
+	payerDid did.Did) MsgApplyPromoCode {
+	return MsgApplyPromoCode{
+		PayerDid:          payerDid,
+		PaymentContractId: contractId,
+		PromoCode:         promoCode,
+	}
+}
+
 func CheckNotEmpty(value string, name string) (valid bool, err sdk.Error) {
 	if strings.TrimSpace(value) == "" {
 		return false, sdk.ErrUnknownRequest(name + " is empty.")
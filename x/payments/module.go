@@ -69,6 +69,7 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		cli.GetCmdGrantPaymentDiscount(cdc),
 		cli.GetCmdRevokePaymentDiscount(cdc),
 		cli.GetCmdEffectPayment(cdc),
+		cli.GetCmdApplyPromoCode(cdc),
 	)...)
 
 	return paymentsTxCmd
@@ -88,6 +89,12 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		cli.GetCmdPaymentTemplate(cdc),
 		cli.GetCmdPaymentContract(cdc),
 		cli.GetCmdSubscription(cdc),
+		cli.GetCmdPaymentReceipts(cdc),
+		cli.GetCmdExportPaymentReceipts(cdc),
+		cli.GetCmdPaymentContractsByPayer(cdc),
+		cli.GetCmdPaymentContractsByCreator(cdc),
+		cli.GetCmdPaymentContractsByTemplate(cdc),
+		cli.GetCmdSubscriptionsByContract(cdc),
 	)...)
 
 	return paymentsQueryCmd
@@ -15,11 +15,34 @@ const (
 	DefaultCodespace = types.DefaultCodespace
 	PaidoutStatus    = types.PaidoutStatus
 	FundedStatus     = types.FundedStatus
+	StartedStatus    = types.StartedStatus
+	StoppedStatus    = types.StoppedStatus
+	SuspendedStatus  = types.SuspendedStatus
+	CancelledStatus  = types.CancelledStatus
 
-	TypeMsgCreateProject = types.TypeMsgCreateProject
+	MinimumFundingGuard    = types.MinimumFundingGuard
+	ApprovedEvaluatorGuard = types.ApprovedEvaluatorGuard
+	NoPendingClaimsGuard   = types.NoPendingClaimsGuard
+
+	PendingAgent    = types.PendingAgent
+	ApprovedAgent   = types.ApprovedAgent
+	RevokedAgent    = types.RevokedAgent
+	ServiceAgent    = types.ServiceAgent
+	EvaluationAgent = types.EvaluationAgent
+	InvestmentAgent = types.InvestmentAgent
+
+	PendingClaim  = types.PendingClaim
+	ApprovedClaim = types.ApprovedClaim
+	RejectedClaim = types.RejectedClaim
 
-	MsgCreateProjectFee            = types.MsgCreateProjectFee
-	MsgCreateProjectTransactionFee = types.MsgCreateProjectTransactionFee
+	ReplaceProjectData    = types.ReplaceProjectData
+	MergePatchProjectData = types.MergePatchProjectData
+
+	NoTargetAction            = types.NoTargetAction
+	UpdateStatusTargetAction  = types.UpdateStatusTargetAction
+	EffectPaymentTargetAction = types.EffectPaymentTargetAction
+
+	TypeMsgCreateProject = types.TypeMsgCreateProject
 )
 
 type (
@@ -33,6 +56,9 @@ type (
 	MsgCreateClaim         = types.MsgCreateClaim
 	MsgCreateEvaluation    = types.MsgCreateEvaluation
 	MsgWithdrawFunds       = types.MsgWithdrawFunds
+	MsgUpdateProjectDoc    = types.MsgUpdateProjectDoc
+	MsgRegisterSchema      = types.MsgRegisterSchema
+	MsgResolveClaimDispute = types.MsgResolveClaimDispute
 
 	ProjectDoc       = types.ProjectDoc
 	StoredProjectDoc = types.StoredProjectDoc
@@ -41,6 +67,36 @@ type (
 	AccountMap        = types.AccountMap
 	GenesisAccountMap = types.GenesisAccountMap
 	InternalAccountID = types.InternalAccountID
+	ProjectStatus     = types.ProjectStatus
+
+	ProjectAgent = types.ProjectAgent
+	AgentRole    = types.AgentRole
+	AgentStatus  = types.AgentStatus
+
+	Claim       = types.Claim
+	ClaimStatus = types.ClaimStatus
+
+	ResolveClaimDisputeDoc = types.ResolveClaimDisputeDoc
+
+	Target         = types.Target
+	Targets        = types.Targets
+	TargetAction   = types.TargetAction
+	TargetProgress = types.TargetProgress
+
+	ProjectFunding = types.ProjectFunding
+
+	StatusGuard      = types.StatusGuard
+	StatusGuards     = types.StatusGuards
+	StatusGuardsList = types.StatusGuardsList
+
+	ProjectDocVersion     = types.ProjectDocVersion
+	ProjectDataUpdateMode = types.ProjectDataUpdateMode
+	UpdateProjectDataDoc  = types.UpdateProjectDataDoc
+
+	ProjectSchema     = types.ProjectSchema
+	RegisterSchemaDoc = types.RegisterSchemaDoc
+
+	AgentStats = types.AgentStats
 )
 
 var (
@@ -53,7 +109,26 @@ var (
 	ValidateParams = types.ValidateParams
 	RegisterCodec  = types.RegisterCodec
 
-	NewProjectDoc = types.NewProjectDoc
+	NewProjectDoc   = types.NewProjectDoc
+	NewProjectAgent = types.NewProjectAgent
+	NewClaim        = types.NewClaim
+
+	NewTargetProgress = types.NewTargetProgress
+
+	NewWithdrawalInfo     = types.NewWithdrawalInfo
+	GetWithdrawalActionId = types.GetWithdrawalActionId
+
+	DefaultProjectFunding = types.DefaultProjectFunding
+
+	NewStatusGuards      = types.NewStatusGuards
+	DefaultStatusGuards  = types.DefaultStatusGuards
+	IsValidProjectStatus = types.IsValidProjectStatus
+
+	NewProjectDocVersion = types.NewProjectDocVersion
+	MergePatch           = types.MergePatch
+
+	NewProjectSchema  = types.NewProjectSchema
+	CompileJsonSchema = types.CompileJsonSchema
 
 	NewGenesisState     = types.NewGenesisState
 	DefaultGenesisState = types.DefaultGenesisState
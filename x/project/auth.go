@@ -2,41 +2,55 @@ package project
 
 import (
 	"fmt"
-	"github.com/btcsuite/btcutil/base58"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
 	"github.com/ixofoundation/ixo-blockchain/x/ixo"
 	"github.com/tendermint/tendermint/crypto"
-	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
 func GetPubKeyGetter(keeper Keeper, didKeeper did.Keeper) ixo.PubKeyGetter {
 	return func(ctx sdk.Context, msg ixo.IxoMsg) (pubKey crypto.PubKey, res sdk.Result) {
 
 		// Get signer PubKey
-		var pubKeyEd25519 ed25519.PubKeyEd25519
 		switch msg := msg.(type) {
 		case MsgCreateProject:
-			copy(pubKeyEd25519[:], base58.Decode(msg.GetPubKey()))
-		case MsgWithdrawFunds:
+			return exported.VerifyKeyToPubKey(msg.GetPubKey()), sdk.Result{}
+		case MsgWithdrawFunds, MsgRegisterSchema:
 			signerDid := msg.GetSignerDid()
 			signerDoc, _ := didKeeper.GetDidDoc(ctx, signerDid)
 			if signerDoc == nil {
 				return pubKey, sdk.ErrUnauthorized("signer did not found").Result()
+			} else if signerDoc.IsDeactivated() {
+				return pubKey, did.ErrorDidDeactivated(did.DefaultCodespace, signerDid).Result()
 			}
-			copy(pubKeyEd25519[:], base58.Decode(signerDoc.GetPubKey()))
+			return signerDoc.GetSignerPubKey(), sdk.Result{}
 		default:
 			// For the remaining messages, the project is the signer
-			projectDoc, err := keeper.GetProjectDoc(ctx, msg.GetSignerDid())
+			projectDid := msg.GetSignerDid()
+			projectDoc, err := keeper.GetProjectDoc(ctx, projectDid)
 			if err != nil {
 				return pubKey, sdk.ErrInternal("project did not found").Result()
 			}
-			copy(pubKeyEd25519[:], base58.Decode(projectDoc.GetPubKey()))
+
+			// A project whose DID was registered with the project's pubKey is
+			// controlled through the DID doc, so that the DID's deactivation,
+			// key rotation and multisig controllers apply to the project too
+			projectDidDoc, _ := didKeeper.GetDidDoc(ctx, projectDid)
+			if projectDidDoc != nil {
+				initialPubKey, _ := didKeeper.GetInitialPubKey(ctx, projectDid)
+				if initialPubKey == projectDoc.GetPubKey() {
+					if projectDidDoc.IsDeactivated() {
+						return pubKey, did.ErrorDidDeactivated(did.DefaultCodespace, projectDid).Result()
+					}
+					return projectDidDoc.GetSignerPubKey(), sdk.Result{}
+				}
+			}
+			return exported.VerifyKeyToPubKey(projectDoc.GetPubKey()), sdk.Result{}
 		}
-		return pubKeyEd25519, sdk.Result{}
 	}
 }
 
@@ -88,7 +102,7 @@ func getProjectCreationSignBytes(chainID string, tx auth.StdTx, acc auth.Account
 }
 
 func NewProjectCreationAnteHandler(ak auth.AccountKeeper, sk supply.Keeper,
-	bk bank.Keeper, didKeeper did.Keeper,
+	bk bank.Keeper, didKeeper did.Keeper, projectKeeper Keeper,
 	pubKeyGetter ixo.PubKeyGetter) sdk.AnteHandler {
 	return func(
 		ctx sdk.Context, tx sdk.Tx, simulate bool,
@@ -108,9 +122,45 @@ func NewProjectCreationAnteHandler(ak auth.AccountKeeper, sk supply.Keeper,
 		}
 
 		params := ak.GetParams(ctx)
-
-		// Project creation uses an infinite gas meter
-		newCtx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		projectParams := projectKeeper.GetParams(ctx)
+
+		// Project creation uses a gas meter bounded by the tx's gas limit, or
+		// by the ProjectCreationGasLimit param if the tx does not specify one,
+		// so that large project data is charged for. The fee is fixed, so the
+		// tx's gas limit cannot exceed the ProjectCreationGasLimit.
+		gasLimit := stdTx.Fee.Gas
+		if gasLimit == 0 {
+			gasLimit = projectParams.ProjectCreationGasLimit
+		} else if gasLimit > projectParams.ProjectCreationGasLimit {
+			newCtx = auth.SetGasMeter(simulate, ctx, 0)
+			return newCtx, sdk.ErrInternal(fmt.Sprintf(
+				"gas limit %d exceeds project creation gas limit %d",
+				gasLimit, projectParams.ProjectCreationGasLimit)).Result(), true
+		}
+		newCtx = auth.SetGasMeter(simulate, ctx, gasLimit)
+
+		// AnteHandlers must have their own defer/recover in order for the BaseApp
+		// to know how much gas was used! This is because the GasMeter is created in
+		// the AnteHandler, but if it panics the context won't be set properly in
+		// runTx's recover call.
+		defer func() {
+			if r := recover(); r != nil {
+				switch rType := r.(type) {
+				case sdk.ErrorOutOfGas:
+					log := fmt.Sprintf(
+						"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+						rType.Descriptor, gasLimit, newCtx.GasMeter().GasConsumed(),
+					)
+					res = sdk.ErrOutOfGas(log).Result()
+
+					res.GasWanted = gasLimit
+					res.GasUsed = newCtx.GasMeter().GasConsumed()
+					abort = true
+				default:
+					panic(r)
+				}
+			}
+		}()
 
 		if err := tx.ValidateBasic(); err != nil {
 			return newCtx, err.Result(), true
@@ -146,15 +196,16 @@ func NewProjectCreationAnteHandler(ak auth.AccountKeeper, sk supply.Keeper,
 			return newCtx, sdk.ErrInternal("expected project account to not exist").Result(), true
 		}
 
-		// confirm that fee is the exact amount expected
-		expectedTotalFee := sdk.NewCoins(sdk.NewCoin(
-			ixo.IxoNativeToken, sdk.NewInt(MsgCreateProjectFee)))
-		if !stdTx.Fee.Amount.IsEqual(expectedTotalFee) {
+		// confirm that fee is the exact amount expected (compared in both
+		// directions since IsEqual panics if the fee is in another denom)
+		expectedTotalFee := projectParams.ProjectCreationFee
+		if !stdTx.Fee.Amount.IsAllGTE(expectedTotalFee) ||
+			!expectedTotalFee.IsAllGTE(stdTx.Fee.Amount) {
 			return newCtx, sdk.ErrInvalidCoins("invalid fee").Result(), true
 		}
 
 		// Calculate transaction fee and project funding
-		transactionFee := sdk.NewCoins(sdk.NewCoin(ixo.IxoNativeToken, sdk.NewInt(MsgCreateProjectTransactionFee)))
+		transactionFee := projectParams.ProjectCreationTransactionFee
 		projectFunding := expectedTotalFee.Sub(transactionFee) // panics if negative result
 
 		// deduct the fees
@@ -163,6 +214,8 @@ func NewProjectCreationAnteHandler(ak auth.AccountKeeper, sk supply.Keeper,
 			feePayerDidDoc, err := didKeeper.GetDidDoc(ctx, msg.SenderDid)
 			if err != nil {
 				return newCtx, err.Result(), true
+			} else if feePayerDidDoc.IsDeactivated() {
+				return newCtx, did.ErrorDidDeactivated(did.DefaultCodespace, msg.SenderDid).Result(), true
 			}
 			feePayerAcc, res := auth.GetSignerAcc(ctx, ak, feePayerDidDoc.Address())
 			if !res.IsOK() {
@@ -202,6 +255,6 @@ func NewProjectCreationAnteHandler(ak auth.AccountKeeper, sk supply.Keeper,
 
 		ak.SetAccount(newCtx, signerAcc)
 
-		return newCtx, sdk.Result{GasWanted: stdTx.Fee.Gas}, false // continue...
+		return newCtx, sdk.Result{GasWanted: gasLimit}, false // continue...
 	}
 }
@@ -0,0 +1,226 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/ixo"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles"
+	"github.com/ixofoundation/ixo-blockchain/x/payments"
+	"github.com/ixofoundation/ixo-blockchain/x/project/internal/types"
+)
+
+const (
+	testAnteChainId    = "test-chain"
+	testAnteProjectDid = "did:ixo:U7GK8p8rVhJMKhBVRCJJ8c"
+	testAnteSenderDid  = "did:ixo:4XJLBfGtWSGKSz4BeRxdun"
+)
+
+type anteTestInput struct {
+	ctx         sdk.Context
+	ak          auth.AccountKeeper
+	sk          supply.Keeper
+	k           Keeper
+	anteHandler sdk.AnteHandler
+	projectKey  ed25519.PrivKeyEd25519
+	senderAddr  sdk.AccAddress
+}
+
+func newAnteTestPrivKey() ed25519.PrivKeyEd25519 {
+	// Some keys encode to fewer than 44 base58 characters and are not valid
+	privKey := ed25519.GenPrivKey()
+	for !did.IsValidPubKey(base58.Encode(privKey[32:])) {
+		privKey = ed25519.GenPrivKey()
+	}
+	return privKey
+}
+
+// createAnteTestInput sets up the keepers used by the project creation ante
+// handler, with a sender DID whose account holds the specified balance.
+func createAnteTestInput(t *testing.T, balance sdk.Coins) anteTestInput {
+	keyProject := sdk.NewKVStoreKey(types.StoreKey)
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyDid := sdk.NewKVStoreKey(did.StoreKey)
+	keyPayments := sdk.NewKVStoreKey(payments.StoreKey)
+	keyOracles := sdk.NewKVStoreKey(oracles.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	for _, key := range []sdk.StoreKey{keyProject, keyAcc, keySupply,
+		keyDid, keyPayments, keyOracles, keyParams} {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	}
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	header := abci.Header{ChainID: testAnteChainId, Height: 1}
+	ctx := sdk.NewContext(ms, header, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	did.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+
+	maccPerms := map[string][]string{auth.FeeCollectorName: nil}
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, nil)
+	sk := supply.NewKeeper(cdc, keySupply, ak, bk, maccPerms)
+	didKeeper := did.NewKeeper(cdc, keyDid, pk.Subspace(did.DefaultParamspace))
+	oraclesKeeper := oracles.NewKeeper(cdc, keyOracles, pk.Subspace(oracles.DefaultParamspace))
+	paymentsKeeper := payments.NewKeeper(cdc, keyPayments, pk.Subspace(payments.DefaultParamspace),
+		bk, didKeeper, oraclesKeeper, nil)
+	k := NewKeeper(cdc, keyProject, pk.Subspace(types.DefaultParamspace), ak, didKeeper, paymentsKeeper)
+
+	ak.SetParams(ctx, auth.DefaultParams())
+	k.SetParams(ctx, types.DefaultParams())
+	sk.SetSupply(ctx, supply.NewSupply(balance))
+
+	// Sender DID (fee payer) with a funded account
+	senderKey := newAnteTestPrivKey()
+	senderDidDoc := did.NewBaseDidDoc(testAnteSenderDid, base58.Encode(senderKey[32:]))
+	didKeeper.AddDidDoc(ctx, senderDidDoc)
+	senderAcc := ak.NewAccountWithAddress(ctx, senderDidDoc.Address())
+	require.Nil(t, senderAcc.SetCoins(balance))
+	ak.SetAccount(ctx, senderAcc)
+
+	return anteTestInput{
+		ctx:         ctx,
+		ak:          ak,
+		sk:          sk,
+		k:           k,
+		anteHandler: NewProjectCreationAnteHandler(ak, sk, bk, didKeeper, k, GetPubKeyGetter(k, didKeeper)),
+		projectKey:  newAnteTestPrivKey(),
+		senderAddr:  senderDidDoc.Address(),
+	}
+}
+
+// createProjectTx builds a project creation tx with the specified fee, signed
+// by the project.
+func (in anteTestInput) createProjectTx(t *testing.T, fee auth.StdFee) auth.StdTx {
+	msg := types.ValidCreateProjectMsg
+	msg.SenderDid = testAnteSenderDid
+	msg.ProjectDid = testAnteProjectDid
+	msg.PubKey = base58.Encode(in.projectKey[32:])
+
+	tx := auth.NewStdTx([]sdk.Msg{msg}, fee, nil, "")
+	signBytes := auth.StdSignBytes(testAnteChainId, 0, 0, tx.Fee, tx.Msgs, tx.Memo)
+	sig, err := in.projectKey.Sign(signBytes)
+	require.Nil(t, err)
+	tx.Signatures = []auth.StdSignature{{PubKey: in.projectKey.PubKey(), Signature: sig}}
+	return tx
+}
+
+func TestProjectCreationAnteHandlerFees(t *testing.T) {
+	creationFee := types.DefaultParams().ProjectCreationFee
+	txFee := types.DefaultParams().ProjectCreationTransactionFee
+	denom := ixo.IxoNativeToken
+	balance := sdk.NewCoins(sdk.NewInt64Coin(denom, 5000000))
+
+	tests := []struct {
+		name string
+		fee  sdk.Coins
+		code sdk.CodeType
+	}{
+		{"exact", creationFee, sdk.CodeOK},
+		{"underpaid", creationFee.Sub(sdk.NewCoins(sdk.NewInt64Coin(denom, 1))), sdk.CodeInvalidCoins},
+		{"overpaid", creationFee.Add(sdk.NewCoins(sdk.NewInt64Coin(denom, 1))), sdk.CodeInvalidCoins},
+		{"other denom", sdk.NewCoins(sdk.NewInt64Coin("other", 1000000)), sdk.CodeInvalidCoins},
+		{"extra denom", creationFee.Add(sdk.NewCoins(sdk.NewInt64Coin("other", 1))), sdk.CodeInvalidCoins},
+		{"no fee", nil, sdk.CodeInvalidCoins},
+	}
+
+	for _, tc := range tests {
+		in := createAnteTestInput(t, balance)
+		tx := in.createProjectTx(t, auth.NewStdFee(0, tc.fee))
+		_, res, abort := in.anteHandler(in.ctx, tx, false)
+		require.Equal(t, tc.code, res.Code, "%s: %s", tc.name, res.Log)
+		require.Equal(t, !res.IsOK(), abort, tc.name)
+
+		projectAddr := sdk.AccAddress(in.projectKey.PubKey().Address())
+		feeCollectorAddr := in.sk.GetModuleAddress(auth.FeeCollectorName)
+		senderCoins := in.ak.GetAccount(in.ctx, in.senderAddr).GetCoins()
+		if !res.IsOK() {
+			// Nothing is deducted and the project account is not created
+			require.Equal(t, balance, senderCoins, tc.name)
+			require.Nil(t, in.ak.GetAccount(in.ctx, projectAddr), tc.name)
+			continue
+		}
+
+		// The transaction fee goes to the fee collector and the rest of the
+		// fee funds the project, whose account now has its pubKey set
+		require.Equal(t, balance.Sub(creationFee), senderCoins, tc.name)
+		require.Equal(t, txFee, in.ak.GetAccount(in.ctx, feeCollectorAddr).GetCoins(), tc.name)
+		projectAcc := in.ak.GetAccount(in.ctx, projectAddr)
+		require.Equal(t, creationFee.Sub(txFee), projectAcc.GetCoins(), tc.name)
+		require.Equal(t, in.projectKey.PubKey(), projectAcc.GetPubKey(), tc.name)
+		require.Equal(t, uint64(1), projectAcc.GetSequence(), tc.name)
+	}
+}
+
+func TestProjectCreationAnteHandlerInsufficientFunds(t *testing.T) {
+	creationFee := types.DefaultParams().ProjectCreationFee
+	in := createAnteTestInput(t, creationFee.Sub(sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 1))))
+
+	tx := in.createProjectTx(t, auth.NewStdFee(0, creationFee))
+	_, res, abort := in.anteHandler(in.ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeInsufficientCoins, res.Code)
+}
+
+func TestProjectCreationAnteHandlerGas(t *testing.T) {
+	creationFee := types.DefaultParams().ProjectCreationFee
+	balance := sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 5000000))
+	gasLimit := types.DefaultParams().ProjectCreationGasLimit
+
+	// Without a gas limit in the tx, the ProjectCreationGasLimit applies
+	in := createAnteTestInput(t, balance)
+	newCtx, res, abort := in.anteHandler(in.ctx, in.createProjectTx(t, auth.NewStdFee(0, creationFee)), false)
+	require.False(t, abort)
+	require.Equal(t, gasLimit, res.GasWanted)
+	require.Equal(t, gasLimit, newCtx.GasMeter().Limit())
+
+	// A gas limit above the ProjectCreationGasLimit is rejected
+	in = createAnteTestInput(t, balance)
+	_, res, abort = in.anteHandler(in.ctx, in.createProjectTx(t, auth.NewStdFee(gasLimit+1, creationFee)), false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeInternal, res.Code)
+
+	// Large txs run out of gas, whether limited by the tx or by the param
+	txBytes := make([]byte, 100000)
+	in = createAnteTestInput(t, balance)
+	_, res, abort = in.anteHandler(in.ctx.WithTxBytes(txBytes),
+		in.createProjectTx(t, auth.NewStdFee(1000, creationFee)), false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeOutOfGas, res.Code)
+	require.Equal(t, uint64(1000), res.GasWanted)
+
+	in = createAnteTestInput(t, balance)
+	params := in.k.GetParams(in.ctx)
+	params.ProjectCreationGasLimit = 1000
+	in.k.SetParams(in.ctx, params)
+	_, res, abort = in.anteHandler(in.ctx.WithTxBytes(txBytes),
+		in.createProjectTx(t, auth.NewStdFee(0, creationFee)), false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeOutOfGas, res.Code)
+	require.Nil(t, in.ak.GetAccount(in.ctx, sdk.AccAddress(in.projectKey.PubKey().Address())))
+}
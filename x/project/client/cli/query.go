@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+
 	"github.com/ixofoundation/ixo-blockchain/x/did"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -123,6 +125,326 @@ func GetCmdProjectTxs(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+const (
+	FlagRole   = "role"
+	FlagStatus = "status"
+	FlagAgent  = "agent"
+)
+
+func GetCmdProjectAgents(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-project-agents [project-did]",
+		Short: "Get the agents of a project, optionally filtered by role and status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			projectDid := args[0]
+			role, _ := cmd.Flags().GetString(FlagRole)
+			status, _ := cmd.Flags().GetString(FlagStatus)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s/%s",
+				types.QuerierRoute, keeper.QueryProjectAgents, projectDid, role, status), nil)
+			if err != nil {
+				return err
+			}
+
+			var agents []types.ProjectAgent
+			err = cdc.UnmarshalJSON(res, &agents)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(agents, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagRole, "", "Only include agents with this role ('SA', 'EA' or 'IA')")
+	cmd.Flags().String(FlagStatus, "", "Only include agents with this status ('0', '1' or '2')")
+
+	return cmd
+}
+
+func GetCmdProjectClaims(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-project-claims [project-did]",
+		Short: "Get the claims of a project, optionally filtered by status and agent",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			projectDid := args[0]
+			status, _ := cmd.Flags().GetString(FlagStatus)
+			agentDid, _ := cmd.Flags().GetString(FlagAgent)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s/%s",
+				types.QuerierRoute, keeper.QueryProjectClaims, projectDid, status, agentDid), nil)
+			if err != nil {
+				return err
+			}
+
+			var claims []types.Claim
+			err = cdc.UnmarshalJSON(res, &claims)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(claims, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "Only include claims with this status ('0', '1' or '2')")
+	cmd.Flags().String(FlagAgent, "", "Only include claims submitted or evaluated by this agent DID")
+
+	return cmd
+}
+
+func GetCmdProjectTargets(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-project-targets [project-did]",
+		Short: "Get the progress towards each of the targets of a project",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			projectDid := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+				types.QuerierRoute, keeper.QueryProjectTargets, projectDid), nil)
+			if err != nil {
+				return err
+			}
+
+			var progresses []types.TargetProgress
+			err = cdc.UnmarshalJSON(res, &progresses)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(progresses, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdRecipientWithdrawals(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-recipient-withdrawals [recipient-did] [page] [limit]",
+		Short: "Get a page of the project withdrawals made to a recipient",
+		Args:  cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Page and limit are optional and are left for the querier to
+			// default and validate if not specified
+			path := []string{args[0], "", ""}
+			copy(path, args)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+				types.QuerierRoute, keeper.QueryRecipientWithdrawals,
+				strings.Join(path, "/")), nil)
+			if err != nil {
+				return err
+			}
+
+			var txs []types.WithdrawalInfo
+			err = cdc.UnmarshalJSON(res, &txs)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(txs, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdProjectDocVersions(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-project-doc-versions [project-did]",
+		Short: "Get all versions of the data of a project",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			projectDid := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+				types.QuerierRoute, keeper.QueryProjectDocVersions, projectDid), nil)
+			if err != nil {
+				return err
+			}
+
+			var versions []types.ProjectDocVersion
+			err = cdc.UnmarshalJSON(res, &versions)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(versions, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdProjectDocVersion(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-project-doc-version [project-did] [version]",
+		Short: "Get a specific version of the data of a project",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			projectDid := args[0]
+			version := args[1]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s",
+				types.QuerierRoute, keeper.QueryProjectDocVersion, projectDid, version), nil)
+			if err != nil {
+				return err
+			}
+
+			var docVersion types.ProjectDocVersion
+			err = cdc.UnmarshalJSON(res, &docVersion)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(docVersion, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdSchemas(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-schemas",
+		Short: "Get all registered project data schemas",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s",
+				types.QuerierRoute, keeper.QuerySchemas), nil)
+			if err != nil {
+				return err
+			}
+
+			var schemas []types.ProjectSchema
+			err = cdc.UnmarshalJSON(res, &schemas)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(schemas, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdSchema(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-schema [schema-id]",
+		Short: "Get a registered project data schema",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			schemaId := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+				types.QuerierRoute, keeper.QuerySchema, schemaId), nil)
+			if err != nil {
+				return err
+			}
+
+			var schema types.ProjectSchema
+			err = cdc.UnmarshalJSON(res, &schema)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(schema, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdAgentStats(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-agent-stats [agent-did]",
+		Short: "Get the claim and evaluation statistics of an agent across all projects",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			agentDid := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+				types.QuerierRoute, keeper.QueryAgentStats, agentDid), nil)
+			if err != nil {
+				return err
+			}
+
+			var stats types.AgentStats
+			err = cdc.UnmarshalJSON(res, &stats)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(stats, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
 func GetParamsRequestHandler(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "params",
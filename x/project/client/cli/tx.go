@@ -9,7 +9,9 @@ import (
 	"github.com/ixofoundation/ixo-blockchain/x/ixo"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"strconv"
 
+	projectclient "github.com/ixofoundation/ixo-blockchain/x/project/client"
 	"github.com/ixofoundation/ixo-blockchain/x/project/internal/types"
 )
 
@@ -29,9 +31,14 @@ func GetCmdCreateProject(cdc *codec.Codec) *cobra.Command {
 			cliCtx := context.NewCLIContext().WithCodec(cdc).
 				WithFromAddress(ixoDid.Address())
 
+			params, err := projectclient.QueryParams(cliCtx)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateProject(
 				senderDid, json.RawMessage(projectDataStr), ixoDid)
-			stdSignMsg := msg.ToStdSignMsg(types.MsgCreateProjectFee)
+			stdSignMsg := msg.ToStdSignMsg(params.ProjectCreationFee)
 
 			res, err := ixo.SignAndBroadcastTxFromStdSignMsg(cliCtx, stdSignMsg, ixoDid)
 			if err != nil {
@@ -59,14 +66,10 @@ func GetCmdUpdateProjectStatus(cdc *codec.Codec) *cobra.Command {
 			}
 
 			projectStatus := types.ProjectStatus(status)
-			if projectStatus != types.CreatedProject &&
-				projectStatus != types.PendingStatus &&
-				projectStatus != types.FundedStatus &&
-				projectStatus != types.StartedStatus &&
-				projectStatus != types.StoppedStatus &&
-				projectStatus != types.PaidoutStatus {
+			if !types.IsValidProjectStatus(projectStatus) {
 				return errors.New("The status must be one of 'CREATED', " +
-					"'PENDING', 'FUNDED', 'STARTED', 'STOPPED' or 'PAIDOUT'")
+					"'PENDING', 'FUNDED', 'STARTED', 'STOPPED', 'PAIDOUT', " +
+					"'SUSPENDED' or 'CANCELLED'")
 			}
 
 			updateProjectStatusDoc := types.UpdateProjectStatusDoc{
@@ -83,6 +86,39 @@ func GetCmdUpdateProjectStatus(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdUpdateProjectDoc(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-project-doc [sender-did] [mode] [project-data-json] [ixo-did]",
+		Short: "Replace or merge-patch the data of a project signed by the ixoDid of the project",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			senderDid := args[0]
+			mode := types.ProjectDataUpdateMode(args[1])
+			projectData := args[2]
+			ixoDid, err := did.UnmarshalIxoDid(args[3])
+			if err != nil {
+				return err
+			}
+
+			if !types.IsValidProjectDataUpdateMode(mode) {
+				return errors.New("The mode must be one of 'replace' or 'merge_patch'")
+			}
+
+			updateProjectDataDoc := types.UpdateProjectDataDoc{
+				Mode: mode,
+				Data: json.RawMessage(projectData),
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgUpdateProjectDoc(senderDid, updateProjectDataDoc, ixoDid)
+
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+}
+
 func GetCmdCreateAgent(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use: "create-agent [tx-hash] [sender-did] [agent-did] " +
@@ -121,7 +157,7 @@ func GetCmdCreateAgent(cdc *codec.Codec) *cobra.Command {
 func GetCmdUpdateAgent(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use: "update-agent [tx-hash] [sender-did] [agent-did] " +
-			"[status] [ixo-did]",
+			"[status] [role] [ixo-did]",
 		Short: "Update the status of an agent on a project signed by the ixoDid of the project",
 		Args:  cobra.ExactArgs(6),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -155,8 +191,34 @@ func GetCmdUpdateAgent(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+const (
+	FlagClaimTemplate = "claim-template"
+	FlagSenderIxoDid  = "sender-ixo-did"
+)
+
+// signBySender returns the signature by the sender's ixo DID (if specified
+// using the FlagSenderIxoDid flag) of the proof that it sent a claim or
+// evaluation, so that the claim or evaluation is attributed to the sender.
+func signBySender(cmd *cobra.Command, proofBytes []byte) ([]byte, error) {
+	senderIxoDidStr, _ := cmd.Flags().GetString(FlagSenderIxoDid)
+	if senderIxoDidStr == "" {
+		return nil, nil
+	}
+
+	senderIxoDid, err := did.UnmarshalIxoDid(senderIxoDidStr)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := ixo.SignBytesWithIxoDid(proofBytes, senderIxoDid)
+	if err != nil {
+		return nil, err
+	}
+	return sig.Signature, nil
+}
+
 func GetCmdCreateClaim(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "create-claim [tx-hash] [sender-did] [claim-id] [ixo-did]",
 		Short: "Create a new claim on a project signed by the ixoDid of the project",
 		Args:  cobra.ExactArgs(4),
@@ -164,8 +226,10 @@ func GetCmdCreateClaim(cdc *codec.Codec) *cobra.Command {
 			txHash := args[0]
 			senderDid := args[1]
 			claimId := args[2]
+			claimTemplateId, _ := cmd.Flags().GetString(FlagClaimTemplate)
 			createClaimDoc := types.CreateClaimDoc{
-				ClaimID: claimId,
+				ClaimID:         claimId,
+				ClaimTemplateID: claimTemplateId,
 			}
 
 			ixoDid, err := did.UnmarshalIxoDid(args[3])
@@ -177,14 +241,23 @@ func GetCmdCreateClaim(cdc *codec.Codec) *cobra.Command {
 				WithFromAddress(ixoDid.Address())
 
 			msg := types.NewMsgCreateClaim(txHash, senderDid, createClaimDoc, ixoDid)
+			msg.SenderSignature, err = signBySender(cmd, msg.GetSenderProofBytes())
+			if err != nil {
+				return err
+			}
 
 			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
 		},
 	}
+
+	cmd.Flags().String(FlagClaimTemplate, "", "ID of the claim template that the claim is for")
+	cmd.Flags().String(FlagSenderIxoDid, "", "ixoDid of the sender, to attribute the claim to the sender")
+
+	return cmd
 }
 
 func GetCmdCreateEvaluation(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use: "create-evaluation [tx-hash] [sender-did] [claim-id] " +
 			"[status] [ixo-did]",
 		Short: "Create a new claim evaluation on a project signed by the ixoDid of the project",
@@ -212,6 +285,49 @@ func GetCmdCreateEvaluation(cdc *codec.Codec) *cobra.Command {
 				WithFromAddress(ixoDid.Address())
 
 			msg := types.NewMsgCreateEvaluation(txHash, senderDid, createEvaluationDoc, ixoDid)
+			msg.SenderSignature, err = signBySender(cmd, msg.GetSenderProofBytes())
+			if err != nil {
+				return err
+			}
+
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+
+	cmd.Flags().String(FlagSenderIxoDid, "", "ixoDid of the sender, to attribute the evaluation to the sender")
+
+	return cmd
+}
+
+func GetCmdResolveClaimDispute(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use: "resolve-claim-dispute [tx-hash] [sender-did] [claim-id] " +
+			"[evaluation-upheld] [ixo-did]",
+		Short: "Resolve a dispute over the evaluation of a rejected claim signed by the ixoDid of the project",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txHash := args[0]
+			senderDid := args[1]
+			claimId := args[2]
+			evaluationUpheld, err := strconv.ParseBool(args[3])
+			if err != nil {
+				return err
+			}
+
+			resolveClaimDisputeDoc := types.ResolveClaimDisputeDoc{
+				ClaimID:          claimId,
+				EvaluationUpheld: evaluationUpheld,
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(args[4])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgResolveClaimDispute(txHash, senderDid, resolveClaimDisputeDoc, ixoDid)
 
 			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
 		},
@@ -244,3 +360,31 @@ func GetCmdWithdrawFunds(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetCmdRegisterSchema(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "register-schema [schema-id] [schema-json] [sender-did]",
+		Short: "Register a JSON schema for project data, signed by the schema admin",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			schemaId := args[0]
+			schema := args[1]
+			ixoDid, err := did.UnmarshalIxoDid(args[2])
+			if err != nil {
+				return err
+			}
+
+			data := types.RegisterSchemaDoc{
+				SchemaId: schemaId,
+				Schema:   json.RawMessage(schema),
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgRegisterSchema(ixoDid.Did, data)
+
+			return ixo.GenerateOrBroadcastMsgs(cliCtx, msg, ixoDid)
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/ixofoundation/ixo-blockchain/x/project/internal/keeper"
+	"github.com/ixofoundation/ixo-blockchain/x/project/internal/types"
+)
+
+// QueryParams queries the project module params, which include the fee that
+// has to be paid when creating a project.
+func QueryParams(cliCtx context.CLIContext) (types.Params, error) {
+	bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s",
+		types.QuerierRoute, keeper.QueryParams), nil)
+	if err != nil {
+		return types.Params{}, err
+	}
+
+	var params types.Params
+	if err := cliCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+		return types.Params{}, err
+	}
+
+	return params, nil
+}
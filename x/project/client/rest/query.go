@@ -25,6 +25,15 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/project/{did}", queryProjectDocRequestHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/projectAccounts/{projectDid}", queryProjectAccountsRequestHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/projectTxs/{projectDid}", queryProjectTxsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/projectAgents/{projectDid}", queryProjectAgentsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/projectClaims/{projectDid}", queryProjectClaimsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/projectTargets/{projectDid}", queryProjectTargetsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/recipientWithdrawals/{recipientDid}", queryRecipientWithdrawalsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/projectDocVersions/{projectDid}", queryProjectDocVersionsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/projectDocVersions/{projectDid}/{version}", queryProjectDocVersionRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/projectSchemas", querySchemasRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/projectSchemas/{schemaId}", querySchemaRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/agentStats/{agentDid}", queryAgentStatsRequestHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/projectParams", queryParamsRequestHandler(cliCtx)).Methods("GET")
 }
 
@@ -118,6 +127,200 @@ func queryProjectTxsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 }
 
+func queryProjectAgentsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		projectDid := vars["projectDid"]
+		role := r.URL.Query().Get("role")
+		status := r.URL.Query().Get("status")
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryProjectAgents, projectDid, role, status), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query project agents. Error: %s", err.Error())))
+			return
+		}
+
+		var agents []types.ProjectAgent
+		cliCtx.Codec.MustUnmarshalJSON(res, &agents)
+
+		rest.PostProcessResponse(w, cliCtx, agents)
+	}
+}
+
+func queryProjectClaimsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		projectDid := vars["projectDid"]
+		status := r.URL.Query().Get("status")
+		agentDid := r.URL.Query().Get("agent")
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryProjectClaims, projectDid, status, agentDid), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query project claims. Error: %s", err.Error())))
+			return
+		}
+
+		var claims []types.Claim
+		cliCtx.Codec.MustUnmarshalJSON(res, &claims)
+
+		rest.PostProcessResponse(w, cliCtx, claims)
+	}
+}
+
+func queryProjectTargetsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		projectDid := vars["projectDid"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryProjectTargets, projectDid), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query project targets. Error: %s", err.Error())))
+			return
+		}
+
+		var progresses []types.TargetProgress
+		cliCtx.Codec.MustUnmarshalJSON(res, &progresses)
+
+		rest.PostProcessResponse(w, cliCtx, progresses)
+	}
+}
+
+func queryRecipientWithdrawalsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		recipientDid := vars["recipientDid"]
+		page := r.URL.Query().Get("page")
+		limit := r.URL.Query().Get("limit")
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryRecipientWithdrawals, recipientDid, page, limit), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query recipient withdrawals. Error: %s", err.Error())))
+			return
+		}
+
+		var txs []types.WithdrawalInfo
+		cliCtx.Codec.MustUnmarshalJSON(res, &txs)
+
+		rest.PostProcessResponse(w, cliCtx, txs)
+	}
+}
+
+func queryProjectDocVersionsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		projectDid := vars["projectDid"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryProjectDocVersions, projectDid), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query project doc versions. Error: %s", err.Error())))
+			return
+		}
+
+		var versions []types.ProjectDocVersion
+		cliCtx.Codec.MustUnmarshalJSON(res, &versions)
+
+		rest.PostProcessResponse(w, cliCtx, versions)
+	}
+}
+
+func queryProjectDocVersionRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		projectDid := vars["projectDid"]
+		version := vars["version"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryProjectDocVersion, projectDid, version), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query project doc version. Error: %s", err.Error())))
+			return
+		}
+
+		var docVersion types.ProjectDocVersion
+		cliCtx.Codec.MustUnmarshalJSON(res, &docVersion)
+
+		rest.PostProcessResponse(w, cliCtx, docVersion)
+	}
+}
+
+func querySchemasRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s",
+			types.QuerierRoute, keeper.QuerySchemas), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query project schemas. Error: %s", err.Error())))
+			return
+		}
+
+		var schemas []types.ProjectSchema
+		cliCtx.Codec.MustUnmarshalJSON(res, &schemas)
+
+		rest.PostProcessResponse(w, cliCtx, schemas)
+	}
+}
+
+func querySchemaRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		schemaId := vars["schemaId"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QuerySchema, schemaId), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query project schema. Error: %s", err.Error())))
+			return
+		}
+
+		var schema types.ProjectSchema
+		cliCtx.Codec.MustUnmarshalJSON(res, &schema)
+
+		rest.PostProcessResponse(w, cliCtx, schema)
+	}
+}
+
+func queryAgentStatsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		agentDid := vars["agentDid"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryAgentStats, agentDid), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query agent stats. Error: %s", err.Error())))
+			return
+		}
+
+		var stats types.AgentStats
+		cliCtx.Codec.MustUnmarshalJSON(res, &stats)
+
+		rest.PostProcessResponse(w, cliCtx, stats)
+	}
+}
+
 func queryParamsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/ixofoundation/ixo-blockchain/x/did"
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -18,10 +19,13 @@ import (
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/project", createProjectRequestHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/updateProjectStatus", updateProjectStatusRequestHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc("/updateProjectDoc", updateProjectDocRequestHandler(cliCtx)).Methods("PUT")
 	r.HandleFunc("/createAgent", createAgentRequestHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/createClaim", createClaimRequestHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/createEvaluation", createEvaluationRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/resolveClaimDispute", resolveClaimDisputeRequestHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/withdrawFunds", withdrawFundsRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/registerSchema", registerSchemaRequestHandler(cliCtx)).Methods("POST")
 }
 
 func createProjectRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -72,14 +76,10 @@ func updateProjectStatusRequestHandler(cliCtx context.CLIContext) http.HandlerFu
 		cliCtx = cliCtx.WithBroadcastMode(mode)
 
 		projectStatus := types.ProjectStatus(status)
-		if projectStatus != types.CreatedProject &&
-			projectStatus != types.PendingStatus &&
-			projectStatus != types.FundedStatus &&
-			projectStatus != types.StartedStatus &&
-			projectStatus != types.StoppedStatus &&
-			projectStatus != types.PaidoutStatus {
+		if !types.IsValidProjectStatus(projectStatus) {
 			_, _ = w.Write([]byte("The status must be one of 'CREATED', " +
-				"'PENDING', 'FUNDED', 'STARTED', 'STOPPED' or 'PAIDOUT'"))
+				"'PENDING', 'FUNDED', 'STARTED', 'STOPPED', 'PAIDOUT', " +
+				"'SUSPENDED' or 'CANCELLED'"))
 			return
 		}
 
@@ -100,6 +100,49 @@ func updateProjectStatusRequestHandler(cliCtx context.CLIContext) http.HandlerFu
 	}
 }
 
+func updateProjectDocRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		senderDid := r.URL.Query().Get("senderDid")
+		updateMode := r.URL.Query().Get("updateMode")
+		projectDataParam := r.URL.Query().Get("projectData")
+		ixoDidParam := r.URL.Query().Get("ixoDid")
+		mode := r.URL.Query().Get("mode")
+
+		ixoDid, err := did.UnmarshalIxoDid(ixoDidParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		dataUpdateMode := types.ProjectDataUpdateMode(updateMode)
+		if !types.IsValidProjectDataUpdateMode(dataUpdateMode) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("The updateMode must be one of 'replace' or 'merge_patch'"))
+			return
+		}
+
+		updateProjectDataDoc := types.UpdateProjectDataDoc{
+			Mode: dataUpdateMode,
+			Data: json.RawMessage(projectDataParam),
+		}
+
+		msg := types.NewMsgUpdateProjectDoc(senderDid, updateProjectDataDoc, ixoDid)
+
+		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, ixoDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
 func createAgentRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
@@ -148,7 +191,9 @@ func createClaimRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		txHash := r.URL.Query().Get("txHash")
 		senderDid := r.URL.Query().Get("senderDid")
 		claimId := r.URL.Query().Get("claimId")
+		claimTemplateId := r.URL.Query().Get("claimTemplateId")
 		ixoDidParam := r.URL.Query().Get("ixoDid")
+		senderIxoDidParam := r.URL.Query().Get("senderIxoDid")
 		mode := r.URL.Query().Get("mode")
 
 		ixoDid, err := did.UnmarshalIxoDid(ixoDidParam)
@@ -159,12 +204,19 @@ func createClaimRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		createClaimDoc := types.CreateClaimDoc{
-			ClaimID: claimId,
+			ClaimID:         claimId,
+			ClaimTemplateID: claimTemplateId,
 		}
 
 		cliCtx = cliCtx.WithBroadcastMode(mode)
 
 		msg := types.NewMsgCreateClaim(txHash, senderDid, createClaimDoc, ixoDid)
+		msg.SenderSignature, err = signBySender(senderIxoDidParam, msg.GetSenderProofBytes())
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
 
 		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, ixoDid)
 		if err != nil {
@@ -185,6 +237,7 @@ func createEvaluationRequestHandler(cliCtx context.CLIContext) http.HandlerFunc
 		claimDid := r.URL.Query().Get("claimDid")
 		status := r.URL.Query().Get("status")
 		ixoDidParam := r.URL.Query().Get("ixoDid")
+		senderIxoDidParam := r.URL.Query().Get("senderIxoDid")
 		mode := r.URL.Query().Get("mode")
 
 		ixoDid, err := did.UnmarshalIxoDid(ixoDidParam)
@@ -208,6 +261,75 @@ func createEvaluationRequestHandler(cliCtx context.CLIContext) http.HandlerFunc
 		}
 
 		msg := types.NewMsgCreateEvaluation(txHash, senderDid, createEvaluationDoc, ixoDid)
+		msg.SenderSignature, err = signBySender(senderIxoDidParam, msg.GetSenderProofBytes())
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, ixoDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+// signBySender returns the signature by the sender's ixo DID (if specified)
+// of the proof that it sent a claim or evaluation.
+func signBySender(senderIxoDidParam string, proofBytes []byte) ([]byte, error) {
+	if senderIxoDidParam == "" {
+		return nil, nil
+	}
+
+	senderIxoDid, err := did.UnmarshalIxoDid(senderIxoDidParam)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := ixo.SignBytesWithIxoDid(proofBytes, senderIxoDid)
+	if err != nil {
+		return nil, err
+	}
+	return sig.Signature, nil
+}
+
+func resolveClaimDisputeRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		txHash := r.URL.Query().Get("txHash")
+		senderDid := r.URL.Query().Get("senderDid")
+		claimId := r.URL.Query().Get("claimId")
+		evaluationUpheldParam := r.URL.Query().Get("evaluationUpheld")
+		ixoDidParam := r.URL.Query().Get("ixoDid")
+		mode := r.URL.Query().Get("mode")
+
+		ixoDid, err := did.UnmarshalIxoDid(ixoDidParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		evaluationUpheld, err := strconv.ParseBool(evaluationUpheldParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		resolveClaimDisputeDoc := types.ResolveClaimDisputeDoc{
+			ClaimID:          claimId,
+			EvaluationUpheld: evaluationUpheld,
+		}
+
+		msg := types.NewMsgResolveClaimDispute(txHash, senderDid, resolveClaimDisputeDoc, ixoDid)
 
 		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, ixoDid)
 		if err != nil {
@@ -256,3 +378,38 @@ func withdrawFundsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, output)
 	}
 }
+
+func registerSchemaRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		senderDidParam := r.URL.Query().Get("senderDid")
+		schemaId := r.URL.Query().Get("schemaId")
+		schemaParam := r.URL.Query().Get("schema")
+		mode := r.URL.Query().Get("mode")
+
+		senderDid, err := did.UnmarshalIxoDid(senderDidParam)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		data := types.RegisterSchemaDoc{
+			SchemaId: schemaId,
+			Schema:   json.RawMessage(schemaParam),
+		}
+
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		msg := types.NewMsgRegisterSchema(senderDid.Did, data)
+
+		output, err := ixo.CompleteAndBroadcastTxRest(cliCtx, msg, senderDid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
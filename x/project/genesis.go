@@ -17,7 +17,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 		panic(err)
 	}
 
-	// Initialise project docs, account maps, project withdrawals, params
+	// Initialise project docs, account maps, project withdrawals, agents, claims, targets, doc versions, schemas, agent stats, params
 	for i := range data.ProjectDocs {
 		keeper.SetProjectDoc(ctx, &data.ProjectDocs[i])
 		keeper.SetAccountMap(ctx,
@@ -25,11 +25,29 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 		keeper.SetProjectWithdrawalTransactions(ctx,
 			data.ProjectDocs[i].GetProjectDid(), data.WithdrawalsInfos[i])
 	}
+	for _, agent := range data.Agents {
+		keeper.SetAgent(ctx, agent)
+	}
+	for _, claim := range data.Claims {
+		keeper.SetClaim(ctx, claim)
+	}
+	for _, progress := range data.TargetProgresses {
+		keeper.SetTargetProgress(ctx, progress)
+	}
+	for _, version := range data.DocVersions {
+		keeper.SetProjectDocVersion(ctx, version)
+	}
+	for _, schema := range data.Schemas {
+		keeper.SetSchema(ctx, schema)
+	}
+	for _, stats := range data.AgentStats {
+		keeper.SetAgentStats(ctx, stats)
+	}
 	keeper.SetParams(ctx, data.Params)
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
-	// Export project docs, account maps, project withdrawals
+	// Export project docs, account maps, project withdrawals, agents, claims, targets, doc versions, schemas, agent stats
 	var projectDocs []ProjectDoc
 	var accountMaps []AccountMap
 	var withdrawalInfos [][]WithdrawalInfo
@@ -45,6 +63,41 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		withdrawalInfos = append(withdrawalInfos, withdrawalInfo)
 	}
 
+	var agents []ProjectAgent
+	agentIterator := k.GetAgentIterator(ctx)
+	for ; agentIterator.Valid(); agentIterator.Next() {
+		agents = append(agents, k.MustGetAgentByKey(ctx, agentIterator.Key()))
+	}
+
+	var claims []Claim
+	claimIterator := k.GetClaimIterator(ctx)
+	for ; claimIterator.Valid(); claimIterator.Next() {
+		claims = append(claims, k.MustGetClaimByKey(ctx, claimIterator.Key()))
+	}
+
+	var targetProgresses []TargetProgress
+	targetIterator := k.GetTargetProgressIterator(ctx)
+	for ; targetIterator.Valid(); targetIterator.Next() {
+		targetProgresses = append(targetProgresses,
+			k.MustGetTargetProgressByKey(ctx, targetIterator.Key()))
+	}
+
+	var docVersions []ProjectDocVersion
+	versionIterator := k.GetProjectDocVersionIterator(ctx)
+	for ; versionIterator.Valid(); versionIterator.Next() {
+		docVersions = append(docVersions,
+			k.MustGetProjectDocVersionByKey(ctx, versionIterator.Key()))
+	}
+
+	schemas := k.GetSchemas(ctx)
+
+	var agentStats []AgentStats
+	statsIterator := k.GetAgentStatsIterator(ctx)
+	for ; statsIterator.Valid(); statsIterator.Next() {
+		agentStats = append(agentStats,
+			k.MustGetAgentStatsByKey(ctx, statsIterator.Key()))
+	}
+
 	params := k.GetParams(ctx)
 
 	// Marshal/Unmarshal account maps into array of GenesisAccountMap
@@ -62,6 +115,12 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		ProjectDocs:      projectDocs,
 		AccountMaps:      genesisAccountMaps,
 		WithdrawalsInfos: withdrawalInfos,
+		Agents:           agents,
+		Claims:           claims,
+		TargetProgresses: targetProgresses,
+		DocVersions:      docVersions,
+		Schemas:          schemas,
+		AgentStats:       agentStats,
 		Params:           params,
 	}
 }
@@ -1,15 +1,15 @@
 package project
 
 import (
-	"encoding/hex"
 	"fmt"
 	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
 	"github.com/ixofoundation/ixo-blockchain/x/project/internal/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 
-	"github.com/ixofoundation/ixo-blockchain/x/ixo"
 	"github.com/ixofoundation/ixo-blockchain/x/payments"
 )
 
@@ -26,7 +26,7 @@ func NewHandler(k Keeper, fk payments.Keeper, bk bank.Keeper) sdk.Handler {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		switch msg := msg.(type) {
 		case MsgCreateProject:
-			return handleMsgCreateProject(ctx, k, msg)
+			return handleMsgCreateProject(ctx, k, fk, msg)
 		case MsgUpdateProjectStatus:
 			return handleMsgUpdateProjectStatus(ctx, k, bk, msg)
 		case MsgCreateAgent:
@@ -39,13 +39,24 @@ func NewHandler(k Keeper, fk payments.Keeper, bk bank.Keeper) sdk.Handler {
 			return handleMsgCreateEvaluation(ctx, k, fk, bk, msg)
 		case MsgWithdrawFunds:
 			return handleMsgWithdrawFunds(ctx, k, bk, msg)
+		case MsgUpdateProjectDoc:
+			return handleMsgUpdateProjectDoc(ctx, k, fk, msg)
+		case MsgRegisterSchema:
+			return handleMsgRegisterSchema(ctx, k, msg)
+		case MsgResolveClaimDispute:
+			return handleMsgResolveClaimDispute(ctx, k, msg)
 		default:
 			return sdk.ErrUnknownRequest("No match for message type.").Result()
 		}
 	}
 }
 
-func handleMsgCreateProject(ctx sdk.Context, k Keeper, msg MsgCreateProject) sdk.Result {
+func handleMsgCreateProject(ctx sdk.Context, k Keeper, fk payments.Keeper, msg MsgCreateProject) sdk.Result {
+
+	// Check that data conforms to the schema that it declares (if any)
+	if err := k.ValidateProjectDataSchema(ctx, msg.Data); err != nil {
+		return err.Result()
+	}
 
 	var err sdk.Error
 	if _, err = createAccountInProjectAccounts(ctx, k, msg.ProjectDid, IxoAccountFeesId); err != nil {
@@ -72,92 +83,256 @@ func handleMsgCreateProject(ctx sdk.Context, k Keeper, msg MsgCreateProject) sdk
 		msg.TxHash, msg.ProjectDid, msg.SenderDid,
 		msg.PubKey, types.NullStatus, msg.Data)
 
+	// Check that the targets' payment contracts (if any) belong to the project
+	targets, err := projectDoc.GetTargets()
+	if err != nil {
+		return err.Result()
+	} else if err := checkTargetPaymentContracts(ctx, k, fk,
+		msg.ProjectDid, msg.PubKey, targets); err != nil {
+		return err.Result()
+	}
+
 	k.SetProjectDoc(ctx, &projectDoc)
 	k.SetProjectWithdrawalTransactions(ctx, msg.ProjectDid, nil)
+	k.AddProjectDocVersion(ctx, msg.ProjectDid, msg.Data, getTxHash(ctx, msg.TxHash))
 
 	return sdk.Result{}
 }
 
-func handleMsgUpdateProjectStatus(ctx sdk.Context, k Keeper, bk bank.Keeper,
-	msg MsgUpdateProjectStatus) sdk.Result {
+func handleMsgUpdateProjectDoc(ctx sdk.Context, k Keeper, fk payments.Keeper, msg MsgUpdateProjectDoc) sdk.Result {
 
 	projectDoc, err := k.GetProjectDoc(ctx, msg.ProjectDid)
 	if err != nil {
 		return sdk.ErrUnknownRequest("Could not find Project").Result()
 	}
 
-	newStatus := msg.Data.Status
+	// Projects created before versioning was introduced have no versions, in
+	// which case the current data is recorded as the first version
+	if k.GetLatestProjectDocVersion(ctx, msg.ProjectDid) == 0 {
+		k.AddProjectDocVersion(ctx, msg.ProjectDid,
+			projectDoc.GetData(), projectDoc.GetTxHash())
+	}
 
-	if !newStatus.IsValidProgressionFrom(projectDoc.GetStatus()) {
-		return sdk.ErrUnknownRequest("Invalid Status Progression requested").Result()
+	newData, err := types.ApplyProjectDataUpdate(
+		projectDoc.GetData(), msg.Data.Mode, msg.Data.Data)
+	if err != nil {
+		return err.Result()
+	} else if err := types.ValidateProjectData(newData); err != nil {
+		return err.Result()
+	} else if err := k.ValidateProjectDataSchema(ctx, newData); err != nil {
+		return err.Result()
 	}
 
-	if newStatus == FundedStatus {
-		projectAddr, err := getProjectAccount(ctx, k, projectDoc.GetProjectDid())
+	// Fields that agents and funders rely on are locked once started
+	if projectDoc.GetStatus().HasStarted() {
+		err = types.CheckLockedFieldsUnchanged(projectDoc.GetData(), newData)
 		if err != nil {
 			return err.Result()
 		}
+	}
 
-		projectAcc := k.AccountKeeper.GetAccount(ctx, projectAddr)
-		if projectAcc == nil {
-			return sdk.ErrUnknownRequest("Could not find project account").Result()
-		}
+	projectDoc.SetData(newData)
 
-		minimumFunding := k.GetParams(ctx).ProjectMinimumInitialFunding
-		if projectAcc.GetCoins().AmountOf(ixo.IxoNativeToken).LT(minimumFunding) {
-			return sdk.ErrInsufficientFunds(
-				fmt.Sprintf("Project has not reached minimum funding %s", minimumFunding)).Result()
-		}
+	// Check that the targets' payment contracts (if any) belong to the project
+	targets, err := projectDoc.GetTargets()
+	if err != nil {
+		return err.Result()
+	} else if err := checkTargetPaymentContracts(ctx, k, fk,
+		msg.ProjectDid, projectDoc.GetPubKey(), targets); err != nil {
+		return err.Result()
+	}
+
+	k.SetProjectDoc(ctx, projectDoc)
+	version := k.AddProjectDocVersion(ctx, msg.ProjectDid,
+		newData, getTxHash(ctx, msg.TxHash))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateProjectDoc,
+			sdk.NewAttribute(types.AttributeKeyProjectDid, msg.ProjectDid),
+			sdk.NewAttribute(types.AttributeKeyVersion, fmt.Sprint(version.Version)),
+			sdk.NewAttribute(types.AttributeKeyMode, string(msg.Data.Mode)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRegisterSchema(ctx sdk.Context, k Keeper, msg MsgRegisterSchema) sdk.Result {
+
+	// Only the schema admin can register schemas
+	schemaAdminDid := k.GetParams(ctx).SchemaAdminDid
+	if schemaAdminDid == "" || msg.SenderDid != schemaAdminDid {
+		return sdk.ErrUnauthorized("sender is not the schema admin").Result()
+	}
+
+	if k.SchemaExists(ctx, msg.Data.SchemaId) {
+		return types.ErrSchemaAlreadyExists(types.DefaultCodespace, msg.Data.SchemaId).Result()
 	}
 
-	if newStatus == PaidoutStatus {
-		res := payoutFees(ctx, k, bk, projectDoc.GetProjectDid())
-		if res.Code != sdk.CodeOK {
-			return res
+	schema := types.NewProjectSchema(ctx, msg.Data.SchemaId, msg.Data.Schema, msg.SenderDid)
+	k.SetSchema(ctx, schema)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterSchema,
+			sdk.NewAttribute(types.AttributeKeySchemaId, msg.Data.SchemaId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// getTxHash returns the hash of the transaction being processed, falling
+// back to the hash specified in the message if the transaction is unknown
+// (e.g. when processing genesis transactions).
+func getTxHash(ctx sdk.Context, msgTxHash string) string {
+	if len(ctx.TxBytes()) == 0 {
+		return msgTxHash
+	}
+	return fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes()))
+}
+
+func handleMsgUpdateProjectStatus(ctx sdk.Context, k Keeper, bk bank.Keeper,
+	msg MsgUpdateProjectStatus) sdk.Result {
+
+	projectDoc, err := k.GetProjectDoc(ctx, msg.ProjectDid)
+	if err != nil {
+		return sdk.ErrUnknownRequest("Could not find Project").Result()
+	}
+
+	err = updateProjectStatus(ctx, k, bk, projectDoc, msg.Data.Status)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{}
+}
+
+func updateProjectStatus(ctx sdk.Context, k Keeper, bk bank.Keeper,
+	projectDoc StoredProjectDoc, newStatus ProjectStatus) sdk.Error {
+
+	if !newStatus.IsValidProgressionFrom(projectDoc.GetStatus()) {
+		return sdk.ErrUnknownRequest("Invalid Status Progression requested")
+	}
+
+	err := checkStatusGuards(ctx, k, projectDoc, newStatus)
+	if err != nil {
+		return err
+	}
+
+	// Fees are also paid out when a project is cancelled, so that the
+	// remaining funds can be refunded
+	if newStatus.IsPaidOut() {
+		err = payoutFees(ctx, k, bk, projectDoc.GetProjectDid())
+		if err != nil {
+			return err
 		}
 	}
 
 	projectDoc.SetStatus(newStatus)
 	k.SetProjectDoc(ctx, projectDoc)
 
-	return sdk.Result{}
+	return nil
 }
 
-func payoutFees(ctx sdk.Context, k Keeper, bk bank.Keeper, projectDid did.Did) sdk.Result {
+// checkStatusGuards returns an error if the project does not meet any of the
+// guards configured in the params for the status that it is moving to.
+func checkStatusGuards(ctx sdk.Context, k Keeper, projectDoc StoredProjectDoc,
+	newStatus ProjectStatus) sdk.Error {
+
+	projectDid := projectDoc.GetProjectDid()
+	for _, guard := range k.GetParams(ctx).StatusGuards.GetGuards(newStatus) {
+		switch guard {
+		case types.MinimumFundingGuard:
+			projectAddr, err := getProjectAccount(ctx, k, projectDid)
+			if err != nil {
+				return err
+			}
+
+			projectAcc := k.AccountKeeper.GetAccount(ctx, projectAddr)
+			if projectAcc == nil {
+				return sdk.ErrUnknownRequest("Could not find project account")
+			}
+
+			// Minimum funding is checked in the project's primary funding denom
+			funding, err := projectDoc.GetFunding()
+			if err != nil {
+				return err
+			}
+
+			minimumFunding := k.GetParams(ctx).ProjectMinimumInitialFunding
+			if projectAcc.GetCoins().AmountOf(funding.PrimaryDenom()).LT(minimumFunding) {
+				return types.ErrStatusGuardFailed(types.DefaultCodespace, newStatus, guard,
+					fmt.Sprintf("project has not reached minimum funding %s%s",
+						minimumFunding, funding.PrimaryDenom()))
+			}
+		case types.ApprovedEvaluatorGuard:
+			evaluators := k.GetAgents(ctx, projectDid, types.EvaluationAgent, types.ApprovedAgent)
+			if len(evaluators) == 0 {
+				return types.ErrStatusGuardFailed(types.DefaultCodespace, newStatus, guard,
+					"project has no approved evaluation agents")
+			}
+		case types.NoPendingClaimsGuard:
+			pending := k.GetClaims(ctx, projectDid, types.PendingClaim, "")
+			if len(pending) != 0 {
+				return types.ErrStatusGuardFailed(types.DefaultCodespace, newStatus, guard,
+					fmt.Sprintf("project has %d pending claims", len(pending)))
+			}
+		default:
+			return sdk.ErrInternal(fmt.Sprintf("unknown status guard '%s'", guard))
+		}
+	}
+
+	return nil
+}
+
+func payoutFees(ctx sdk.Context, k Keeper, bk bank.Keeper, projectDid did.Did) sdk.Error {
 
 	_, err := payAllFeesToAddress(ctx, k, bk, projectDid, IxoAccountPayFeesId, IxoAccountFeesId)
 	if err != nil {
-		return sdk.ErrInternal("Failed to send coins").Result()
+		return sdk.ErrInternal("Failed to send coins")
 	}
 
 	_, err = payAllFeesToAddress(ctx, k, bk, projectDid, InitiatingNodeAccountPayFeesId, IxoAccountFeesId)
 	if err != nil {
-		return sdk.ErrInternal("Failed to send coins").Result()
+		return sdk.ErrInternal("Failed to send coins")
 	}
 
 	_, err = payAllFeesToAddress(ctx, k, bk, projectDid, ValidatingNodeSetAccountFeesId, IxoAccountFeesId)
 	if err != nil {
-		return sdk.ErrInternal("Failed to send coins").Result()
+		return sdk.ErrInternal("Failed to send coins")
 	}
 
+	// Fees might have been collected in more than one denom, so each denom
+	// is recorded as a separate withdrawal
 	ixoDid := k.GetParams(ctx).IxoDid
-	amount := getIxoAmount(ctx, k, bk, projectDid, IxoAccountFeesId)
-	err = payoutAndRecon(ctx, k, bk, projectDid, IxoAccountFeesId, ixoDid, amount)
-	if err != nil {
-		return err.Result()
+	for _, amount := range getAccountCoins(ctx, k, bk, projectDid, IxoAccountFeesId) {
+		err = payoutAndRecon(ctx, k, bk, projectDid, IxoAccountFeesId, ixoDid, amount)
+		if err != nil {
+			return err
+		}
 	}
 
-	return sdk.Result{}
+	return nil
 }
 
 func payAllFeesToAddress(ctx sdk.Context, k Keeper, bk bank.Keeper, projectDid did.Did,
 	sendingAddress InternalAccountID, receivingAddress InternalAccountID) (sdk.Events, sdk.Error) {
-	feesToPay := getIxoAmount(ctx, k, bk, projectDid, sendingAddress)
+	feesToPay := getAccountCoins(ctx, k, bk, projectDid, sendingAddress)
 
-	if feesToPay.Amount.LT(sdk.ZeroInt()) {
+	if feesToPay.IsAnyNegative() {
 		return nil, sdk.ErrInternal("Negative fee to pay")
 	}
-	if feesToPay.Amount.IsZero() {
+	if feesToPay.IsZero() {
 		return nil, nil
 	}
 
@@ -168,17 +343,22 @@ func payAllFeesToAddress(ctx sdk.Context, k Keeper, bk bank.Keeper, projectDid d
 
 	sendingAccount, _ := getAccountInProjectAccounts(ctx, k, projectDid, sendingAddress)
 
-	return sdk.Events{}, bk.SendCoins(ctx, sendingAccount, receivingAccount, sdk.Coins{feesToPay})
+	return sdk.Events{}, bk.SendCoins(ctx, sendingAccount, receivingAccount, feesToPay)
 }
 
-func getIxoAmount(ctx sdk.Context, k Keeper, bk bank.Keeper, projectDid did.Did, accountID InternalAccountID) sdk.Coin {
+func getAccountCoins(ctx sdk.Context, k Keeper, bk bank.Keeper, projectDid did.Did, accountID InternalAccountID) sdk.Coins {
 	found := checkAccountInProjectAccounts(ctx, k, projectDid, accountID)
 	if found {
 		accAddr, _ := getAccountInProjectAccounts(ctx, k, projectDid, accountID)
-		coins := bk.GetCoins(ctx, accAddr)
-		return sdk.NewCoin(ixo.IxoNativeToken, coins.AmountOf(ixo.IxoNativeToken))
+		return bk.GetCoins(ctx, accAddr)
 	}
-	return sdk.NewCoin(ixo.IxoNativeToken, sdk.ZeroInt())
+	return sdk.NewCoins()
+}
+
+func getAccountAmount(ctx sdk.Context, k Keeper, bk bank.Keeper, projectDid did.Did,
+	accountID InternalAccountID, denom string) sdk.Coin {
+	coins := getAccountCoins(ctx, k, bk, projectDid, accountID)
+	return sdk.NewCoin(denom, coins.AmountOf(denom))
 }
 
 func handleMsgCreateAgent(ctx sdk.Context, k Keeper, bk bank.Keeper, msg MsgCreateAgent) sdk.Result {
@@ -189,12 +369,28 @@ func handleMsgCreateAgent(ctx sdk.Context, k Keeper, bk bank.Keeper, msg MsgCrea
 		return sdk.ErrUnknownRequest("Could not find Project").Result()
 	}
 
+	// Check if agent already exists. Revoked agents can be created again, in
+	// which case they are pending once more, possibly with a different role.
+	if k.AgentExists(ctx, msg.ProjectDid, msg.Data.AgentDid) {
+		agent, err := k.GetAgent(ctx, msg.ProjectDid, msg.Data.AgentDid)
+		if err != nil {
+			return err.Result()
+		} else if agent.Status != types.RevokedAgent {
+			return types.ErrAgentAlreadyExists(types.DefaultCodespace,
+				msg.ProjectDid, msg.Data.AgentDid).Result()
+		}
+	}
+
 	// Create account in project accounts for the agent
 	_, err = createAccountInProjectAccounts(ctx, k, msg.ProjectDid, InternalAccountID(msg.Data.AgentDid))
 	if err != nil {
 		err.Result()
 	}
 
+	// Store agent (pending until approved by the project)
+	k.SetAgent(ctx, types.NewProjectAgent(
+		ctx, msg.ProjectDid, msg.Data.AgentDid, msg.Data.Role))
+
 	return sdk.Result{}
 }
 
@@ -206,7 +402,18 @@ func handleMsgUpdateAgent(ctx sdk.Context, k Keeper, bk bank.Keeper, msg MsgUpda
 		return sdk.ErrUnknownRequest("Could not find Project").Result()
 	}
 
-	// TODO: implement agent update (or remove functionality)
+	agent, err := k.GetAgent(ctx, msg.ProjectDid, msg.Data.Did)
+	if err != nil {
+		return err.Result()
+	}
+
+	if !types.IsValidAgentStatusProgression(agent.Status, msg.Data.Status) {
+		return types.ErrInvalidAgentStatusProgression(
+			types.DefaultCodespace, agent.Status, msg.Data.Status).Result()
+	}
+
+	agent.Update(ctx, msg.Data.Status, msg.Data.Role)
+	k.SetAgent(ctx, agent)
 
 	return sdk.Result{}
 }
@@ -215,18 +422,46 @@ func handleMsgCreateClaim(ctx sdk.Context, k Keeper, fk payments.Keeper,
 	bk bank.Keeper, msg MsgCreateClaim) sdk.Result {
 
 	// Check if project exists
-	_, err := k.GetProjectDoc(ctx, msg.ProjectDid)
+	projectDoc, err := k.GetProjectDoc(ctx, msg.ProjectDid)
 	if err != nil {
 		return sdk.ErrUnknownRequest("Could not find Project").Result()
 	}
 
+	// Check that project accepts claims, that claim is new, and that claimer
+	// is an approved service agent
+	if !projectDoc.GetStatus().AcceptsClaims() {
+		return types.ErrInvalidProjectStatus(types.DefaultCodespace,
+			projectDoc.GetStatus()).Result()
+	} else if k.ClaimExists(ctx, msg.ProjectDid, msg.Data.ClaimID) {
+		return types.ErrClaimAlreadyExists(types.DefaultCodespace,
+			msg.ProjectDid, msg.Data.ClaimID).Result()
+	} else if !k.IsApprovedAgent(ctx, msg.ProjectDid, msg.SenderDid, types.ServiceAgent) {
+		return types.ErrAgentNotApproved(types.DefaultCodespace,
+			msg.ProjectDid, msg.SenderDid, types.ServiceAgent).Result()
+	}
+
+	// Check the claimer's signature (if any)
+	claimerAuthenticated, err := verifySenderSignature(
+		ctx, k, msg.SenderDid, msg.GetSenderProofBytes(), msg.SenderSignature)
+	if err != nil {
+		return err.Result()
+	}
+
 	// Process claim fees
 	err = processFees(
-		ctx, k, fk, bk, payments.FeeClaimTransaction, msg.ProjectDid)
+		ctx, k, fk, bk, payments.FeeClaimTransaction, projectDoc)
 	if err != nil {
 		return err.Result()
 	}
 
+	// Store claim (pending until evaluated) and count it towards the
+	// claimer's statistics
+	claim := types.NewClaim(ctx, msg.ProjectDid,
+		msg.Data.ClaimID, msg.Data.ClaimTemplateID, msg.SenderDid)
+	claim.ClaimerAuthenticated = claimerAuthenticated
+	k.SetClaim(ctx, claim)
+	k.RecordClaimSubmitted(ctx, claim)
+
 	return sdk.Result{}
 }
 
@@ -238,23 +473,173 @@ func handleMsgCreateEvaluation(ctx sdk.Context, k Keeper, fk payments.Keeper, bk
 		return sdk.ErrUnknownRequest("Could not find Project").Result()
 	}
 
+	// Check that claim has not been evaluated yet and that the evaluator is
+	// an approved evaluation agent, so that the evaluator is only paid once
+	claim, err := k.GetClaim(ctx, msg.ProjectDid, msg.Data.ClaimID)
+	if err != nil {
+		return err.Result()
+	} else if !projectDoc.GetStatus().AcceptsClaims() {
+		return types.ErrInvalidProjectStatus(types.DefaultCodespace,
+			projectDoc.GetStatus()).Result()
+	} else if claim.IsEvaluated() {
+		return types.ErrClaimAlreadyEvaluated(types.DefaultCodespace,
+			msg.ProjectDid, msg.Data.ClaimID).Result()
+	} else if !k.IsApprovedAgent(ctx, msg.ProjectDid, msg.SenderDid, types.EvaluationAgent) {
+		return types.ErrAgentNotApproved(types.DefaultCodespace,
+			msg.ProjectDid, msg.SenderDid, types.EvaluationAgent).Result()
+	}
+
+	// Check the evaluator's signature (if any)
+	evaluatorAuthenticated, err := verifySenderSignature(
+		ctx, k, msg.SenderDid, msg.GetSenderProofBytes(), msg.SenderSignature)
+	if err != nil {
+		return err.Result()
+	}
+
 	// Process evaluation fees
 	err = processFees(
-		ctx, k, fk, bk, payments.FeeEvaluationTransaction, msg.ProjectDid)
+		ctx, k, fk, bk, payments.FeeEvaluationTransaction, projectDoc)
 	if err != nil {
 		return err.Result()
 	}
 
 	// Process evaluator pay
+	evaluatorPay, err := projectDoc.GetEvaluatorPay()
+	if err != nil {
+		return err.Result()
+	}
 	err = processEvaluatorPay(ctx, k, fk, bk, msg.ProjectDid,
-		msg.SenderDid, projectDoc.GetEvaluatorPay())
+		msg.SenderDid, evaluatorPay)
+	if err != nil {
+		return err.Result()
+	}
+
+	// Record evaluation and count it towards the agents' statistics
+	claim.Evaluate(ctx, msg.SenderDid, msg.Data.Status)
+	claim.EvaluatorAuthenticated = evaluatorAuthenticated
+	k.SetClaim(ctx, claim)
+	k.RecordClaimEvaluated(ctx, claim)
+
+	// Count evaluation towards the project's targets
+	targets, err := projectDoc.GetTargets()
+	if err != nil {
+		return err.Result()
+	}
+	reached := k.AddEvaluationToTargets(ctx, msg.ProjectDid, targets, claim)
+	for _, target := range reached {
+		processTargetReached(ctx, k, fk, bk, projectDoc, target)
+	}
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// verifySenderSignature checks the signature (if any) by the sender of a
+// claim or evaluation, which is not the signer of the msg, and indicates
+// whether the sender is authenticated. A msg without a sender signature is
+// valid, but the claim or evaluation is then not attributed to the sender.
+func verifySenderSignature(ctx sdk.Context, k Keeper, senderDid did.Did,
+	proofBytes, signature []byte) (bool, sdk.Error) {
+	if len(signature) == 0 {
+		return false, nil
+	}
+
+	senderDidDoc, err := k.DidKeeper.GetDidDoc(ctx, senderDid)
+	if err != nil {
+		return false, err
+	} else if senderDidDoc.IsDeactivated() {
+		return false, did.ErrorDidDeactivated(did.DefaultCodespace, senderDid)
+	} else if !senderDidDoc.GetSignerPubKey().VerifyBytes(proofBytes, signature) {
+		return false, sdk.ErrUnauthorized("sender signature verification failed")
+	}
+
+	return true, nil
+}
+
+func handleMsgResolveClaimDispute(ctx sdk.Context, k Keeper, msg MsgResolveClaimDispute) sdk.Result {
+
+	// Check if project exists
+	_, err := k.GetProjectDoc(ctx, msg.ProjectDid)
+	if err != nil {
+		return sdk.ErrUnknownRequest("Could not find Project").Result()
+	}
+
+	// Only rejected claims can be disputed (by the claimer), and only once
+	claim, err := k.GetClaim(ctx, msg.ProjectDid, msg.Data.ClaimID)
 	if err != nil {
 		return err.Result()
+	} else if claim.Status != types.RejectedClaim {
+		return types.ErrClaimNotDisputable(types.DefaultCodespace,
+			msg.ProjectDid, msg.Data.ClaimID, "claim is not rejected").Result()
+	} else if claim.IsDisputeResolved() {
+		return types.ErrClaimNotDisputable(types.DefaultCodespace,
+			msg.ProjectDid, msg.Data.ClaimID, "dispute already resolved").Result()
+	}
+
+	// Record the outcome and count it towards the losing agent's statistics,
+	// if the claim or evaluation that was disputed is attributed to it
+	loserDid, authenticated := claim.ResolveDispute(msg.Data.EvaluationUpheld)
+	k.SetClaim(ctx, claim)
+	if authenticated {
+		k.RecordDisputeLost(ctx, loserDid)
 	}
 
 	return sdk.Result{}
 }
 
+// processTargetReached performs the target's action (if any) and emits a
+// target_reached event. Failing to perform the action does not cause the
+// evaluation that reached the target to fail, given that the target can only
+// be reached once. Instead, the error is recorded in the target's progress.
+func processTargetReached(ctx sdk.Context, k Keeper, fk payments.Keeper,
+	bk bank.Keeper, projectDoc StoredProjectDoc, target types.Target) {
+
+	// Actions are performed in a cached context so that any changes made by
+	// an action that fails midway are discarded
+	cacheCtx, write := ctx.CacheContext()
+
+	var err sdk.Error
+	switch target.Action {
+	case types.UpdateStatusTargetAction:
+		err = updateProjectStatus(cacheCtx, k, bk, projectDoc, target.ActionStatus)
+	case types.EffectPaymentTargetAction:
+		err = checkTargetPaymentContract(cacheCtx, k, fk, projectDoc.GetProjectDid(),
+			projectDoc.GetPubKey(), target.ActionPaymentContractId)
+		if err != nil {
+			break
+		}
+
+		var effected bool
+		effected, err = fk.EffectPayment(cacheCtx, bk, target.ActionPaymentContractId)
+		if err == nil && !effected {
+			err = sdk.ErrInternal("payment could not be effected")
+		}
+	}
+
+	progress := k.GetTargetProgress(ctx, projectDoc.GetProjectDid(), target.Id)
+	if err == nil {
+		write()
+	} else {
+		progress.ActionError = err.Error()
+		k.SetTargetProgress(ctx, progress)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTargetReached,
+			sdk.NewAttribute(types.AttributeKeyProjectDid, progress.ProjectDid),
+			sdk.NewAttribute(types.AttributeKeyTargetId, progress.TargetId),
+			sdk.NewAttribute(types.AttributeKeyApproved, fmt.Sprint(progress.Approved)),
+			sdk.NewAttribute(types.AttributeKeyRejected, fmt.Sprint(progress.Rejected)),
+			sdk.NewAttribute(types.AttributeKeyAction, string(target.Action)),
+			sdk.NewAttribute(types.AttributeKeyActionError, progress.ActionError),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
 func handleMsgWithdrawFunds(ctx sdk.Context, k Keeper, bk bank.Keeper,
 	msg MsgWithdrawFunds) sdk.Result {
 
@@ -264,13 +649,21 @@ func handleMsgWithdrawFunds(ctx sdk.Context, k Keeper, bk bank.Keeper,
 		return sdk.ErrUnknownRequest("Could not find Project").Result()
 	}
 
-	if projectDoc.GetStatus() != PaidoutStatus {
-		return sdk.ErrUnknownRequest("Project not in PAIDOUT Status").Result()
+	if !projectDoc.GetStatus().IsPaidOut() {
+		return sdk.ErrUnknownRequest("Project not in PAIDOUT or CANCELLED Status").Result()
 	}
 
 	projectDid := withdrawFundsDoc.ProjectDid
 	recipientDid := withdrawFundsDoc.RecipientDid
-	amount := withdrawFundsDoc.Amount
+	amount := withdrawFundsDoc.GetAmount()
+
+	// Check that funds are being withdrawn in one of the project's denoms
+	funding, err := projectDoc.GetFunding()
+	if err != nil {
+		return err.Result()
+	} else if !funding.IsPayoutDenom(amount.Denom) {
+		return types.ErrInvalidFundingDenom(types.DefaultCodespace, amount.Denom).Result()
+	}
 
 	// If this is a refund, recipient has to be the project creator
 	if withdrawFundsDoc.IsRefund && (recipientDid != projectDoc.GetSenderDid()) {
@@ -284,8 +677,7 @@ func handleMsgWithdrawFunds(ctx sdk.Context, k Keeper, bk bank.Keeper,
 		fromAccountId = InternalAccountID(recipientDid)
 	}
 
-	amountCoin := sdk.NewCoin(ixo.IxoNativeToken, amount)
-	err = payoutAndRecon(ctx, k, bk, projectDid, fromAccountId, recipientDid, amountCoin)
+	err = payoutAndRecon(ctx, k, bk, projectDid, fromAccountId, recipientDid, amount)
 	if err != nil {
 		return err.Result()
 	}
@@ -296,8 +688,8 @@ func handleMsgWithdrawFunds(ctx sdk.Context, k Keeper, bk bank.Keeper,
 func payoutAndRecon(ctx sdk.Context, k Keeper, bk bank.Keeper, projectDid did.Did,
 	fromAccountId InternalAccountID, recipientDid did.Did, amount sdk.Coin) sdk.Error {
 
-	ixoBalance := getIxoAmount(ctx, k, bk, projectDid, fromAccountId)
-	if ixoBalance.IsLT(amount) {
+	balance := getAccountAmount(ctx, k, bk, projectDid, fromAccountId, amount.Denom)
+	if balance.IsLT(amount) {
 		return sdk.ErrInternal("insufficient funds in specified account")
 	}
 
@@ -318,19 +710,26 @@ func payoutAndRecon(ctx sdk.Context, k Keeper, bk bank.Keeper, projectDid did.Di
 		return err
 	}
 
-	var actionId [32]byte
-	dec := sdk.OneDec() // TODO: should increment with each withdrawal (ref: #113)
-	copy(actionId[:], dec.Bytes())
-
-	addProjectWithdrawalTransaction(ctx, k, projectDid, actionId, recipientDid, amount)
+	sequence := k.NextWithdrawalSequence(ctx, projectDid)
+	withdrawalInfo := NewWithdrawalInfo(ctx, projectDid, recipientDid, amount, sequence)
+	k.AddProjectWithdrawalTransaction(ctx, projectDid, withdrawalInfo)
 	return nil
 }
 
 func processFees(ctx sdk.Context, k Keeper, fk payments.Keeper, bk bank.Keeper,
-	feeType payments.FeeType, projectDid did.Did) sdk.Error {
+	feeType payments.FeeType, projectDoc StoredProjectDoc) sdk.Error {
 
+	projectDid := projectDoc.GetProjectDid()
 	projectAddr, _ := getProjectAccount(ctx, k, projectDid)
 
+	// Fees are taken in IXO or in the project's funding denom, depending on
+	// the project's funding setting
+	funding, err := projectDoc.GetFunding()
+	if err != nil {
+		return err
+	}
+	feeDenom := funding.FeeDenom()
+
 	validatingNodeSetAddr, err := getAccountInProjectAccounts(ctx, k, projectDid, ValidatingNodeSetAccountFeesId)
 	if err != nil {
 		return err
@@ -354,15 +753,15 @@ func processFees(ctx sdk.Context, k Keeper, fk payments.Keeper, bk bank.Keeper,
 		return sdk.ErrUnknownRequest("Invalid Fee type.")
 	}
 
-	nodeAmount := adjustedFeeAmount.Mul(nodePercentage).RoundInt64()
-	ixoAmount := adjustedFeeAmount.RoundInt64() - nodeAmount
+	nodeAmount := adjustedFeeAmount.Mul(nodePercentage).RoundInt()
+	ixoAmount := adjustedFeeAmount.RoundInt().Sub(nodeAmount)
 
-	err = bk.SendCoins(ctx, projectAddr, validatingNodeSetAddr, sdk.Coins{sdk.NewInt64Coin(ixo.IxoNativeToken, nodeAmount)})
+	err = bk.SendCoins(ctx, projectAddr, validatingNodeSetAddr, sdk.Coins{sdk.NewCoin(feeDenom, nodeAmount)})
 	if err != nil {
 		return err
 	}
 
-	err = bk.SendCoins(ctx, projectAddr, ixoAddr, sdk.Coins{sdk.NewInt64Coin(ixo.IxoNativeToken, ixoAmount)})
+	err = bk.SendCoins(ctx, projectAddr, ixoAddr, sdk.Coins{sdk.NewCoin(feeDenom, ixoAmount)})
 	if err != nil {
 		return err
 	}
@@ -371,9 +770,9 @@ func processFees(ctx sdk.Context, k Keeper, fk payments.Keeper, bk bank.Keeper,
 }
 
 func processEvaluatorPay(ctx sdk.Context, k Keeper, fk payments.Keeper,
-	bk bank.Keeper, projectDid, senderDid did.Did, evaluatorPay int64) sdk.Error {
+	bk bank.Keeper, projectDid, senderDid did.Did, evaluatorPay sdk.Coin) sdk.Error {
 
-	if evaluatorPay == 0 {
+	if evaluatorPay.IsZero() {
 		return nil
 	}
 
@@ -393,23 +792,24 @@ func processEvaluatorPay(ctx sdk.Context, k Keeper, fk payments.Keeper,
 	feePercentage := fk.GetParams(ctx).EvaluationPayFeePercentage
 	nodeFeePercentage := fk.GetParams(ctx).EvaluationPayNodeFeePercentage
 
-	totalEvaluatorPayAmount := sdk.NewDec(evaluatorPay).Mul(ixo.IxoDecimals) // This is in IXO * 10^8
+	payDenom := evaluatorPay.Denom
+	totalEvaluatorPayAmount := evaluatorPay.Amount.ToDec()
 	evaluatorPayFeeAmount := totalEvaluatorPayAmount.Mul(feePercentage)
 	evaluatorPayLessFees := totalEvaluatorPayAmount.Sub(evaluatorPayFeeAmount)
 	nodePayFees := evaluatorPayFeeAmount.Mul(nodeFeePercentage)
 	ixoPayFees := evaluatorPayFeeAmount.Sub(nodePayFees)
 
-	err = bk.SendCoins(ctx, projectAddr, evaluatorAccAddr, sdk.Coins{sdk.NewInt64Coin(ixo.IxoNativeToken, evaluatorPayLessFees.RoundInt64())})
+	err = bk.SendCoins(ctx, projectAddr, evaluatorAccAddr, sdk.Coins{sdk.NewCoin(payDenom, evaluatorPayLessFees.RoundInt())})
 	if err != nil {
 		return err
 	}
 
-	err = bk.SendCoins(ctx, projectAddr, nodeAddr, sdk.Coins{sdk.NewInt64Coin(ixo.IxoNativeToken, nodePayFees.RoundInt64())})
+	err = bk.SendCoins(ctx, projectAddr, nodeAddr, sdk.Coins{sdk.NewCoin(payDenom, nodePayFees.RoundInt())})
 	if err != nil {
 		return err
 	}
 
-	err = bk.SendCoins(ctx, projectAddr, ixoAddr, sdk.Coins{sdk.NewInt64Coin(ixo.IxoNativeToken, ixoPayFees.RoundInt64())})
+	err = bk.SendCoins(ctx, projectAddr, ixoAddr, sdk.Coins{sdk.NewCoin(payDenom, ixoPayFees.RoundInt())})
 	if err != nil {
 		return err
 	}
@@ -425,20 +825,6 @@ func checkAccountInProjectAccounts(ctx sdk.Context, k Keeper, projectDid did.Did
 	return found
 }
 
-func addProjectWithdrawalTransaction(ctx sdk.Context, k Keeper, projectDid did.Did,
-	actionID [32]byte, recipientDid did.Did, amount sdk.Coin) {
-	actionIDStr := "0x" + hex.EncodeToString(actionID[:])
-
-	withdrawalInfo := WithdrawalInfo{
-		ActionID:     actionIDStr,
-		ProjectDid:   projectDid,
-		RecipientDid: recipientDid,
-		Amount:       amount,
-	}
-
-	k.AddProjectWithdrawalTransaction(ctx, projectDid, withdrawalInfo)
-}
-
 func createAccountInProjectAccounts(ctx sdk.Context, k Keeper, projectDid did.Did, accountId InternalAccountID) (sdk.AccAddress, sdk.Error) {
 	acc, err := k.CreateNewAccount(ctx, projectDid, accountId)
 	if err != nil {
@@ -462,6 +848,66 @@ func getAccountInProjectAccounts(ctx sdk.Context, k Keeper, projectDid did.Did,
 	}
 }
 
+// checkTargetPaymentContracts checks that the payment contracts effected by
+// the targets' actions belong to the project. Contracts that do not exist yet
+// are checked when the action is performed instead.
+func checkTargetPaymentContracts(ctx sdk.Context, k Keeper, fk payments.Keeper,
+	projectDid did.Did, projectPubKey string, targets types.Targets) sdk.Error {
+	for _, target := range targets {
+		if target.Action != types.EffectPaymentTargetAction ||
+			!fk.PaymentContractExists(ctx, target.ActionPaymentContractId) {
+			continue
+		}
+
+		err := checkTargetPaymentContract(ctx, k, fk, projectDid,
+			projectPubKey, target.ActionPaymentContractId)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkTargetPaymentContract checks that the project is the creator or the
+// payer of the payment contract, which are the only parties (other than the
+// payments module itself) that would be able to have the payment effected.
+func checkTargetPaymentContract(ctx sdk.Context, k Keeper, fk payments.Keeper,
+	projectDid did.Did, projectPubKey string, contractId string) sdk.Error {
+	contract, err := fk.GetPaymentContract(ctx, contractId)
+	if err != nil {
+		return err
+	}
+
+	if !isProjectAddress(ctx, k, projectDid, projectPubKey, contract.Creator) &&
+		!isProjectAddress(ctx, k, projectDid, projectPubKey, contract.Payer) {
+		return types.ErrInvalidTarget(types.DefaultCodespace, fmt.Sprintf(
+			"payment contract %s is not created or paid by the project", contractId))
+	}
+	return nil
+}
+
+// isProjectAddress indicates whether the address is the project's own account
+// or the address of the project's signer key (including the current key of a
+// project DID registered with the project's pubKey).
+func isProjectAddress(ctx sdk.Context, k Keeper, projectDid did.Did,
+	projectPubKey string, addr sdk.AccAddress) bool {
+	if addr.Empty() {
+		return false
+	} else if addr.Equals(exported.VerifyKeyToAddr(projectPubKey)) {
+		return true
+	} else if projectAddr, found := k.GetAccountMap(ctx, projectDid)[InternalAccountID(projectDid)]; found &&
+		addr.Equals(projectAddr) {
+		return true
+	}
+
+	projectDidDoc, _ := k.DidKeeper.GetDidDoc(ctx, projectDid)
+	if projectDidDoc != nil {
+		initialPubKey, _ := k.DidKeeper.GetInitialPubKey(ctx, projectDid)
+		return initialPubKey == projectPubKey && addr.Equals(projectDidDoc.Address())
+	}
+	return false
+}
+
 func getProjectAccount(ctx sdk.Context, k Keeper, projectDid did.Did) (sdk.AccAddress, sdk.Error) {
 	return getAccountInProjectAccounts(ctx, k, projectDid, InternalAccountID(projectDid))
 }
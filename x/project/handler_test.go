@@ -8,9 +8,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	didexported "github.com/ixofoundation/ixo-blockchain/x/did/exported"
 	"github.com/ixofoundation/ixo-blockchain/x/ixo"
+	"github.com/ixofoundation/ixo-blockchain/x/payments"
 	"github.com/ixofoundation/ixo-blockchain/x/project/internal/keeper"
 	"github.com/ixofoundation/ixo-blockchain/x/project/internal/types"
 )
@@ -39,16 +45,16 @@ func TestHandler_CreateClaim(t *testing.T) {
 }
 
 func TestHandler_ProjectMsg(t *testing.T) {
-	ctx, k, cdc, _, _ := keeper.CreateTestInput()
+	ctx, k, cdc, fk, _ := keeper.CreateTestInput()
 	codec.RegisterCrypto(cdc)
 	types.RegisterCodec(cdc)
 	cdc.RegisterInterface((*exported.Account)(nil), nil)
 	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
 
-	res := handleMsgCreateProject(ctx, k, types.ValidCreateProjectMsg)
+	res := handleMsgCreateProject(ctx, k, fk, types.ValidCreateProjectMsg)
 	require.True(t, res.IsOK())
 
-	res = handleMsgCreateProject(ctx, k, types.ValidCreateProjectMsg)
+	res = handleMsgCreateProject(ctx, k, fk, types.ValidCreateProjectMsg)
 	require.False(t, res.IsOK())
 
 }
@@ -193,3 +199,792 @@ func Test_WithdrawFunds(t *testing.T) {
 	res := handleMsgWithdrawFunds(ctx, k, bk, msg)
 	require.NotNil(t, res)
 }
+
+func TestHandler_CreateAndUpdateAgent(t *testing.T) {
+	ctx, k, cdc, fk, bk := keeper.CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+
+	res := handleMsgCreateProject(ctx, k, fk, types.ValidCreateProjectMsg)
+	require.True(t, res.IsOK())
+
+	projectDid := types.ValidCreateProjectMsg.ProjectDid
+	agentDid := "did:ixo:agent"
+
+	// Agent is created as pending and cannot be created twice
+	createMsg := types.MsgCreateAgent{
+		TxHash:     "txHash",
+		SenderDid:  agentDid,
+		ProjectDid: projectDid,
+		Data:       types.CreateAgentDoc{AgentDid: agentDid, Role: types.EvaluationAgent},
+	}
+	res = handleMsgCreateAgent(ctx, k, bk, createMsg)
+	require.True(t, res.IsOK())
+	res = handleMsgCreateAgent(ctx, k, bk, createMsg)
+	require.Equal(t, types.CodeAgentAlreadyExists, res.Code)
+
+	agent, err := k.GetAgent(ctx, projectDid, agentDid)
+	require.Nil(t, err)
+	require.Equal(t, types.PendingAgent, agent.Status)
+	require.Equal(t, types.EvaluationAgent, agent.Role)
+
+	// The project approves the agent (the update is signed by the project,
+	// regardless of the sender)
+	updateMsg := types.MsgUpdateAgent{
+		TxHash:     "txHash",
+		SenderDid:  agentDid,
+		ProjectDid: projectDid,
+		Data:       types.UpdateAgentDoc{Did: agentDid, Status: types.ApprovedAgent},
+	}
+	res = handleMsgUpdateAgent(ctx, k, bk, updateMsg)
+	require.True(t, res.IsOK())
+	agent, err = k.GetAgent(ctx, projectDid, agentDid)
+	require.Nil(t, err)
+	require.Equal(t, types.ApprovedAgent, agent.Status)
+
+	// Approved agent cannot be approved again, but can be revoked
+	res = handleMsgUpdateAgent(ctx, k, bk, updateMsg)
+	require.Equal(t, types.CodeInvalidAgentStatusProgress, res.Code)
+
+	updateMsg.Data.Status = types.RevokedAgent
+	res = handleMsgUpdateAgent(ctx, k, bk, updateMsg)
+	require.True(t, res.IsOK())
+	require.Len(t, k.GetAgents(ctx, projectDid, "", types.RevokedAgent), 1)
+	require.Len(t, k.GetAgents(ctx, projectDid, "", types.ApprovedAgent), 0)
+
+	// Revoked agent can be created again, as pending and with a new role
+	createMsg.Data.Role = types.ServiceAgent
+	res = handleMsgCreateAgent(ctx, k, bk, createMsg)
+	require.True(t, res.IsOK())
+	agent, err = k.GetAgent(ctx, projectDid, agentDid)
+	require.Nil(t, err)
+	require.Equal(t, types.PendingAgent, agent.Status)
+	require.Equal(t, types.ServiceAgent, agent.Role)
+	res = handleMsgCreateAgent(ctx, k, bk, createMsg)
+	require.Equal(t, types.CodeAgentAlreadyExists, res.Code)
+}
+
+const (
+	testServiceAgentDid    = "did:ixo:serviceAgent"
+	testEvaluationAgentDid = "did:ixo:evaluationAgent"
+)
+
+// createTestProjectWithAgents creates a funded project with the specified
+// project data, an approved service agent and an approved evaluation agent.
+func createTestProjectWithAgents(t *testing.T, ctx sdk.Context, k keeper.Keeper,
+	fk payments.Keeper, bk bank.Keeper, data map[string]interface{}) did.Did {
+
+	createProjectMsg := types.ValidCreateProjectMsg
+	createProjectMsg.Data = types.MustMarshalJson(data)
+	res := handleMsgCreateProject(ctx, k, fk, createProjectMsg)
+	require.True(t, res.IsOK())
+
+	projectDid := createProjectMsg.ProjectDid
+	projectAddr, err := getProjectAccount(ctx, k, projectDid)
+	require.Nil(t, err)
+	_, err = bk.AddCoins(ctx, projectAddr, sdk.NewCoins(
+		sdk.NewInt64Coin(ixo.IxoNativeToken, 10000000000)))
+	require.Nil(t, err)
+
+	for agentDid, role := range map[string]string{
+		testServiceAgentDid:    types.ServiceAgent,
+		testEvaluationAgentDid: types.EvaluationAgent} {
+		res = handleMsgCreateAgent(ctx, k, bk, types.MsgCreateAgent{
+			SenderDid:  agentDid,
+			ProjectDid: projectDid,
+			Data:       types.CreateAgentDoc{AgentDid: agentDid, Role: role},
+		})
+		require.True(t, res.IsOK())
+		res = handleMsgUpdateAgent(ctx, k, bk, types.MsgUpdateAgent{
+			SenderDid:  projectDid,
+			ProjectDid: projectDid,
+			Data:       types.UpdateAgentDoc{Did: agentDid, Status: types.ApprovedAgent},
+		})
+		require.True(t, res.IsOK())
+	}
+
+	return projectDid
+}
+
+func TestHandler_ClaimsAndEvaluations(t *testing.T) {
+	ctx, k, cdc, fk, bk := keeper.CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+
+	projectDid := createTestProjectWithAgents(t, ctx, k, fk, bk,
+		map[string]interface{}{"evaluatorPayPerClaim": "10"})
+	serviceAgentDid := testServiceAgentDid
+	evaluationAgentDid := testEvaluationAgentDid
+
+	// Only a service agent can submit a claim, and only once
+	claimMsg := types.MsgCreateClaim{
+		SenderDid:  evaluationAgentDid,
+		ProjectDid: projectDid,
+		Data:       types.CreateClaimDoc{ClaimID: "claim1"},
+	}
+	res := handleMsgCreateClaim(ctx, k, fk, bk, claimMsg)
+	require.Equal(t, types.CodeAgentNotApproved, res.Code)
+
+	claimMsg.SenderDid = serviceAgentDid
+	res = handleMsgCreateClaim(ctx, k, fk, bk, claimMsg)
+	require.True(t, res.IsOK())
+	res = handleMsgCreateClaim(ctx, k, fk, bk, claimMsg)
+	require.Equal(t, types.CodeClaimAlreadyExists, res.Code)
+
+	// Only an evaluation agent can evaluate an existing claim, and only once
+	evaluationMsg := types.MsgCreateEvaluation{
+		SenderDid:  serviceAgentDid,
+		ProjectDid: projectDid,
+		Data:       types.CreateEvaluationDoc{ClaimID: "claim2", Status: types.ApprovedClaim},
+	}
+	res = handleMsgCreateEvaluation(ctx, k, fk, bk, evaluationMsg)
+	require.Equal(t, types.CodeClaimNotFound, res.Code)
+
+	evaluationMsg.Data.ClaimID = "claim1"
+	res = handleMsgCreateEvaluation(ctx, k, fk, bk, evaluationMsg)
+	require.Equal(t, types.CodeAgentNotApproved, res.Code)
+
+	evaluationMsg.SenderDid = evaluationAgentDid
+	res = handleMsgCreateEvaluation(ctx, k, fk, bk, evaluationMsg)
+	require.True(t, res.IsOK())
+	evaluatorAddr, err := getAccountInProjectAccounts(ctx, k, projectDid,
+		InternalAccountID(evaluationAgentDid))
+	require.Nil(t, err)
+	evaluatorBalance := bk.GetCoins(ctx, evaluatorAddr)
+	require.False(t, evaluatorBalance.IsZero())
+
+	// Evaluator is not paid again for a repeated evaluation
+	res = handleMsgCreateEvaluation(ctx, k, fk, bk, evaluationMsg)
+	require.Equal(t, types.CodeClaimAlreadyEvaluated, res.Code)
+	require.Equal(t, evaluatorBalance, bk.GetCoins(ctx, evaluatorAddr))
+
+	claim, err := k.GetClaim(ctx, projectDid, "claim1")
+	require.Nil(t, err)
+	require.Equal(t, types.ApprovedClaim, claim.Status)
+	require.Equal(t, evaluationAgentDid, claim.EvaluatorDid)
+
+	require.Len(t, k.GetClaims(ctx, projectDid, types.ApprovedClaim, ""), 1)
+	require.Len(t, k.GetClaims(ctx, projectDid, types.PendingClaim, ""), 0)
+	require.Len(t, k.GetClaims(ctx, projectDid, "", serviceAgentDid), 1)
+	require.Len(t, k.GetClaims(ctx, projectDid, "", "did:ixo:other"), 0)
+}
+
+func TestHandler_ProjectTargets(t *testing.T) {
+	ctx, k, cdc, fk, bk := keeper.CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+
+	// Target of two approved claims of template1, which moves the project to
+	// the CREATED status when reached
+	projectDid := createTestProjectWithAgents(t, ctx, k, fk, bk, map[string]interface{}{
+		"evaluatorPayPerClaim": "0",
+		"targets": []types.Target{{
+			Id:              "target1",
+			ClaimTemplateId: "template1",
+			Goal:            2,
+			Action:          types.UpdateStatusTargetAction,
+			ActionStatus:    types.CreatedProject,
+		}},
+	})
+
+	targetsReached := func(res sdk.Result) (count int) {
+		for _, event := range res.Events {
+			if event.Type == types.EventTypeTargetReached {
+				count++
+			}
+		}
+		return count
+	}
+
+	claimAndEvaluate := func(claimId, templateId string, status types.ClaimStatus) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		res := handleMsgCreateClaim(ctx, k, fk, bk, types.MsgCreateClaim{
+			SenderDid:  testServiceAgentDid,
+			ProjectDid: projectDid,
+			Data:       types.CreateClaimDoc{ClaimID: claimId, ClaimTemplateID: templateId},
+		})
+		require.True(t, res.IsOK())
+		res = handleMsgCreateEvaluation(ctx, k, fk, bk, types.MsgCreateEvaluation{
+			SenderDid:  testEvaluationAgentDid,
+			ProjectDid: projectDid,
+			Data:       types.CreateEvaluationDoc{ClaimID: claimId, Status: status},
+		})
+		require.True(t, res.IsOK())
+		return res
+	}
+
+	// Claims of other templates and rejected claims do not reach the target
+	claimAndEvaluate("claim1", "template2", types.ApprovedClaim)
+	claimAndEvaluate("claim2", "template1", types.RejectedClaim)
+	claimAndEvaluate("claim3", "template1", types.ApprovedClaim)
+
+	progress := k.GetTargetProgress(ctx, projectDid, "target1")
+	require.Equal(t, uint64(1), progress.Approved)
+	require.Equal(t, uint64(1), progress.Rejected)
+	require.False(t, progress.Reached)
+
+	// Second approved claim of template1 reaches the target
+	res := claimAndEvaluate("claim4", "template1", types.ApprovedClaim)
+	require.Equal(t, 1, targetsReached(res))
+
+	progress = k.GetTargetProgress(ctx, projectDid, "target1")
+	require.True(t, progress.Reached)
+	require.Empty(t, progress.ActionError)
+
+	projectDoc, err := k.GetProjectDoc(ctx, projectDid)
+	require.Nil(t, err)
+	require.Equal(t, types.CreatedProject, projectDoc.GetStatus())
+
+	// Target is only reached once
+	res = claimAndEvaluate("claim5", "template1", types.ApprovedClaim)
+	require.Equal(t, 0, targetsReached(res))
+	require.Equal(t, uint64(3), k.GetTargetProgress(ctx, projectDid, "target1").Approved)
+}
+
+func TestHandler_TargetPaymentContracts(t *testing.T) {
+	ctx, k, cdc, fk, bk := keeper.CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+
+	// Target that effects a payment contract that does not exist yet
+	paymentTarget := types.Target{
+		Id:                      "target1",
+		Goal:                    1,
+		Action:                  types.EffectPaymentTargetAction,
+		ActionPaymentContractId: "contract1",
+	}
+	projectDid := createTestProjectWithAgents(t, ctx, k, fk, bk, map[string]interface{}{
+		"evaluatorPayPerClaim": "0",
+		"targets":              []types.Target{paymentTarget},
+	})
+
+	// Another party's contract is created with the target's contract ID
+	otherAddr := sdk.AccAddress([]byte("otherAddress"))
+	fk.SetPaymentContract(ctx, payments.NewPaymentContract("contract1",
+		"template1", otherAddr, otherAddr, false, true, sdk.ZeroUint()))
+
+	// The contract cannot be declared now that it exists
+	res := handleMsgUpdateProjectDoc(ctx, k, fk, types.MsgUpdateProjectDoc{
+		SenderDid:  projectDid,
+		ProjectDid: projectDid,
+		Data: types.UpdateProjectDataDoc{
+			Mode: types.MergePatchProjectData,
+			Data: types.MustMarshalJson(map[string]interface{}{"name": "Project"}),
+		},
+	})
+	require.Equal(t, types.CodeInvalidTarget, res.Code)
+
+	// Reaching the target does not effect the other party's contract
+	res = handleMsgCreateClaim(ctx, k, fk, bk, types.MsgCreateClaim{
+		SenderDid:  testServiceAgentDid,
+		ProjectDid: projectDid,
+		Data:       types.CreateClaimDoc{ClaimID: "claim1"},
+	})
+	require.True(t, res.IsOK())
+	res = handleMsgCreateEvaluation(ctx, k, fk, bk, types.MsgCreateEvaluation{
+		SenderDid:  testEvaluationAgentDid,
+		ProjectDid: projectDid,
+		Data:       types.CreateEvaluationDoc{ClaimID: "claim1", Status: types.ApprovedClaim},
+	})
+	require.True(t, res.IsOK())
+
+	progress := k.GetTargetProgress(ctx, projectDid, "target1")
+	require.True(t, progress.Reached)
+	require.Contains(t, progress.ActionError, "not created or paid by the project")
+}
+
+func TestHandler_MultiDenomFunding(t *testing.T) {
+	ctx, k, cdc, fk, bk := keeper.CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	did.RegisterCodec(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+
+	// Project funded in xusd, with fees also taken in xusd
+	projectDid := createTestProjectWithAgents(t, ctx, k, fk, bk,
+		map[string]interface{}{
+			"fundingDenoms":        []string{"xusd", ixo.IxoNativeToken},
+			"feesInFundingDenom":   true,
+			"evaluatorPayPerClaim": "1000000xusd",
+		})
+	projectAddr, err := getProjectAccount(ctx, k, projectDid)
+	require.Nil(t, err)
+	_, err = bk.AddCoins(ctx, projectAddr, sdk.NewCoins(
+		sdk.NewInt64Coin("xusd", 10000000000)))
+	require.Nil(t, err)
+
+	// Claim and evaluation fees and evaluator pay are in xusd
+	res := handleMsgCreateClaim(ctx, k, fk, bk, types.MsgCreateClaim{
+		SenderDid:  testServiceAgentDid,
+		ProjectDid: projectDid,
+		Data:       types.CreateClaimDoc{ClaimID: "claim1"},
+	})
+	require.True(t, res.IsOK())
+	res = handleMsgCreateEvaluation(ctx, k, fk, bk, types.MsgCreateEvaluation{
+		SenderDid:  testEvaluationAgentDid,
+		ProjectDid: projectDid,
+		Data:       types.CreateEvaluationDoc{ClaimID: "claim1", Status: types.ApprovedClaim},
+	})
+	require.True(t, res.IsOK())
+
+	feesAddr, err := getAccountInProjectAccounts(ctx, k, projectDid, ValidatingNodeSetAccountFeesId)
+	require.Nil(t, err)
+	fees := bk.GetCoins(ctx, feesAddr)
+	require.True(t, fees.AmountOf("xusd").IsPositive())
+	require.True(t, fees.AmountOf(ixo.IxoNativeToken).IsZero())
+
+	evaluatorAddr, err := getAccountInProjectAccounts(ctx, k, projectDid,
+		InternalAccountID(testEvaluationAgentDid))
+	require.Nil(t, err)
+	evaluatorPay := bk.GetCoins(ctx, evaluatorAddr)
+	require.True(t, evaluatorPay.AmountOf("xusd").IsPositive())
+	require.True(t, evaluatorPay.AmountOf(ixo.IxoNativeToken).IsZero())
+
+	// Evaluator withdraws pay in xusd once project is paid out
+	projectDoc, err := k.GetProjectDoc(ctx, projectDid)
+	require.Nil(t, err)
+	projectDoc.SetStatus(PaidoutStatus)
+	k.SetProjectDoc(ctx, projectDoc)
+	err = k.DidKeeper.SetDidDoc(ctx, did.NewBaseDidDoc(testEvaluationAgentDid,
+		"96UYka2KZEw3nNb58GfP48wPeBUjPrUFrM4AnFhoBzqx"))
+	require.Nil(t, err)
+
+	withdrawMsg := types.MsgWithdrawFunds{
+		SenderDid: testEvaluationAgentDid,
+		Data: types.WithdrawFundsDoc{
+			ProjectDid:   projectDid,
+			RecipientDid: testEvaluationAgentDid,
+			Amount:       evaluatorPay.AmountOf("xusd"),
+			Denom:        "abc",
+		},
+	}
+	res = handleMsgWithdrawFunds(ctx, k, bk, withdrawMsg)
+	require.Equal(t, types.CodeInvalidFundingDenom, res.Code)
+
+	withdrawMsg.Data.Denom = "xusd"
+	res = handleMsgWithdrawFunds(ctx, k, bk, withdrawMsg)
+	require.True(t, res.IsOK())
+	require.True(t, bk.GetCoins(ctx, evaluatorAddr).IsZero())
+
+	withdrawals, err := k.GetProjectWithdrawalTransactions(ctx, projectDid)
+	require.Nil(t, err)
+	require.Equal(t, "xusd", withdrawals[len(withdrawals)-1].Amount.Denom)
+}
+
+func TestHandler_StatusGuards(t *testing.T) {
+	ctx, k, cdc, fk, bk := keeper.CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	did.RegisterCodec(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+
+	params := types.DefaultParams()
+	params.StatusGuards = types.StatusGuardsList{
+		types.NewStatusGuards(FundedStatus, types.MinimumFundingGuard),
+		types.NewStatusGuards(StartedStatus, types.ApprovedEvaluatorGuard),
+		types.NewStatusGuards(PaidoutStatus, types.NoPendingClaimsGuard),
+	}
+	params.IxoDid = "did:ixo:ixo"
+	require.Nil(t, params.StatusGuards.Validate())
+	k.SetParams(ctx, params)
+	err := k.DidKeeper.SetDidDoc(ctx, did.NewBaseDidDoc(params.IxoDid,
+		"96UYka2KZEw3nNb58GfP48wPeBUjPrUFrM4AnFhoBzqx"))
+	require.Nil(t, err)
+
+	projectDid := createTestProjectWithAgents(t, ctx, k, fk, bk,
+		map[string]interface{}{"evaluatorPayPerClaim": "10"})
+	updateStatus := func(status ProjectStatus) sdk.Result {
+		return handleMsgUpdateProjectStatus(ctx, k, bk, types.MsgUpdateProjectStatus{
+			SenderDid:  projectDid,
+			ProjectDid: projectDid,
+			Data:       types.UpdateProjectStatusDoc{Status: status},
+		})
+	}
+	setEvaluatorStatus := func(status types.AgentStatus) {
+		res := handleMsgUpdateAgent(ctx, k, bk, types.MsgUpdateAgent{
+			SenderDid:  projectDid,
+			ProjectDid: projectDid,
+			Data:       types.UpdateAgentDoc{Did: testEvaluationAgentDid, Status: status},
+		})
+		require.True(t, res.IsOK())
+	}
+
+	require.True(t, updateStatus(types.CreatedProject).IsOK())
+	require.True(t, updateStatus(types.PendingStatus).IsOK())
+	require.True(t, updateStatus(FundedStatus).IsOK())
+
+	// STARTED requires an approved evaluator
+	setEvaluatorStatus(types.RevokedAgent)
+	require.Equal(t, types.CodeStatusGuardFailed, updateStatus(StartedStatus).Code)
+	setEvaluatorStatus(types.ApprovedAgent)
+	require.True(t, updateStatus(StartedStatus).IsOK())
+
+	claimMsg := types.MsgCreateClaim{
+		SenderDid:  testServiceAgentDid,
+		ProjectDid: projectDid,
+		Data:       types.CreateClaimDoc{ClaimID: "claim1"},
+	}
+	require.True(t, handleMsgCreateClaim(ctx, k, fk, bk, claimMsg).IsOK())
+
+	// A suspended project does not accept claims
+	require.True(t, updateStatus(SuspendedStatus).IsOK())
+	claimMsg.Data.ClaimID = "claim2"
+	res := handleMsgCreateClaim(ctx, k, fk, bk, claimMsg)
+	require.Equal(t, types.CodeInvalidProjectStatus, res.Code)
+	require.True(t, updateStatus(StartedStatus).IsOK())
+	require.True(t, updateStatus(StoppedStatus).IsOK())
+
+	// PAIDOUT requires all claims to have been evaluated
+	require.Equal(t, types.CodeStatusGuardFailed, updateStatus(PaidoutStatus).Code)
+	res = handleMsgCreateEvaluation(ctx, k, fk, bk, types.MsgCreateEvaluation{
+		SenderDid:  testEvaluationAgentDid,
+		ProjectDid: projectDid,
+		Data:       types.CreateEvaluationDoc{ClaimID: "claim1", Status: types.RejectedClaim},
+	})
+	require.True(t, res.IsOK())
+	require.True(t, updateStatus(PaidoutStatus).IsOK())
+}
+
+func TestHandler_CancelProject(t *testing.T) {
+	ctx, k, cdc, fk, bk := keeper.CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	did.RegisterCodec(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+
+	projectDid := createTestProjectWithAgents(t, ctx, k, fk, bk,
+		map[string]interface{}{"evaluatorPayPerClaim": "10"})
+	creatorDid := types.ValidCreateProjectMsg.SenderDid
+	err := k.DidKeeper.SetDidDoc(ctx, did.NewBaseDidDoc(creatorDid,
+		"96UYka2KZEw3nNb58GfP48wPeBUjPrUFrM4AnFhoBzqx"))
+	require.Nil(t, err)
+
+	withdrawMsg := types.MsgWithdrawFunds{
+		SenderDid: creatorDid,
+		Data: types.WithdrawFundsDoc{
+			ProjectDid:   projectDid,
+			RecipientDid: creatorDid,
+			Amount:       sdk.NewInt(10000000000),
+			IsRefund:     true,
+		},
+	}
+
+	// Refunds are only possible once the project is cancelled
+	res := handleMsgWithdrawFunds(ctx, k, bk, withdrawMsg)
+	require.False(t, res.IsOK())
+
+	for _, status := range []ProjectStatus{types.CreatedProject, CancelledStatus} {
+		res = handleMsgUpdateProjectStatus(ctx, k, bk, types.MsgUpdateProjectStatus{
+			SenderDid:  projectDid,
+			ProjectDid: projectDid,
+			Data:       types.UpdateProjectStatusDoc{Status: status},
+		})
+		require.True(t, res.IsOK())
+	}
+
+	res = handleMsgWithdrawFunds(ctx, k, bk, withdrawMsg)
+	require.True(t, res.IsOK())
+	projectAddr, err := getProjectAccount(ctx, k, projectDid)
+	require.Nil(t, err)
+	require.True(t, bk.GetCoins(ctx, projectAddr).IsZero())
+
+	// A cancelled project is final
+	require.False(t, StartedStatus.IsValidProgressionFrom(CancelledStatus))
+}
+
+func TestHandler_UpdateProjectDoc(t *testing.T) {
+	ctx, k, cdc, fk, bk := keeper.CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+
+	projectDid := createTestProjectWithAgents(t, ctx, k, fk, bk,
+		map[string]interface{}{"evaluatorPayPerClaim": "10", "name": "Project"})
+	updateDoc := func(mode types.ProjectDataUpdateMode, data string) sdk.Result {
+		return handleMsgUpdateProjectDoc(ctx.WithBlockHeight(ctx.BlockHeight()+1), k, fk,
+			types.MsgUpdateProjectDoc{
+				SenderDid:  projectDid,
+				ProjectDid: projectDid,
+				Data:       types.UpdateProjectDataDoc{Mode: mode, Data: json.RawMessage(data)},
+			})
+	}
+	getData := func() map[string]interface{} {
+		projectDoc, err := k.GetProjectDoc(ctx, projectDid)
+		require.Nil(t, err)
+		var data map[string]interface{}
+		require.Nil(t, json.Unmarshal(projectDoc.GetData(), &data))
+		return data
+	}
+
+	// Data is merge-patched, with null removing fields
+	res := updateDoc(types.MergePatchProjectData,
+		`{"name":"Renamed","description":"Desc","evaluatorPayPerClaim":"20"}`)
+	require.True(t, res.IsOK())
+	require.Equal(t, map[string]interface{}{"name": "Renamed",
+		"description": "Desc", "evaluatorPayPerClaim": "20"}, getData())
+
+	res = updateDoc(types.MergePatchProjectData, `{"description":null}`)
+	require.True(t, res.IsOK())
+	require.Equal(t, map[string]interface{}{"name": "Renamed",
+		"evaluatorPayPerClaim": "20"}, getData())
+
+	// Updated data has to be valid
+	res = updateDoc(types.MergePatchProjectData, `{"evaluatorPayPerClaim":null}`)
+	require.False(t, res.IsOK())
+
+	// Locked fields cannot be changed once the project has started
+	projectDoc, err := k.GetProjectDoc(ctx, projectDid)
+	require.Nil(t, err)
+	projectDoc.SetStatus(StartedStatus)
+	k.SetProjectDoc(ctx, projectDoc)
+
+	res = updateDoc(types.ReplaceProjectData, `{"evaluatorPayPerClaim":"30"}`)
+	require.Equal(t, types.CodeLockedProjectDataField, res.Code)
+	res = updateDoc(types.ReplaceProjectData, `{"evaluatorPayPerClaim":"20"}`)
+	require.True(t, res.IsOK())
+	require.Equal(t, map[string]interface{}{"evaluatorPayPerClaim": "20"}, getData())
+
+	// They stay locked once a suspended project is cancelled
+	projectDoc, err = k.GetProjectDoc(ctx, projectDid)
+	require.Nil(t, err)
+	projectDoc.SetStatus(SuspendedStatus)
+	k.SetProjectDoc(ctx, projectDoc)
+	require.True(t, CancelledStatus.IsValidProgressionFrom(SuspendedStatus))
+	projectDoc.SetStatus(CancelledStatus)
+	k.SetProjectDoc(ctx, projectDoc)
+
+	res = updateDoc(types.ReplaceProjectData, `{"evaluatorPayPerClaim":"30"}`)
+	require.Equal(t, types.CodeLockedProjectDataField, res.Code)
+
+	// All versions are kept, starting with the creation data
+	versions := k.GetProjectDocVersions(ctx, projectDid)
+	require.Len(t, versions, 4)
+	require.Equal(t, uint64(4), k.GetLatestProjectDocVersion(ctx, projectDid))
+	for i, version := range versions {
+		require.Equal(t, uint64(i+1), version.Version)
+	}
+	projectDoc, err = k.GetProjectDoc(ctx, projectDid)
+	require.Nil(t, err)
+	require.Equal(t, versions[3].Data, projectDoc.GetData())
+	require.Equal(t, ctx.BlockHeight()+1, versions[1].Height)
+
+	first, err := k.GetProjectDocVersion(ctx, projectDid, 1)
+	require.Nil(t, err)
+	require.Equal(t, types.MustMarshalJson(map[string]interface{}{
+		"evaluatorPayPerClaim": "10", "name": "Project"}), []byte(first.Data))
+
+	_, err = k.GetProjectDocVersion(ctx, projectDid, 5)
+	require.Equal(t, types.CodeDocVersionNotFound, err.Code())
+}
+
+func TestHandler_RegisterSchema(t *testing.T) {
+	ctx, k, cdc, fk, _ := keeper.CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+
+	schemaAdminDid := "did:ixo:U7GK8p8rVhJMKhBVRCJJ8c"
+	params := types.DefaultParams()
+	params.SchemaAdminDid = schemaAdminDid
+	k.SetParams(ctx, params)
+
+	registerSchema := func(senderDid did.Did, id, schema string) sdk.Result {
+		msg := types.NewMsgRegisterSchema(senderDid, types.RegisterSchemaDoc{
+			SchemaId: id, Schema: json.RawMessage(schema)})
+		if err := msg.ValidateBasic(); err != nil {
+			return err.Result()
+		}
+		return handleMsgRegisterSchema(ctx, k, msg)
+	}
+	schema := `{
+		"type": "object",
+		"required": ["name", "evaluatorPayPerClaim"],
+		"properties": {
+			"name": {"type": "string", "minLength": 1},
+			"evaluatorPayPerClaim": {"type": "string", "pattern": "^[0-9]+$"},
+			"tags": {"type": "array", "items": {"enum": ["a", "b"]}, "uniqueItems": true}
+		}
+	}`
+
+	// Only the schema admin can register schemas, and only supported schemas
+	res := registerSchema("did:ixo:4XJLBfGtWSGKSz4BeRxdun", "project-v1", schema)
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+	res = registerSchema(schemaAdminDid, "project-v1", `{"$ref": "#/other"}`)
+	require.Equal(t, types.CodeInvalidSchema, res.Code)
+	res = registerSchema(schemaAdminDid, "project-v1", schema)
+	require.True(t, res.IsOK())
+	res = registerSchema(schemaAdminDid, "project-v1", `{}`)
+	require.Equal(t, types.CodeSchemaAlreadyExists, res.Code)
+	require.Len(t, k.GetSchemas(ctx), 1)
+
+	createProject := func(data string) sdk.Result {
+		msg := types.ValidCreateProjectMsg
+		msg.Data = json.RawMessage(data)
+		if err := types.ValidateProjectData(msg.Data); err != nil {
+			return err.Result()
+		}
+		return handleMsgCreateProject(ctx, k, fk, msg)
+	}
+
+	// Data has to conform to the declared schema, which has to exist
+	res = createProject(`{"schemaId":"project-v2","name":"P","evaluatorPayPerClaim":"10"}`)
+	require.Equal(t, types.CodeSchemaNotFound, res.Code)
+	res = createProject(`{"schemaId":"project-v1","name":"","evaluatorPayPerClaim":"10"}`)
+	require.Equal(t, types.CodeDataDoesNotMatchSchema, res.Code)
+	res = createProject(`{"schemaId":"project-v1","name":"P","evaluatorPayPerClaim":"10","tags":["a","a"]}`)
+	require.Equal(t, types.CodeDataDoesNotMatchSchema, res.Code)
+	res = createProject(`{"schemaId":1,"name":"P","evaluatorPayPerClaim":"10"}`)
+	require.Equal(t, types.CodeInvalidSchema, res.Code)
+	res = createProject(`{"schemaId":"project-v1","name":"P","evaluatorPayPerClaim":"10","tags":["b"]}`)
+	require.True(t, res.IsOK())
+
+	// Updates are also checked against the schema
+	projectDid := types.ValidCreateProjectMsg.ProjectDid
+	res = handleMsgUpdateProjectDoc(ctx, k, fk, types.MsgUpdateProjectDoc{
+		SenderDid:  projectDid,
+		ProjectDid: projectDid,
+		Data: types.UpdateProjectDataDoc{Mode: types.MergePatchProjectData,
+			Data: json.RawMessage(`{"tags":["c"]}`)},
+	})
+	require.Equal(t, types.CodeDataDoesNotMatchSchema, res.Code)
+	res = handleMsgUpdateProjectDoc(ctx, k, fk, types.MsgUpdateProjectDoc{
+		SenderDid:  projectDid,
+		ProjectDid: projectDid,
+		Data: types.UpdateProjectDataDoc{Mode: types.MergePatchProjectData,
+			Data: json.RawMessage(`{"tags":["a","b"]}`)},
+	})
+	require.True(t, res.IsOK())
+}
+
+// setTestAgentDidDoc stores a DID doc with a new ed25519 key for the agent and
+// returns the agent's ixo DID, with which it can sign claims and evaluations.
+func setTestAgentDidDoc(t *testing.T, ctx sdk.Context, k keeper.Keeper, agentDid did.Did) did.IxoDid {
+	privKey := ed25519.GenPrivKey()
+	ixoDid := did.IxoDid{
+		Did:       agentDid,
+		VerifyKey: base58.Encode(privKey[32:]),
+		Secret:    didexported.Secret{SignKey: base58.Encode(privKey[:32])},
+	}
+	err := k.DidKeeper.SetDidDoc(ctx, did.NewBaseDidDoc(agentDid, ixoDid.VerifyKey))
+	require.Nil(t, err)
+	return ixoDid
+}
+
+func signTestSenderProof(t *testing.T, proofBytes []byte, ixoDid did.IxoDid) []byte {
+	sig, err := ixo.SignBytesWithIxoDid(proofBytes, ixoDid)
+	require.Nil(t, err)
+	return sig.Signature
+}
+
+func TestHandler_AgentStats(t *testing.T) {
+	ctx, k, cdc, fk, bk := keeper.CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+
+	projectDid := createTestProjectWithAgents(t, ctx, k, fk, bk,
+		map[string]interface{}{"evaluatorPayPerClaim": "10"})
+	serviceAgent := setTestAgentDidDoc(t, ctx, k, testServiceAgentDid)
+	evaluationAgent := setTestAgentDidDoc(t, ctx, k, testEvaluationAgentDid)
+
+	// Submit three claims signed by the claimer, and one that is not
+	for _, claimId := range []string{"claim1", "claim2", "claim3", "claim4"} {
+		msg := types.MsgCreateClaim{
+			SenderDid:  testServiceAgentDid,
+			ProjectDid: projectDid,
+			Data:       types.CreateClaimDoc{ClaimID: claimId},
+		}
+		if claimId != "claim4" {
+			msg.SenderSignature = signTestSenderProof(t, msg.GetSenderProofBytes(), serviceAgent)
+		}
+		res := handleMsgCreateClaim(ctx, k, fk, bk, msg)
+		require.True(t, res.IsOK())
+	}
+
+	// A claim with a signature by another key is rejected
+	badClaimMsg := types.MsgCreateClaim{
+		SenderDid:  testServiceAgentDid,
+		ProjectDid: projectDid,
+		Data:       types.CreateClaimDoc{ClaimID: "claim5"},
+	}
+	badClaimMsg.SenderSignature = signTestSenderProof(t, badClaimMsg.GetSenderProofBytes(), evaluationAgent)
+	res := handleMsgCreateClaim(ctx, k, fk, bk, badClaimMsg)
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+
+	// One claim is approved and two are rejected, all signed by the evaluator
+	for claimId, status := range map[string]types.ClaimStatus{
+		"claim1": types.ApprovedClaim, "claim2": types.RejectedClaim, "claim4": types.RejectedClaim} {
+		msg := types.MsgCreateEvaluation{
+			SenderDid:  testEvaluationAgentDid,
+			ProjectDid: projectDid,
+			Data:       types.CreateEvaluationDoc{ClaimID: claimId, Status: status},
+		}
+		msg.SenderSignature = signTestSenderProof(t, msg.GetSenderProofBytes(), evaluationAgent)
+		res := handleMsgCreateEvaluation(ctx, k, fk, bk, msg)
+		require.True(t, res.IsOK())
+	}
+
+	// Failed evaluations are not counted
+	res = handleMsgCreateEvaluation(ctx, k, fk, bk, types.MsgCreateEvaluation{
+		SenderDid:  testEvaluationAgentDid,
+		ProjectDid: projectDid,
+		Data:       types.CreateEvaluationDoc{ClaimID: "claim1", Status: types.RejectedClaim},
+	})
+	require.Equal(t, types.CodeClaimAlreadyEvaluated, res.Code)
+
+	// The unsigned claim does not count towards the claimer's statistics
+	serviceAgentStats := k.GetAgentStats(ctx, testServiceAgentDid)
+	require.Equal(t, uint64(3), serviceAgentStats.ClaimsSubmitted)
+	require.Equal(t, uint64(1), serviceAgentStats.ClaimsApproved)
+	require.Equal(t, uint64(1), serviceAgentStats.ClaimsRejected)
+	require.Equal(t, uint64(1), serviceAgentStats.ClaimsPending())
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), serviceAgentStats.ClaimApprovalRate())
+	require.Equal(t, uint64(0), serviceAgentStats.EvaluationsPerformed)
+
+	evaluationAgentStats := k.GetAgentStats(ctx, testEvaluationAgentDid)
+	require.Equal(t, uint64(3), evaluationAgentStats.EvaluationsPerformed)
+	require.Equal(t, uint64(0), evaluationAgentStats.ClaimsSubmitted)
+	require.True(t, evaluationAgentStats.ClaimApprovalRate().IsZero())
+
+	// Only rejected claims can be disputed, and only once
+	resolveDispute := func(claimId string, evaluationUpheld bool) sdk.Result {
+		return handleMsgResolveClaimDispute(ctx, k, types.MsgResolveClaimDispute{
+			SenderDid:  projectDid,
+			ProjectDid: projectDid,
+			Data: types.ResolveClaimDisputeDoc{
+				ClaimID: claimId, EvaluationUpheld: evaluationUpheld},
+		})
+	}
+	require.Equal(t, types.CodeClaimNotDisputable, resolveDispute("claim1", false).Code)
+	require.Equal(t, types.CodeClaimNotDisputable, resolveDispute("claim3", false).Code)
+	require.True(t, resolveDispute("claim2", false).IsOK())
+	require.Equal(t, types.CodeClaimNotDisputable, resolveDispute("claim2", true).Code)
+
+	claim, err := k.GetClaim(ctx, projectDid, "claim2")
+	require.Nil(t, err)
+	require.Equal(t, testEvaluationAgentDid, claim.DisputeLoserDid)
+	require.Equal(t, types.RejectedClaim, claim.Status)
+	require.Equal(t, uint64(1), k.GetAgentStats(ctx, testEvaluationAgentDid).DisputesLost)
+
+	// The claimer loses the dispute over its unsigned claim, which does not
+	// count towards its statistics
+	require.True(t, resolveDispute("claim4", true).IsOK())
+	require.Equal(t, uint64(0), k.GetAgentStats(ctx, testServiceAgentDid).DisputesLost)
+
+	// Agents without any activity have zero stats
+	require.Equal(t, types.NewAgentStats("did:ixo:other"), k.GetAgentStats(ctx, "did:ixo:other"))
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/project/internal/types"
+)
+
+func (k Keeper) GetAgentIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.AgentKey)
+}
+
+func (k Keeper) GetProjectAgentIterator(ctx sdk.Context, projectDid did.Did) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetAgentsPrefixKey(projectDid))
+}
+
+func (k Keeper) MustGetAgentByKey(ctx sdk.Context, key []byte) types.ProjectAgent {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("agent not found")
+	}
+
+	bz := store.Get(key)
+	var agent types.ProjectAgent
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &agent)
+
+	return agent
+}
+
+func (k Keeper) AgentExists(ctx sdk.Context, projectDid, agentDid did.Did) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAgentKey(projectDid, agentDid))
+}
+
+func (k Keeper) GetAgent(ctx sdk.Context, projectDid, agentDid did.Did) (types.ProjectAgent, sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAgentKey(projectDid, agentDid)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return types.ProjectAgent{}, types.ErrAgentNotFound(types.DefaultCodespace, projectDid, agentDid)
+	}
+
+	var agent types.ProjectAgent
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &agent)
+
+	return agent, nil
+}
+
+// GetAgents returns the agents of a project with the specified role and
+// status. An empty role or status means that agents with any role or status
+// are returned.
+func (k Keeper) GetAgents(ctx sdk.Context, projectDid did.Did,
+	role types.AgentRole, status types.AgentStatus) []types.ProjectAgent {
+	iterator := k.GetProjectAgentIterator(ctx, projectDid)
+	defer iterator.Close()
+
+	agents := []types.ProjectAgent{}
+	for ; iterator.Valid(); iterator.Next() {
+		var agent types.ProjectAgent
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &agent)
+		if agent.Matches(role, status) {
+			agents = append(agents, agent)
+		}
+	}
+
+	return agents
+}
+
+func (k Keeper) SetAgent(ctx sdk.Context, agent types.ProjectAgent) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAgentKey(agent.ProjectDid, agent.AgentDid)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(agent))
+}
+
+// IsApprovedAgent indicates whether the DID is an approved agent of the
+// project with the specified role.
+func (k Keeper) IsApprovedAgent(ctx sdk.Context, projectDid, agentDid did.Did,
+	role types.AgentRole) bool {
+	agent, err := k.GetAgent(ctx, projectDid, agentDid)
+	if err != nil {
+		return false
+	}
+	return agent.Role == role && agent.Status == types.ApprovedAgent
+}
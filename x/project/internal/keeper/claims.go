@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/project/internal/types"
+)
+
+func (k Keeper) GetClaimIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.ClaimKey)
+}
+
+func (k Keeper) GetProjectClaimIterator(ctx sdk.Context, projectDid did.Did) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetClaimsPrefixKey(projectDid))
+}
+
+func (k Keeper) MustGetClaimByKey(ctx sdk.Context, key []byte) types.Claim {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("claim not found")
+	}
+
+	bz := store.Get(key)
+	var claim types.Claim
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &claim)
+
+	return claim
+}
+
+func (k Keeper) ClaimExists(ctx sdk.Context, projectDid did.Did, claimId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetClaimKey(projectDid, claimId))
+}
+
+func (k Keeper) GetClaim(ctx sdk.Context, projectDid did.Did, claimId string) (types.Claim, sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetClaimKey(projectDid, claimId)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return types.Claim{}, types.ErrClaimNotFound(types.DefaultCodespace, projectDid, claimId)
+	}
+
+	var claim types.Claim
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &claim)
+
+	return claim, nil
+}
+
+// GetClaims returns the claims of a project with the specified status and
+// submitted or evaluated by the specified agent. An empty status or agent DID
+// means that claims with any status or by any agent are returned.
+func (k Keeper) GetClaims(ctx sdk.Context, projectDid did.Did,
+	status types.ClaimStatus, agentDid did.Did) []types.Claim {
+	iterator := k.GetProjectClaimIterator(ctx, projectDid)
+	defer iterator.Close()
+
+	claims := []types.Claim{}
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.Claim
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &claim)
+		if claim.Matches(status, agentDid) {
+			claims = append(claims, claim)
+		}
+	}
+
+	return claims
+}
+
+func (k Keeper) SetClaim(ctx sdk.Context, claim types.Claim) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetClaimKey(claim.ProjectDid, claim.ClaimId)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(claim))
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"encoding/json"
+	"math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -150,12 +152,52 @@ func (k Keeper) CreateNewAccount(ctx sdk.Context, projectDid did.Did,
 	return account, nil
 }
 
+// assignLegacyWithdrawalSequences gives any of the withdrawals without a
+// sequence number (recorded before sequences were introduced, all with the
+// same action ID) the next sequence numbers, in order, after the highest one
+// in use, along with the corresponding action IDs. It returns the resulting
+// withdrawals and whether any withdrawal was given a sequence number.
+func (k Keeper) assignLegacyWithdrawalSequences(ctx sdk.Context, projectDid did.Did,
+	txs []types.WithdrawalInfo) ([]types.WithdrawalInfo, bool) {
+	next := k.GetWithdrawalSequence(ctx, projectDid) + 1
+	for _, info := range txs {
+		if info.Sequence >= next {
+			next = info.Sequence + 1
+		}
+	}
+
+	assigned := false
+	txs = append([]types.WithdrawalInfo(nil), txs...)
+	for i := range txs {
+		if txs[i].Sequence == 0 {
+			txs[i].Sequence = next
+			txs[i].ActionID = types.GetWithdrawalActionId(projectDid, next)
+			assigned = true
+			next++
+		}
+	}
+
+	return txs, assigned
+}
+
+// SetProjectWithdrawalTransactions replaces a project's withdrawals, giving
+// any legacy withdrawals without a sequence number (e.g. from genesis) their
+// sequence numbers and action IDs.
 func (k Keeper) SetProjectWithdrawalTransactions(ctx sdk.Context, projectDid did.Did, txs []types.WithdrawalInfo) {
+	txs, _ = k.assignLegacyWithdrawalSequences(ctx, projectDid, txs)
+
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(txs)
 	store.Set(types.GetWithdrawalPrefixKey(projectDid), bz)
+
+	for _, info := range txs {
+		k.indexWithdrawal(ctx, info)
+	}
 }
 
+// GetProjectWithdrawalTransactions returns a project's withdrawals. Legacy
+// withdrawals without a sequence number are given their sequence numbers and
+// action IDs when first read, so that they are upgraded without a migration.
 func (k Keeper) GetProjectWithdrawalTransactions(ctx sdk.Context, projectDid did.Did) ([]types.WithdrawalInfo, sdk.Error) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetWithdrawalPrefixKey(projectDid)
@@ -163,12 +205,16 @@ func (k Keeper) GetProjectWithdrawalTransactions(ctx sdk.Context, projectDid did
 	bz := store.Get(key)
 	if bz == nil {
 		return []types.WithdrawalInfo{}, did.ErrorInvalidDid(types.DefaultCodespace, "ProjectDoc doesn't exist")
-	} else {
-		var txs []types.WithdrawalInfo
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &txs)
+	}
 
-		return txs, nil
+	var txs []types.WithdrawalInfo
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &txs)
+
+	txs, assigned := k.assignLegacyWithdrawalSequences(ctx, projectDid, txs)
+	if assigned {
+		k.SetProjectWithdrawalTransactions(ctx, projectDid, txs)
 	}
+	return txs, nil
 }
 
 func (k Keeper) AddProjectWithdrawalTransaction(ctx sdk.Context, projectDid did.Did, info types.WithdrawalInfo) {
@@ -179,4 +225,70 @@ func (k Keeper) AddProjectWithdrawalTransaction(ctx sdk.Context, projectDid did.
 	txs = append(txs, info)
 
 	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(txs))
+	k.indexWithdrawal(ctx, info)
+}
+
+// GetWithdrawalSequence returns the sequence number of the latest withdrawal
+// from a project, which is zero if no withdrawals have been made.
+func (k Keeper) GetWithdrawalSequence(ctx sdk.Context, projectDid did.Did) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWithdrawalSequenceKey(projectDid))
+	if bz == nil {
+		return 0
+	}
+
+	var sequence uint64
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &sequence)
+	return sequence
+}
+
+// NextWithdrawalSequence returns the sequence number that the next withdrawal
+// from a project is to be given. The sequence is only incremented once the
+// withdrawal is added using AddProjectWithdrawalTransaction.
+func (k Keeper) NextWithdrawalSequence(ctx sdk.Context, projectDid did.Did) uint64 {
+	// Make sure that any legacy withdrawals have been given their sequences
+	_, _ = k.GetProjectWithdrawalTransactions(ctx, projectDid)
+	return k.GetWithdrawalSequence(ctx, projectDid) + 1
+}
+
+// indexWithdrawal adds the withdrawal to the recipient's withdrawals and makes
+// sure that the project's sequence is not behind the withdrawal's sequence.
+func (k Keeper) indexWithdrawal(ctx sdk.Context, info types.WithdrawalInfo) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetWithdrawalByRecipientKey(info.RecipientDid, info.ProjectDid, info.Sequence)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(info))
+
+	if info.Sequence > k.GetWithdrawalSequence(ctx, info.ProjectDid) {
+		store.Set(types.GetWithdrawalSequenceKey(info.ProjectDid),
+			k.cdc.MustMarshalBinaryLengthPrefixed(info.Sequence))
+	}
+}
+
+// GetWithdrawalsByRecipient returns a page (starting at 1) of the withdrawals
+// made to a recipient, ordered by project and sequence.
+func (k Keeper) GetWithdrawalsByRecipient(ctx sdk.Context, recipientDid did.Did,
+	page, limit uint64) []types.WithdrawalInfo {
+	// There is no such page if the number of withdrawals before it overflows
+	txs := []types.WithdrawalInfo{}
+	if page == 0 || limit == 0 || page-1 > math.MaxUint64/limit {
+		return txs
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store,
+		types.GetWithdrawalsByRecipientPrefixKey(recipientDid))
+	defer iterator.Close()
+
+	skip := (page - 1) * limit
+	for ; iterator.Valid() && uint64(len(txs)) < limit; iterator.Next() {
+		if skip > 0 {
+			skip--
+			continue
+		}
+		var info types.WithdrawalInfo
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &info)
+		txs = append(txs, info)
+	}
+
+	return txs
 }
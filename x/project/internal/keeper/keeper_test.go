@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/stretchr/testify/require"
@@ -70,3 +72,141 @@ func TestKeeperWithdrawalInfo(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, 2, len(withdrawals))
 }
+
+func TestKeeperWithdrawalSequence(t *testing.T) {
+	ctx, k, _, _, _ := CreateTestInput()
+
+	recipientDid := types.ValidWithdrawalInfo.RecipientDid
+	otherProjectDid := "U7GK8p8rVhJMKhBVRCJJ8c"
+	amount := types.ValidWithdrawalInfo.Amount
+
+	// Sequences start at 1 and are independent for each project
+	require.Equal(t, uint64(0), k.GetWithdrawalSequence(ctx, types.ProjectDid))
+	for i := uint64(1); i <= 3; i++ {
+		seq := k.NextWithdrawalSequence(ctx, types.ProjectDid)
+		require.Equal(t, i, seq)
+		info := types.NewWithdrawalInfo(ctx, types.ProjectDid, recipientDid, amount, seq)
+		k.AddProjectWithdrawalTransaction(ctx, types.ProjectDid, info)
+	}
+	seq := k.NextWithdrawalSequence(ctx, otherProjectDid)
+	require.Equal(t, uint64(1), seq)
+	info := types.NewWithdrawalInfo(ctx, otherProjectDid, recipientDid, amount, seq)
+	k.AddProjectWithdrawalTransaction(ctx, otherProjectDid, info)
+
+	// Action IDs are unique and derived from the sequence
+	withdrawals, err := k.GetProjectWithdrawalTransactions(ctx, types.ProjectDid)
+	require.Nil(t, err)
+	require.Len(t, withdrawals, 3)
+	require.Regexp(t, "^0x[0-9a-f]{64}$", withdrawals[0].ActionID)
+	require.NotEqual(t, withdrawals[0].ActionID, withdrawals[1].ActionID)
+	require.Equal(t, types.GetWithdrawalActionId(types.ProjectDid, 3), withdrawals[2].ActionID)
+
+	// Action IDs are also unique across projects with the same sequence
+	otherWithdrawals, err := k.GetProjectWithdrawalTransactions(ctx, otherProjectDid)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), otherWithdrawals[0].Sequence)
+	require.NotEqual(t, withdrawals[0].ActionID, otherWithdrawals[0].ActionID)
+
+	// Recipient's withdrawals are paginated across projects
+	require.Len(t, k.GetWithdrawalsByRecipient(ctx, recipientDid, 1, 10), 4)
+	page := k.GetWithdrawalsByRecipient(ctx, recipientDid, 2, 3)
+	require.Len(t, page, 1)
+	require.Len(t, k.GetWithdrawalsByRecipient(ctx, recipientDid, 3, 3), 0)
+	require.Len(t, k.GetWithdrawalsByRecipient(ctx, recipientDid, 1<<63+1, 2), 0)
+	require.Len(t, k.GetWithdrawalsByRecipient(ctx, "otherRecipient", 1, 10), 0)
+
+	// Setting withdrawals (e.g. from genesis) moves the sequence forward
+	info = types.NewWithdrawalInfo(ctx, types.ProjectDid, recipientDid, amount, 10)
+	k.SetProjectWithdrawalTransactions(ctx, types.ProjectDid, []types.WithdrawalInfo{info})
+	require.Equal(t, uint64(11), k.NextWithdrawalSequence(ctx, types.ProjectDid))
+
+	// Legacy withdrawals without a sequence are given the next sequences, so
+	// that they are all indexed for the recipient
+	legacyRecipientDid := "did:ixo:4XJLBfGtWSGKSz4BeRxdun"
+	legacy := types.WithdrawalInfo{
+		ActionID:     "0x" + strings.Repeat("0", 63) + "1",
+		ProjectDid:   otherProjectDid,
+		RecipientDid: legacyRecipientDid,
+		Amount:       amount,
+	}
+	info = types.NewWithdrawalInfo(ctx, otherProjectDid, recipientDid, amount, 10)
+	k.SetProjectWithdrawalTransactions(ctx, otherProjectDid,
+		[]types.WithdrawalInfo{legacy, legacy, info})
+	withdrawals, err = k.GetProjectWithdrawalTransactions(ctx, otherProjectDid)
+	require.Nil(t, err)
+	require.Equal(t, uint64(11), withdrawals[0].Sequence)
+	require.Equal(t, uint64(12), withdrawals[1].Sequence)
+	require.Equal(t, types.GetWithdrawalActionId(otherProjectDid, 11), withdrawals[0].ActionID)
+	require.Equal(t, types.GetWithdrawalActionId(otherProjectDid, 12), withdrawals[1].ActionID)
+	require.Len(t, k.GetWithdrawalsByRecipient(ctx, legacyRecipientDid, 1, 10), 2)
+	require.Equal(t, uint64(13), k.NextWithdrawalSequence(ctx, otherProjectDid))
+
+	// Legacy withdrawals already in the store (recorded before sequences were
+	// introduced) are given their sequences when first read
+	legacyProjectDid := "did:ixo:legacyProject"
+	legacy.ProjectDid = legacyProjectDid
+	ctx.KVStore(k.storeKey).Set(types.GetWithdrawalPrefixKey(legacyProjectDid),
+		k.cdc.MustMarshalBinaryLengthPrefixed([]types.WithdrawalInfo{legacy, legacy}))
+	require.Equal(t, uint64(3), k.NextWithdrawalSequence(ctx, legacyProjectDid))
+	withdrawals, err = k.GetProjectWithdrawalTransactions(ctx, legacyProjectDid)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), withdrawals[0].Sequence)
+	require.Equal(t, uint64(2), withdrawals[1].Sequence)
+	require.NotEqual(t, withdrawals[0].ActionID, withdrawals[1].ActionID)
+	require.Len(t, k.GetWithdrawalsByRecipient(ctx, legacyRecipientDid, 1, 10), 4)
+}
+
+func TestKeeperAgents(t *testing.T) {
+	ctx, k, _, _, _ := CreateTestInput()
+
+	agent1 := types.NewProjectAgent(ctx, types.ProjectDid, "did:ixo:agent1", types.ServiceAgent)
+	agent2 := types.NewProjectAgent(ctx, types.ProjectDid, "did:ixo:agent2", types.EvaluationAgent)
+	agent2.Update(ctx, types.ApprovedAgent, "")
+
+	// Agent of a project whose DID has the other project's DID as a prefix
+	otherAgent := types.NewProjectAgent(ctx, types.ProjectDid+"2", "did:ixo:agent3", types.ServiceAgent)
+
+	require.False(t, k.AgentExists(ctx, types.ProjectDid, agent1.AgentDid))
+	_, err := k.GetAgent(ctx, types.ProjectDid, agent1.AgentDid)
+	require.NotNil(t, err)
+
+	k.SetAgent(ctx, agent1)
+	k.SetAgent(ctx, agent2)
+	k.SetAgent(ctx, otherAgent)
+
+	agent, err := k.GetAgent(ctx, types.ProjectDid, agent1.AgentDid)
+	require.Nil(t, err)
+	require.Equal(t, agent1, agent)
+
+	require.Len(t, k.GetAgents(ctx, types.ProjectDid, "", ""), 2)
+	require.Equal(t, []types.ProjectAgent{agent1},
+		k.GetAgents(ctx, types.ProjectDid, types.ServiceAgent, ""))
+	require.Equal(t, []types.ProjectAgent{agent2},
+		k.GetAgents(ctx, types.ProjectDid, "", types.ApprovedAgent))
+	require.Len(t, k.GetAgents(ctx, types.ProjectDid, types.ServiceAgent, types.ApprovedAgent), 0)
+}
+
+func TestKeeperProjectCreationParams(t *testing.T) {
+	ctx, k, _, _, _ := CreateTestInput()
+
+	params := types.DefaultParams()
+	params.IxoDid = "did:ixo:U7GK8p8rVhJMKhBVRCJJ8c"
+	params.ProjectCreationFee = sdk.NewCoins(sdk.NewInt64Coin("uixo", 5000000))
+	params.ProjectCreationTransactionFee = sdk.NewCoins(sdk.NewInt64Coin("uixo", 20000))
+	params.ProjectCreationGasLimit = 2000000
+	require.Nil(t, types.ValidateParams(params))
+
+	k.SetParams(ctx, params)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	// The transaction fee cannot exceed the creation fee
+	params.ProjectCreationTransactionFee = sdk.NewCoins(sdk.NewInt64Coin("uixo", 6000000))
+	require.NotNil(t, types.ValidateParams(params))
+	params.ProjectCreationTransactionFee = sdk.NewCoins(sdk.NewInt64Coin("xusd", 1))
+	require.NotNil(t, types.ValidateParams(params))
+
+	// The gas limit has to be positive
+	params.ProjectCreationTransactionFee = sdk.NewCoins()
+	params.ProjectCreationGasLimit = 0
+	require.NotNil(t, types.ValidateParams(params))
+}
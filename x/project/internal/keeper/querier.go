@@ -3,10 +3,13 @@ package keeper
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/tendermint/tendermint/abci/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/project/internal/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 const (
@@ -14,10 +17,23 @@ const (
 	QueryProjectAccounts = "queryProjectAccounts"
 	QueryProjectTx       = "queryProjectTx"
 	QueryParams          = "queryParams"
+	QueryProjectAgents   = "queryProjectAgents"
+	QueryProjectClaims   = "queryProjectClaims"
+	QueryProjectTargets  = "queryProjectTargets"
+
+	QueryRecipientWithdrawals = "queryRecipientWithdrawals"
+	QueryProjectDocVersions   = "queryProjectDocVersions"
+	QueryProjectDocVersion    = "queryProjectDocVersion"
+	QuerySchemas              = "querySchemas"
+	QuerySchema               = "querySchema"
+	QueryAgentStats           = "queryAgentStats"
+
+	DefaultQueryPageLimit = 100
+	MaxQueryPageLimit     = 1000
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
-	return func(ctx sdk.Context, path []string, req types.RequestQuery) (res []byte, err sdk.Error) {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
 		switch path[0] {
 		case QueryProjectDoc:
 			return queryProjectDoc(ctx, path[1:], k)
@@ -27,6 +43,24 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryProjectTx(ctx, path[1:], k)
 		case QueryParams:
 			return queryParams(ctx, k)
+		case QueryProjectAgents:
+			return queryProjectAgents(ctx, path[1:], k)
+		case QueryProjectClaims:
+			return queryProjectClaims(ctx, path[1:], k)
+		case QueryProjectTargets:
+			return queryProjectTargets(ctx, path[1:], k)
+		case QueryRecipientWithdrawals:
+			return queryRecipientWithdrawals(ctx, path[1:], k)
+		case QueryProjectDocVersions:
+			return queryProjectDocVersions(ctx, path[1:], k)
+		case QueryProjectDocVersion:
+			return queryProjectDocVersion(ctx, path[1:], k)
+		case QuerySchemas:
+			return querySchemas(ctx, k)
+		case QuerySchema:
+			return querySchema(ctx, path[1:], k)
+		case QueryAgentStats:
+			return queryAgentStats(ctx, path[1:], k)
 		default:
 			return nil, sdk.ErrUnknownRequest("Unknown project query endpoint")
 		}
@@ -72,6 +106,232 @@ func queryProjectTx(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error
 	return res, nil
 }
 
+// queryProjectAgents expects a path of the form [project-did, role, status]
+// where the role and status are optional filters that can also be empty.
+func queryProjectAgents(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("project did not specified")
+	}
+
+	var role, status string
+	if len(path) > 1 {
+		role = path[1]
+	}
+	if len(path) > 2 {
+		status = path[2]
+	}
+
+	if !k.ProjectDocExists(ctx, path[0]) {
+		return nil, did.ErrorInvalidDid(types.DefaultCodespace, "Invalid ProjectDid Address")
+	} else if role != "" && !types.IsValidAgentRole(role) {
+		return nil, types.ErrInvalidAgentRole(types.DefaultCodespace, role)
+	} else if status != "" && !types.IsValidAgentStatus(status) {
+		return nil, types.ErrInvalidAgentStatus(types.DefaultCodespace, status)
+	}
+
+	agents := k.GetAgents(ctx, path[0], role, status)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, agents)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+// queryProjectClaims expects a path of the form [project-did, status, agent-did]
+// where the status and agent DID are optional filters that can also be empty.
+func queryProjectClaims(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("project did not specified")
+	}
+
+	var status types.ClaimStatus
+	var agentDid did.Did
+	if len(path) > 1 {
+		status = types.ClaimStatus(path[1])
+	}
+	if len(path) > 2 {
+		agentDid = path[2]
+	}
+
+	if !k.ProjectDocExists(ctx, path[0]) {
+		return nil, did.ErrorInvalidDid(types.DefaultCodespace, "Invalid ProjectDid Address")
+	} else if status != "" && !types.IsValidClaimStatus(status) {
+		return nil, types.ErrInvalidClaimStatus(types.DefaultCodespace, status)
+	}
+
+	claims := k.GetClaims(ctx, path[0], status, agentDid)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, claims)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryProjectTargets(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	projectDoc, err := k.GetProjectDoc(ctx, path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	targets, err := projectDoc.GetTargets()
+	if err != nil {
+		return nil, err
+	}
+
+	progresses := []types.TargetProgress{}
+	for _, target := range targets {
+		progresses = append(progresses,
+			k.GetTargetProgress(ctx, projectDoc.GetProjectDid(), target.Id))
+	}
+
+	res, err2 := codec.MarshalJSONIndent(k.cdc, progresses)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", err2.Error()))
+	}
+
+	return res, nil
+}
+
+func queryProjectDocVersions(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("project did not specified")
+	} else if !k.ProjectDocExists(ctx, path[0]) {
+		return nil, sdk.ErrUnknownRequest("project does not exist")
+	}
+
+	versions := k.GetProjectDocVersions(ctx, path[0])
+
+	res, err := codec.MarshalJSONIndent(k.cdc, versions)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+// queryProjectDocVersion expects a path of the form [project-did, version].
+func queryProjectDocVersion(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) < 2 {
+		return nil, sdk.ErrUnknownRequest("project did or version not specified")
+	}
+
+	versionNumber, err := strconv.ParseUint(path[1], 10, 64)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf(
+			"version '%s' is not a valid positive integer", path[1]))
+	}
+
+	version, err2 := k.GetProjectDocVersion(ctx, path[0], versionNumber)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, version)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func querySchemas(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	schemas := k.GetSchemas(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, schemas)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func querySchema(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("schema id not specified")
+	}
+
+	schema, err := k.GetSchema(ctx, path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, schema)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryAgentStats(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("agent did not specified")
+	}
+
+	stats := k.GetAgentStats(ctx, path[0])
+
+	res, err := codec.MarshalJSONIndent(k.cdc, stats)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+// parsePageAndLimit parses the optional page (starting at 1) and limit that
+// follow the first element of the path in paginated list queries.
+func parsePageAndLimit(path []string) (page, limit uint64, err sdk.Error) {
+	page = 1
+	if len(path) > 1 && path[1] != "" {
+		var err error
+		page, err = strconv.ParseUint(path[1], 10, 64)
+		if err != nil || page == 0 {
+			return 0, 0, sdk.ErrUnknownRequest(fmt.Sprintf(
+				"page '%s' is not a valid positive integer", path[1]))
+		}
+	}
+
+	limit = DefaultQueryPageLimit
+	if len(path) > 2 && path[2] != "" {
+		var err error
+		limit, err = strconv.ParseUint(path[2], 10, 64)
+		if err != nil || limit == 0 {
+			return 0, 0, sdk.ErrUnknownRequest(fmt.Sprintf(
+				"limit '%s' is not a valid positive integer", path[2]))
+		} else if limit > MaxQueryPageLimit {
+			limit = MaxQueryPageLimit
+		}
+	}
+
+	return page, limit, nil
+}
+
+// queryRecipientWithdrawals expects a path of the form [recipient-did, page,
+// limit], where page and limit are optional.
+func queryRecipientWithdrawals(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("recipient did not specified")
+	}
+
+	page, limit, err := parsePageAndLimit(path)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := k.GetWithdrawalsByRecipient(ctx, path[0], page, limit)
+
+	res, err2 := codec.MarshalJSONIndent(k.cdc, txs)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", err2.Error()))
+	}
+
+	return res, nil
+}
+
 func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	params := k.GetParams(ctx)
 
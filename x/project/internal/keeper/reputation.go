@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/project/internal/types"
+)
+
+func (k Keeper) GetAgentStatsIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.AgentStatsKey)
+}
+
+func (k Keeper) MustGetAgentStatsByKey(ctx sdk.Context, key []byte) types.AgentStats {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("agent stats not found")
+	}
+
+	bz := store.Get(key)
+	var stats types.AgentStats
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &stats)
+
+	return stats
+}
+
+// GetAgentStats returns the statistics of an agent, which are all zero if the
+// agent has not taken part in any project yet.
+func (k Keeper) GetAgentStats(ctx sdk.Context, agentDid did.Did) types.AgentStats {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAgentStatsKey(agentDid)
+	if !store.Has(key) {
+		return types.NewAgentStats(agentDid)
+	}
+
+	return k.MustGetAgentStatsByKey(ctx, key)
+}
+
+func (k Keeper) SetAgentStats(ctx sdk.Context, stats types.AgentStats) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAgentStatsKey(stats.AgentDid)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(stats))
+}
+
+// RecordClaimSubmitted counts a new claim towards the claimer's statistics,
+// if the claimer signed the claim.
+func (k Keeper) RecordClaimSubmitted(ctx sdk.Context, claim types.Claim) {
+	if !claim.ClaimerAuthenticated {
+		return
+	}
+
+	stats := k.GetAgentStats(ctx, claim.ClaimerDid)
+	stats.ClaimsSubmitted++
+	k.SetAgentStats(ctx, stats)
+}
+
+// RecordClaimEvaluated counts an evaluated claim towards the evaluator's
+// statistics, if the evaluator signed the evaluation, and towards the
+// claimer's statistics, if both the claimer and evaluator signed.
+func (k Keeper) RecordClaimEvaluated(ctx sdk.Context, claim types.Claim) {
+	if !claim.EvaluatorAuthenticated {
+		return
+	}
+
+	if claim.ClaimerAuthenticated {
+		claimerStats := k.GetAgentStats(ctx, claim.ClaimerDid)
+		switch claim.Status {
+		case types.ApprovedClaim:
+			claimerStats.ClaimsApproved++
+		case types.RejectedClaim:
+			claimerStats.ClaimsRejected++
+		}
+		k.SetAgentStats(ctx, claimerStats)
+	}
+
+	evaluatorStats := k.GetAgentStats(ctx, claim.EvaluatorDid)
+	evaluatorStats.EvaluationsPerformed++
+	k.SetAgentStats(ctx, evaluatorStats)
+}
+
+// RecordDisputeLost counts a dispute resolved against the agent.
+func (k Keeper) RecordDisputeLost(ctx sdk.Context, agentDid did.Did) {
+	stats := k.GetAgentStats(ctx, agentDid)
+	stats.DisputesLost++
+	k.SetAgentStats(ctx, stats)
+}
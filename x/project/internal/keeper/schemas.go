@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/project/internal/types"
+)
+
+func (k Keeper) GetSchemaIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.SchemaKey)
+}
+
+func (k Keeper) MustGetSchemaByKey(ctx sdk.Context, key []byte) types.ProjectSchema {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("project schema not found")
+	}
+
+	bz := store.Get(key)
+	var schema types.ProjectSchema
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &schema)
+
+	return schema
+}
+
+func (k Keeper) SchemaExists(ctx sdk.Context, schemaId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetSchemaKey(schemaId))
+}
+
+func (k Keeper) GetSchema(ctx sdk.Context, schemaId string) (types.ProjectSchema, sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetSchemaKey(schemaId)
+	if !store.Has(key) {
+		return types.ProjectSchema{}, types.ErrSchemaNotFound(types.DefaultCodespace, schemaId)
+	}
+
+	return k.MustGetSchemaByKey(ctx, key), nil
+}
+
+func (k Keeper) GetSchemas(ctx sdk.Context) []types.ProjectSchema {
+	iterator := k.GetSchemaIterator(ctx)
+	defer iterator.Close()
+
+	schemas := []types.ProjectSchema{}
+	for ; iterator.Valid(); iterator.Next() {
+		schemas = append(schemas, k.MustGetSchemaByKey(ctx, iterator.Key()))
+	}
+
+	return schemas
+}
+
+func (k Keeper) SetSchema(ctx sdk.Context, schema types.ProjectSchema) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetSchemaKey(schema.Id)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(schema))
+}
+
+// ValidateProjectDataSchema checks that the project data conforms to the
+// schema that it declares in its "schemaId" field, if any.
+func (k Keeper) ValidateProjectDataSchema(ctx sdk.Context, data []byte) sdk.Error {
+	schemaId, err := types.GetSchemaIdFromData(data)
+	if err != nil {
+		return err
+	} else if schemaId == "" {
+		return nil
+	}
+
+	schema, err := k.GetSchema(ctx, schemaId)
+	if err != nil {
+		return err
+	}
+
+	return schema.ValidateProjectData(data)
+}
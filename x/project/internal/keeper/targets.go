@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/project/internal/types"
+)
+
+func (k Keeper) GetTargetProgressIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.TargetKey)
+}
+
+func (k Keeper) MustGetTargetProgressByKey(ctx sdk.Context, key []byte) types.TargetProgress {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("target progress not found")
+	}
+
+	bz := store.Get(key)
+	var progress types.TargetProgress
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &progress)
+
+	return progress
+}
+
+// GetTargetProgress returns the progress towards a project's target, which
+// is zero progress if no claims counting towards the target were evaluated.
+func (k Keeper) GetTargetProgress(ctx sdk.Context, projectDid did.Did, targetId string) types.TargetProgress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTargetProgressKey(projectDid, targetId))
+	if bz == nil {
+		return types.NewTargetProgress(projectDid, targetId)
+	}
+
+	var progress types.TargetProgress
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &progress)
+
+	return progress
+}
+
+func (k Keeper) SetTargetProgress(ctx sdk.Context, progress types.TargetProgress) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetTargetProgressKey(progress.ProjectDid, progress.TargetId)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(progress))
+}
+
+// AddEvaluationToTargets counts an evaluated claim towards each of the targets
+// that it is relevant to, and returns the targets that have just been reached.
+func (k Keeper) AddEvaluationToTargets(ctx sdk.Context, projectDid did.Did,
+	targets types.Targets, claim types.Claim) (reached types.Targets) {
+	for _, target := range targets {
+		if !target.CountsClaim(claim) {
+			continue
+		}
+
+		progress := k.GetTargetProgress(ctx, projectDid, target.Id)
+		if progress.AddEvaluation(ctx, target, claim) {
+			reached = append(reached, target)
+		}
+		k.SetTargetProgress(ctx, progress)
+	}
+	return reached
+}
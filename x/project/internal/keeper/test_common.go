@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmDB "github.com/tendermint/tm-db"
@@ -24,6 +25,7 @@ func CreateTestInput() (sdk.Context, Keeper, *codec.Codec,
 	tkeyParams := sdk.NewTransientStoreKey("transient_params")
 	keyPayments := sdk.NewKVStoreKey(payments.StoreKey)
 	keyDid := sdk.NewKVStoreKey(did.StoreKey)
+	keyOracles := sdk.NewKVStoreKey(oracles.StoreKey)
 
 	db := tmDB.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -33,6 +35,7 @@ func CreateTestInput() (sdk.Context, Keeper, *codec.Codec,
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeIAVL, nil)
 	ms.MountStoreWithDB(keyPayments, sdk.StoreTypeIAVL, nil)
 	ms.MountStoreWithDB(keyDid, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyOracles, sdk.StoreTypeIAVL, nil)
 	_ = ms.LoadLatestVersion()
 
 	ctx := sdk.NewContext(ms, abci.Header{}, true, log.NewNopLogger())
@@ -47,11 +50,13 @@ func CreateTestInput() (sdk.Context, Keeper, *codec.Codec,
 	projectSubspace := pk1.Subspace(types.DefaultParamspace)
 
 	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk1.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, nil)
-	didKeeper := did.NewKeeper(cdc, keyDid)
-	paymentsKeeper := payments.NewKeeper(cdc, keyPayments, paymentsSubspace, bankKeeper, didKeeper, nil)
+	didKeeper := did.NewKeeper(cdc, keyDid, pk1.Subspace(did.DefaultParamspace))
+	oraclesKeeper := oracles.NewKeeper(cdc, keyOracles, pk1.Subspace(oracles.DefaultParamspace))
+	paymentsKeeper := payments.NewKeeper(cdc, keyPayments, paymentsSubspace, bankKeeper, didKeeper, oraclesKeeper, nil)
 	keeper := NewKeeper(cdc, storeKey, projectSubspace, accountKeeper, didKeeper, paymentsKeeper)
 
 	paymentsKeeper.SetParams(ctx, payments.DefaultParams())
+	keeper.SetParams(ctx, types.DefaultParams())
 
 	return ctx, keeper, cdc, paymentsKeeper, bankKeeper
 }
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/project/internal/types"
+)
+
+func (k Keeper) GetProjectDocVersionIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.ProjectDocVersionKey)
+}
+
+func (k Keeper) MustGetProjectDocVersionByKey(ctx sdk.Context, key []byte) types.ProjectDocVersion {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("project doc version not found")
+	}
+
+	bz := store.Get(key)
+	var version types.ProjectDocVersion
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &version)
+
+	return version
+}
+
+// GetLatestProjectDocVersion returns the number of the latest version of a
+// project's data, which is zero if no versions have been recorded.
+func (k Keeper) GetLatestProjectDocVersion(ctx sdk.Context, projectDid did.Did) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store,
+		types.GetProjectDocVersionsPrefixKey(projectDid))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+	return k.MustGetProjectDocVersionByKey(ctx, iterator.Key()).Version
+}
+
+func (k Keeper) GetProjectDocVersion(ctx sdk.Context, projectDid did.Did,
+	version uint64) (types.ProjectDocVersion, sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetProjectDocVersionKey(projectDid, version)
+	if !store.Has(key) {
+		return types.ProjectDocVersion{}, types.ErrProjectDocVersionNotFound(
+			types.DefaultCodespace, projectDid, version)
+	}
+
+	return k.MustGetProjectDocVersionByKey(ctx, key), nil
+}
+
+// GetProjectDocVersions returns all versions of a project's data, ordered
+// from the first to the latest version.
+func (k Keeper) GetProjectDocVersions(ctx sdk.Context, projectDid did.Did) []types.ProjectDocVersion {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store,
+		types.GetProjectDocVersionsPrefixKey(projectDid))
+	defer iterator.Close()
+
+	versions := []types.ProjectDocVersion{}
+	for ; iterator.Valid(); iterator.Next() {
+		versions = append(versions, k.MustGetProjectDocVersionByKey(ctx, iterator.Key()))
+	}
+
+	return versions
+}
+
+func (k Keeper) SetProjectDocVersion(ctx sdk.Context, version types.ProjectDocVersion) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetProjectDocVersionKey(version.ProjectDid, version.Version)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(version))
+}
+
+// AddProjectDocVersion records the data as the next version of a project's
+// data and returns the new version.
+func (k Keeper) AddProjectDocVersion(ctx sdk.Context, projectDid did.Did,
+	data json.RawMessage, txHash string) types.ProjectDocVersion {
+	version := types.NewProjectDocVersion(ctx, projectDid,
+		k.GetLatestProjectDocVersion(ctx, projectDid)+1, data, txHash)
+	k.SetProjectDocVersion(ctx, version)
+	return version
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+)
+
+type AgentRole = string
+
+const (
+	ServiceAgent    AgentRole = "SA"
+	EvaluationAgent AgentRole = "EA"
+	InvestmentAgent AgentRole = "IA"
+)
+
+func IsValidAgentRole(role AgentRole) bool {
+	return role == ServiceAgent || role == EvaluationAgent || role == InvestmentAgent
+}
+
+func IsValidAgentStatus(status AgentStatus) bool {
+	return status == PendingAgent || status == ApprovedAgent || status == RevokedAgent
+}
+
+// Agents start off as pending and can then be approved or revoked by the
+// project. Revoked agents can be approved again, or created again as pending.
+var AgentStatusTransitions = map[AgentStatus][]AgentStatus{
+	PendingAgent:  {ApprovedAgent, RevokedAgent},
+	ApprovedAgent: {RevokedAgent},
+	RevokedAgent:  {ApprovedAgent},
+}
+
+func IsValidAgentStatusProgression(from, to AgentStatus) bool {
+	for _, status := range AgentStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+type ProjectAgent struct {
+	ProjectDid    did.Did     `json:"project_did" yaml:"project_did"`
+	AgentDid      did.Did     `json:"agent_did" yaml:"agent_did"`
+	Role          AgentRole   `json:"role" yaml:"role"`
+	Status        AgentStatus `json:"status" yaml:"status"`
+	CreatedHeight int64       `json:"created_height" yaml:"created_height"`
+	CreatedTime   time.Time   `json:"created_time" yaml:"created_time"`
+	UpdatedHeight int64       `json:"updated_height" yaml:"updated_height"`
+	UpdatedTime   time.Time   `json:"updated_time" yaml:"updated_time"`
+}
+
+// NewProjectAgent creates a pending agent in the current block.
+func NewProjectAgent(ctx sdk.Context, projectDid, agentDid did.Did, role AgentRole) ProjectAgent {
+	return ProjectAgent{
+		ProjectDid:    projectDid,
+		AgentDid:      agentDid,
+		Role:          role,
+		Status:        PendingAgent,
+		CreatedHeight: ctx.BlockHeight(),
+		CreatedTime:   ctx.BlockTime(),
+		UpdatedHeight: ctx.BlockHeight(),
+		UpdatedTime:   ctx.BlockTime(),
+	}
+}
+
+// Update sets the agent's status (and role, if not empty) and records the
+// current block as the time of the update.
+func (a *ProjectAgent) Update(ctx sdk.Context, status AgentStatus, role AgentRole) {
+	a.Status = status
+	if role != "" {
+		a.Role = role
+	}
+	a.UpdatedHeight = ctx.BlockHeight()
+	a.UpdatedTime = ctx.BlockTime()
+}
+
+// Matches indicates whether the agent has the specified role and status,
+// where an empty role or status matches any role or status.
+func (a ProjectAgent) Matches(role AgentRole, status AgentStatus) bool {
+	return (role == "" || a.Role == role) && (status == "" || a.Status == status)
+}
+
+func (a ProjectAgent) Validate() sdk.Error {
+	if !did.IsValidDid(a.ProjectDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "project did is invalid")
+	} else if !did.IsValidDid(a.AgentDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "agent did is invalid")
+	} else if !IsValidAgentRole(a.Role) {
+		return ErrInvalidAgentRole(DefaultCodespace, a.Role)
+	} else if !IsValidAgentStatus(a.Status) {
+		return ErrInvalidAgentStatus(DefaultCodespace, a.Status)
+	}
+
+	return nil
+}
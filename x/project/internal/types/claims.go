@@ -0,0 +1,106 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+)
+
+func IsValidClaimStatus(status ClaimStatus) bool {
+	return status == PendingClaim || status == ApprovedClaim || status == RejectedClaim
+}
+
+// IsValidEvaluationStatus indicates whether a claim can be evaluated to the
+// status, i.e. whether it is one of the final (approved/rejected) statuses.
+func IsValidEvaluationStatus(status ClaimStatus) bool {
+	return status == ApprovedClaim || status == RejectedClaim
+}
+
+type Claim struct {
+	ProjectDid      did.Did     `json:"project_did" yaml:"project_did"`
+	ClaimId         string      `json:"claim_id" yaml:"claim_id"`
+	TemplateId      string      `json:"template_id" yaml:"template_id"`
+	ClaimerDid      did.Did     `json:"claimer_did" yaml:"claimer_did"`
+	Status          ClaimStatus `json:"status" yaml:"status"`
+	EvaluatorDid    did.Did     `json:"evaluator_did" yaml:"evaluator_did"`
+	CreatedHeight   int64       `json:"created_height" yaml:"created_height"`
+	CreatedTime     time.Time   `json:"created_time" yaml:"created_time"`
+	EvaluatedHeight int64       `json:"evaluated_height" yaml:"evaluated_height"`
+	EvaluatedTime   time.Time   `json:"evaluated_time" yaml:"evaluated_time"`
+	// Whether the claimer and evaluator signed the claim and evaluation, i.e.
+	// whether these can be attributed to them in the agents' statistics
+	ClaimerAuthenticated   bool `json:"claimer_authenticated,omitempty" yaml:"claimer_authenticated"`
+	EvaluatorAuthenticated bool `json:"evaluator_authenticated,omitempty" yaml:"evaluator_authenticated"`
+	// DisputeLoserDid is the claimer or evaluator that lost a dispute over
+	// the claim's evaluation, which can only be disputed once
+	DisputeLoserDid did.Did `json:"dispute_loser_did,omitempty" yaml:"dispute_loser_did"`
+}
+
+// NewClaim creates a pending claim in the current block.
+func NewClaim(ctx sdk.Context, projectDid did.Did, claimId, templateId string,
+	claimerDid did.Did) Claim {
+	return Claim{
+		ProjectDid:    projectDid,
+		ClaimId:       claimId,
+		TemplateId:    templateId,
+		ClaimerDid:    claimerDid,
+		Status:        PendingClaim,
+		CreatedHeight: ctx.BlockHeight(),
+		CreatedTime:   ctx.BlockTime(),
+	}
+}
+
+func (c Claim) IsEvaluated() bool {
+	return c.Status != PendingClaim
+}
+
+// Evaluate sets the claim's final status and records the evaluator and the
+// current block as the time of the evaluation.
+func (c *Claim) Evaluate(ctx sdk.Context, evaluatorDid did.Did, status ClaimStatus) {
+	c.Status = status
+	c.EvaluatorDid = evaluatorDid
+	c.EvaluatedHeight = ctx.BlockHeight()
+	c.EvaluatedTime = ctx.BlockTime()
+}
+
+func (c Claim) IsDisputeResolved() bool {
+	return c.DisputeLoserDid != ""
+}
+
+// ResolveDispute records the outcome of a dispute over the claim's evaluation
+// and returns the DID of the agent that lost the dispute, together with
+// whether that agent is authenticated. The claim's status is not changed,
+// given that the evaluation has already counted towards the targets.
+func (c *Claim) ResolveDispute(evaluationUpheld bool) (loserDid did.Did, authenticated bool) {
+	if evaluationUpheld {
+		c.DisputeLoserDid = c.ClaimerDid
+		return c.ClaimerDid, c.ClaimerAuthenticated
+	}
+	c.DisputeLoserDid = c.EvaluatorDid
+	return c.EvaluatorDid, c.EvaluatorAuthenticated
+}
+
+// Matches indicates whether the claim has the specified status and was either
+// submitted or evaluated by the specified agent, where an empty status or
+// agent DID matches any status or agent.
+func (c Claim) Matches(status ClaimStatus, agentDid did.Did) bool {
+	return (status == "" || c.Status == status) &&
+		(agentDid == "" || c.ClaimerDid == agentDid || c.EvaluatorDid == agentDid)
+}
+
+func (c Claim) Validate() sdk.Error {
+	if !did.IsValidDid(c.ProjectDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "project did is invalid")
+	} else if !did.IsValidDid(c.ClaimerDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "claimer did is invalid")
+	} else if c.IsEvaluated() && !did.IsValidDid(c.EvaluatorDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "evaluator did is invalid")
+	} else if valid, err := CheckNotEmpty(c.ClaimId, "ClaimId"); !valid {
+		return err
+	} else if !IsValidClaimStatus(c.Status) {
+		return ErrInvalidClaimStatus(DefaultCodespace, c.Status)
+	}
+
+	return nil
+}
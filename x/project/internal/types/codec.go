@@ -12,6 +12,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateClaim{}, "project/CreateClaim", nil)
 	cdc.RegisterConcrete(MsgCreateEvaluation{}, "project/CreateEvaluation", nil)
 	cdc.RegisterConcrete(MsgWithdrawFunds{}, "project/WithdrawFunds", nil)
+	cdc.RegisterConcrete(MsgUpdateProjectDoc{}, "project/UpdateProjectDoc", nil)
+	cdc.RegisterConcrete(MsgRegisterSchema{}, "project/RegisterSchema", nil)
+	cdc.RegisterConcrete(MsgResolveClaimDispute{}, "project/ResolveClaimDispute", nil)
 
 	cdc.RegisterInterface((*StoredProjectDoc)(nil), nil)
 	cdc.RegisterConcrete(ProjectDoc{}, "project/ProjectDoc", nil)
@@ -1,9 +1,153 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
 )
 
 const (
 	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeAgentAlreadyExists         sdk.CodeType = 501
+	CodeAgentNotFound              sdk.CodeType = 502
+	CodeInvalidAgentRole           sdk.CodeType = 503
+	CodeInvalidAgentStatus         sdk.CodeType = 504
+	CodeInvalidAgentStatusProgress sdk.CodeType = 505
+	CodeAgentNotApproved           sdk.CodeType = 506
+	CodeClaimAlreadyExists         sdk.CodeType = 507
+	CodeClaimNotFound              sdk.CodeType = 508
+	CodeClaimAlreadyEvaluated      sdk.CodeType = 509
+	CodeInvalidClaimStatus         sdk.CodeType = 510
+	CodeInvalidTarget              sdk.CodeType = 511
+	CodeInvalidProjectFunding      sdk.CodeType = 512
+	CodeInvalidFundingDenom        sdk.CodeType = 513
+	CodeStatusGuardFailed          sdk.CodeType = 514
+	CodeInvalidProjectStatus       sdk.CodeType = 515
+	CodeInvalidDataUpdateMode      sdk.CodeType = 516
+	CodeLockedProjectDataField     sdk.CodeType = 517
+	CodeDocVersionNotFound         sdk.CodeType = 518
+	CodeInvalidSchema              sdk.CodeType = 519
+	CodeSchemaAlreadyExists        sdk.CodeType = 520
+	CodeSchemaNotFound             sdk.CodeType = 521
+	CodeDataDoesNotMatchSchema     sdk.CodeType = 522
+	CodeClaimNotDisputable         sdk.CodeType = 523
 )
+
+func ErrAgentAlreadyExists(codespace sdk.CodespaceType, projectDid, agentDid did.Did) sdk.Error {
+	errMsg := fmt.Sprintf("agent %s already exists in project %s", agentDid, projectDid)
+	return sdk.NewError(codespace, CodeAgentAlreadyExists, errMsg)
+}
+
+func ErrAgentNotFound(codespace sdk.CodespaceType, projectDid, agentDid did.Did) sdk.Error {
+	errMsg := fmt.Sprintf("agent %s not found in project %s", agentDid, projectDid)
+	return sdk.NewError(codespace, CodeAgentNotFound, errMsg)
+}
+
+func ErrInvalidAgentRole(codespace sdk.CodespaceType, role AgentRole) sdk.Error {
+	errMsg := fmt.Sprintf("invalid agent role '%s'; must be one of '%s', '%s' or '%s'",
+		role, ServiceAgent, EvaluationAgent, InvestmentAgent)
+	return sdk.NewError(codespace, CodeInvalidAgentRole, errMsg)
+}
+
+func ErrInvalidAgentStatus(codespace sdk.CodespaceType, status AgentStatus) sdk.Error {
+	errMsg := fmt.Sprintf("invalid agent status '%s'; must be one of '%s' (Pending), "+
+		"'%s' (Approved) or '%s' (Revoked)", status, PendingAgent, ApprovedAgent, RevokedAgent)
+	return sdk.NewError(codespace, CodeInvalidAgentStatus, errMsg)
+}
+
+func ErrInvalidAgentStatusProgression(codespace sdk.CodespaceType, from, to AgentStatus) sdk.Error {
+	errMsg := fmt.Sprintf("agent status cannot progress from '%s' to '%s'", from, to)
+	return sdk.NewError(codespace, CodeInvalidAgentStatusProgress, errMsg)
+}
+
+func ErrAgentNotApproved(codespace sdk.CodespaceType, projectDid, agentDid did.Did, role AgentRole) sdk.Error {
+	errMsg := fmt.Sprintf("%s is not an approved '%s' agent of project %s", agentDid, role, projectDid)
+	return sdk.NewError(codespace, CodeAgentNotApproved, errMsg)
+}
+
+func ErrClaimAlreadyExists(codespace sdk.CodespaceType, projectDid did.Did, claimId string) sdk.Error {
+	errMsg := fmt.Sprintf("claim %s already exists in project %s", claimId, projectDid)
+	return sdk.NewError(codespace, CodeClaimAlreadyExists, errMsg)
+}
+
+func ErrClaimNotFound(codespace sdk.CodespaceType, projectDid did.Did, claimId string) sdk.Error {
+	errMsg := fmt.Sprintf("claim %s not found in project %s", claimId, projectDid)
+	return sdk.NewError(codespace, CodeClaimNotFound, errMsg)
+}
+
+func ErrClaimAlreadyEvaluated(codespace sdk.CodespaceType, projectDid did.Did, claimId string) sdk.Error {
+	errMsg := fmt.Sprintf("claim %s in project %s has already been evaluated", claimId, projectDid)
+	return sdk.NewError(codespace, CodeClaimAlreadyEvaluated, errMsg)
+}
+
+func ErrInvalidClaimStatus(codespace sdk.CodespaceType, status ClaimStatus) sdk.Error {
+	errMsg := fmt.Sprintf("invalid claim status '%s'", status)
+	return sdk.NewError(codespace, CodeInvalidClaimStatus, errMsg)
+}
+
+func ErrInvalidTarget(codespace sdk.CodespaceType, msg string) sdk.Error {
+	errMsg := fmt.Sprintf("invalid project target; %s", msg)
+	return sdk.NewError(codespace, CodeInvalidTarget, errMsg)
+}
+
+func ErrInvalidProjectFunding(codespace sdk.CodespaceType, msg string) sdk.Error {
+	errMsg := fmt.Sprintf("invalid project funding; %s", msg)
+	return sdk.NewError(codespace, CodeInvalidProjectFunding, errMsg)
+}
+
+func ErrInvalidFundingDenom(codespace sdk.CodespaceType, denom string) sdk.Error {
+	errMsg := fmt.Sprintf("denom %s is not one of the project's denoms", denom)
+	return sdk.NewError(codespace, CodeInvalidFundingDenom, errMsg)
+}
+
+func ErrStatusGuardFailed(codespace sdk.CodespaceType, status ProjectStatus, guard StatusGuard, reason string) sdk.Error {
+	errMsg := fmt.Sprintf("project cannot be moved to status %s; guard %s failed: %s", status, guard, reason)
+	return sdk.NewError(codespace, CodeStatusGuardFailed, errMsg)
+}
+
+func ErrInvalidProjectStatus(codespace sdk.CodespaceType, status ProjectStatus) sdk.Error {
+	errMsg := fmt.Sprintf("action not allowed while project status is '%s'", status)
+	return sdk.NewError(codespace, CodeInvalidProjectStatus, errMsg)
+}
+
+func ErrInvalidProjectDataUpdateMode(codespace sdk.CodespaceType, mode ProjectDataUpdateMode) sdk.Error {
+	errMsg := fmt.Sprintf("invalid project data update mode '%s'", mode)
+	return sdk.NewError(codespace, CodeInvalidDataUpdateMode, errMsg)
+}
+
+func ErrLockedProjectDataField(codespace sdk.CodespaceType, field string) sdk.Error {
+	errMsg := fmt.Sprintf("project data field '%s' cannot be changed once the project has started", field)
+	return sdk.NewError(codespace, CodeLockedProjectDataField, errMsg)
+}
+
+func ErrProjectDocVersionNotFound(codespace sdk.CodespaceType, projectDid did.Did, version uint64) sdk.Error {
+	errMsg := fmt.Sprintf("version %d of project %s not found", version, projectDid)
+	return sdk.NewError(codespace, CodeDocVersionNotFound, errMsg)
+}
+
+func ErrInvalidSchema(codespace sdk.CodespaceType, msg string) sdk.Error {
+	errMsg := fmt.Sprintf("invalid project schema; %s", msg)
+	return sdk.NewError(codespace, CodeInvalidSchema, errMsg)
+}
+
+func ErrSchemaAlreadyExists(codespace sdk.CodespaceType, schemaId string) sdk.Error {
+	errMsg := fmt.Sprintf("project schema %s already exists", schemaId)
+	return sdk.NewError(codespace, CodeSchemaAlreadyExists, errMsg)
+}
+
+func ErrSchemaNotFound(codespace sdk.CodespaceType, schemaId string) sdk.Error {
+	errMsg := fmt.Sprintf("project schema %s not found", schemaId)
+	return sdk.NewError(codespace, CodeSchemaNotFound, errMsg)
+}
+
+func ErrProjectDataDoesNotMatchSchema(codespace sdk.CodespaceType, schemaId, reason string) sdk.Error {
+	errMsg := fmt.Sprintf("project data does not conform to schema %s; %s", schemaId, reason)
+	return sdk.NewError(codespace, CodeDataDoesNotMatchSchema, errMsg)
+}
+
+func ErrClaimNotDisputable(codespace sdk.CodespaceType, projectDid did.Did, claimId, reason string) sdk.Error {
+	errMsg := fmt.Sprintf("claim %s in project %s cannot be disputed: %s", claimId, projectDid, reason)
+	return sdk.NewError(codespace, CodeClaimNotDisputable, errMsg)
+}
@@ -0,0 +1,19 @@
+package types
+
+const (
+	EventTypeTargetReached    = "target_reached"
+	EventTypeUpdateProjectDoc = "update_project_doc"
+	EventTypeRegisterSchema   = "register_schema"
+
+	AttributeKeyProjectDid  = "project_did"
+	AttributeKeyTargetId    = "target_id"
+	AttributeKeyApproved    = "approved"
+	AttributeKeyRejected    = "rejected"
+	AttributeKeyAction      = "action"
+	AttributeKeyActionError = "action_error"
+	AttributeKeyVersion     = "version"
+	AttributeKeyMode        = "mode"
+	AttributeKeySchemaId    = "schema_id"
+
+	AttributeValueCategory = ModuleName
+)
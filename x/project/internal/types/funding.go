@@ -0,0 +1,149 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/ixo"
+)
+
+// ProjectFunding holds the denominations that a project is funded and pays
+// out in, as declared in the project data. The first funding denom is the
+// project's primary denom, in which the minimum initial funding is checked
+// and in which fees are taken if FeesInFundingDenom is set. Otherwise fees
+// are taken in IXO.
+type ProjectFunding struct {
+	FundingDenoms      []string `json:"fundingDenoms" yaml:"fundingDenoms"`
+	FeesInFundingDenom bool     `json:"feesInFundingDenom" yaml:"feesInFundingDenom"`
+}
+
+// DefaultProjectFunding is the funding of projects that do not declare any
+// funding denoms, which are funded and pay out in IXO only.
+func DefaultProjectFunding() ProjectFunding {
+	return ProjectFunding{
+		FundingDenoms:      []string{ixo.IxoNativeToken},
+		FeesInFundingDenom: false,
+	}
+}
+
+func (f ProjectFunding) PrimaryDenom() string {
+	return f.FundingDenoms[0]
+}
+
+func (f ProjectFunding) FeeDenom() string {
+	if f.FeesInFundingDenom {
+		return f.PrimaryDenom()
+	}
+	return ixo.IxoNativeToken
+}
+
+func (f ProjectFunding) IsFundingDenom(denom string) bool {
+	for _, d := range f.FundingDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPayoutDenom returns true if funds in the denom can be withdrawn from the
+// project's accounts, which is the case for funding denoms and the fee denom.
+func (f ProjectFunding) IsPayoutDenom(denom string) bool {
+	return f.IsFundingDenom(denom) || denom == f.FeeDenom()
+}
+
+func (f ProjectFunding) Validate() sdk.Error {
+	if len(f.FundingDenoms) == 0 {
+		return ErrInvalidProjectFunding(DefaultCodespace, "no funding denoms specified")
+	}
+
+	seen := make(map[string]bool)
+	for _, denom := range f.FundingDenoms {
+		if err := CheckCoinDenom(denom); err != nil {
+			return ErrInvalidProjectFunding(DefaultCodespace, err.Error())
+		} else if seen[denom] {
+			return ErrInvalidProjectFunding(DefaultCodespace, "duplicate funding denom "+denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// GetProjectFundingFromDataMap returns the funding denoms and fee setting in
+// the "fundingDenoms" and "feesInFundingDenom" fields of the project data
+// after validating them. Missing fields take their default values.
+func GetProjectFundingFromDataMap(dataMap ProjectDataMap) (ProjectFunding, sdk.Error) {
+	funding := DefaultProjectFunding()
+
+	if denomsBz, found := dataMap["fundingDenoms"]; found {
+		if err := json.Unmarshal(denomsBz, &funding.FundingDenoms); err != nil {
+			return ProjectFunding{}, ErrInvalidProjectFunding(DefaultCodespace, err.Error())
+		}
+	}
+
+	if feesBz, found := dataMap["feesInFundingDenom"]; found {
+		if err := json.Unmarshal(feesBz, &funding.FeesInFundingDenom); err != nil {
+			return ProjectFunding{}, ErrInvalidProjectFunding(DefaultCodespace, err.Error())
+		}
+	}
+
+	if err := funding.Validate(); err != nil {
+		return ProjectFunding{}, err
+	}
+
+	return funding, nil
+}
+
+// GetEvaluatorPayFromDataMap returns the pay per evaluated claim from the
+// "evaluatorPayPerClaim" field of the project data. The pay is either a coin
+// (e.g. "100xusd") in one of the project's funding denoms, or a non-negative
+// integer number of IXO, which is converted to the IXO native token and is
+// therefore only valid if IXO is a funding denom. A zero integer pay is taken
+// to be in the project's primary denom.
+func GetEvaluatorPayFromDataMap(dataMap ProjectDataMap, funding ProjectFunding) (sdk.Coin, sdk.Error) {
+	evaluatorPayPerClaimBz, found := dataMap["evaluatorPayPerClaim"]
+	if !found {
+		return sdk.Coin{}, sdk.ErrInternal("missing evaluatorPayPerClaim in project doc")
+	}
+
+	var evaluatorPayPerClaimStr string
+	err := json.Unmarshal(evaluatorPayPerClaimBz, &evaluatorPayPerClaimStr)
+	if err != nil {
+		return sdk.Coin{}, sdk.ErrInternal(err.Error())
+	}
+
+	var pay sdk.Coin
+	if ixoAmount, err := strconv.ParseInt(evaluatorPayPerClaimStr, 10, 64); err == nil {
+		amount := sdk.NewDec(ixoAmount).Mul(ixo.IxoDecimals).RoundInt()
+		if amount.IsNegative() {
+			return sdk.Coin{}, sdk.ErrInternal(
+				"evaluatorPayPerClaim cannot be negative")
+		} else if amount.IsZero() {
+			pay = sdk.NewCoin(funding.PrimaryDenom(), amount)
+		} else {
+			pay = sdk.NewCoin(ixo.IxoNativeToken, amount)
+		}
+	} else if pay, err = sdk.ParseCoin(evaluatorPayPerClaimStr); err != nil {
+		return sdk.Coin{}, sdk.ErrInternal(
+			"evaluatorPayPerClaim should be an integer or a coin")
+	}
+
+	if !funding.IsFundingDenom(pay.Denom) {
+		return sdk.Coin{}, ErrInvalidFundingDenom(DefaultCodespace, pay.Denom)
+	}
+
+	return pay, nil
+}
+
+// CheckCoinDenom returns an error if the denom is not a valid coin denom.
+func CheckCoinDenom(denom string) sdk.Error {
+	coin, err := sdk.ParseCoin("0" + denom)
+	if err != nil {
+		return sdk.ErrInvalidCoins(err.Error())
+	} else if denom != coin.Denom {
+		return sdk.ErrInvalidCoins("invalid denom " + denom)
+	}
+	return nil
+}
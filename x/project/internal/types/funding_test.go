@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ixofoundation/ixo-blockchain/x/ixo"
+)
+
+func TestEvaluatorPayFromDataMap(t *testing.T) {
+	tests := []struct {
+		data  string
+		pay   sdk.Coin
+		valid bool
+	}{
+		{`{"evaluatorPayPerClaim":"5"}`, sdk.NewCoin(ixo.IxoNativeToken, sdk.NewInt(500000000)), true},
+		{`{"evaluatorPayPerClaim":"0"}`, sdk.NewCoin(ixo.IxoNativeToken, sdk.ZeroInt()), true},
+		{`{"evaluatorPayPerClaim":"-5"}`, sdk.Coin{}, false},
+		{`{"evaluatorPayPerClaim":"-5uixo"}`, sdk.Coin{}, false},
+		{`{"evaluatorPayPerClaim":"5.5"}`, sdk.Coin{}, false},
+		{`{"evaluatorPayPerClaim":5}`, sdk.Coin{}, false},
+		{`{}`, sdk.Coin{}, false},
+
+		// Pay has to be in one of the funding denoms, including integer pay,
+		// which is in IXO unless it is zero
+		{`{"fundingDenoms":["xusd"],"evaluatorPayPerClaim":"10xusd"}`, sdk.NewInt64Coin("xusd", 10), true},
+		{`{"fundingDenoms":["xusd"],"evaluatorPayPerClaim":"10uixo"}`, sdk.Coin{}, false},
+		{`{"fundingDenoms":["xusd"],"evaluatorPayPerClaim":"10"}`, sdk.Coin{}, false},
+		{`{"fundingDenoms":["xusd"],"evaluatorPayPerClaim":"0"}`, sdk.NewInt64Coin("xusd", 0), true},
+		{`{"fundingDenoms":["xusd"],"evaluatorPayPerClaim":"0uixo"}`, sdk.Coin{}, false},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.valid, ValidateProjectData(json.RawMessage(tc.data)) == nil, tc.data)
+
+		var dataMap ProjectDataMap
+		require.Nil(t, json.Unmarshal([]byte(tc.data), &dataMap))
+		funding, err := GetProjectFundingFromDataMap(dataMap)
+		require.Nil(t, err)
+		pay, err := GetEvaluatorPayFromDataMap(dataMap, funding)
+		if tc.valid {
+			require.Nil(t, err, tc.data)
+			require.Equal(t, tc.pay, pay, tc.data)
+		} else {
+			require.NotNil(t, err, tc.data)
+		}
+	}
+}
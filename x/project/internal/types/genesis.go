@@ -4,15 +4,30 @@ type GenesisState struct {
 	ProjectDocs      []ProjectDoc        `json:"project_docs" yaml:"project_docs"`
 	AccountMaps      []GenesisAccountMap `json:"account_maps" yaml:"account_maps"`
 	WithdrawalsInfos [][]WithdrawalInfo  `json:"withdrawal_infos" yaml:"withdrawal_infos"`
+	Agents           []ProjectAgent      `json:"agents" yaml:"agents"`
+	Claims           []Claim             `json:"claims" yaml:"claims"`
+	TargetProgresses []TargetProgress    `json:"target_progresses" yaml:"target_progresses"`
+	DocVersions      []ProjectDocVersion `json:"doc_versions" yaml:"doc_versions"`
+	Schemas          []ProjectSchema     `json:"schemas" yaml:"schemas"`
+	AgentStats       []AgentStats        `json:"agent_stats" yaml:"agent_stats"`
 	Params           Params              `json:"params" yaml:"params"`
 }
 
 func NewGenesisState(projectDocs []ProjectDoc, accountMaps []GenesisAccountMap,
-	withdrawalInfos [][]WithdrawalInfo, params Params) GenesisState {
+	withdrawalInfos [][]WithdrawalInfo, agents []ProjectAgent,
+	claims []Claim, targetProgresses []TargetProgress,
+	docVersions []ProjectDocVersion, schemas []ProjectSchema,
+	agentStats []AgentStats, params Params) GenesisState {
 	return GenesisState{
 		ProjectDocs:      projectDocs,
 		AccountMaps:      accountMaps,
 		WithdrawalsInfos: withdrawalInfos,
+		Agents:           agents,
+		Claims:           claims,
+		TargetProgresses: targetProgresses,
+		DocVersions:      docVersions,
+		Schemas:          schemas,
+		AgentStats:       agentStats,
 		Params:           params,
 	}
 }
@@ -24,6 +39,42 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	for _, agent := range data.Agents {
+		if err := agent.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, claim := range data.Claims {
+		if err := claim.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, progress := range data.TargetProgresses {
+		if err := progress.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, version := range data.DocVersions {
+		if err := version.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, schema := range data.Schemas {
+		if err := schema.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, stats := range data.AgentStats {
+		if err := stats.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -32,6 +83,12 @@ func DefaultGenesisState() GenesisState {
 		ProjectDocs:      nil,
 		AccountMaps:      nil,
 		WithdrawalsInfos: nil,
+		Agents:           nil,
+		Claims:           nil,
+		TargetProgresses: nil,
+		DocVersions:      nil,
+		Schemas:          nil,
+		AgentStats:       nil,
 		Params:           DefaultParams(),
 	}
 }
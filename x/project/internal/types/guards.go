@@ -0,0 +1,97 @@
+package types
+
+import "fmt"
+
+// StatusGuard is a condition that a project has to meet to move to a status.
+type StatusGuard string
+
+const (
+	// MinimumFundingGuard requires the project account's balance in the
+	// project's primary funding denom to be at least the minimum initial
+	// funding specified in the params.
+	MinimumFundingGuard StatusGuard = "minimum_funding"
+	// ApprovedEvaluatorGuard requires at least one approved evaluation agent.
+	ApprovedEvaluatorGuard StatusGuard = "approved_evaluator"
+	// NoPendingClaimsGuard requires that all claims have been evaluated.
+	NoPendingClaimsGuard StatusGuard = "no_pending_claims"
+)
+
+func IsValidStatusGuard(guard StatusGuard) bool {
+	return guard == MinimumFundingGuard ||
+		guard == ApprovedEvaluatorGuard ||
+		guard == NoPendingClaimsGuard
+}
+
+// StatusGuards are the guards checked when a project moves to a status.
+type StatusGuards struct {
+	Status ProjectStatus `json:"status" yaml:"status"`
+	Guards []StatusGuard `json:"guards" yaml:"guards"`
+}
+
+func NewStatusGuards(status ProjectStatus, guards ...StatusGuard) StatusGuards {
+	return StatusGuards{
+		Status: status,
+		Guards: guards,
+	}
+}
+
+func (sg StatusGuards) Validate() error {
+	if !IsValidProjectStatus(sg.Status) {
+		return fmt.Errorf("invalid project status '%s'", sg.Status)
+	}
+
+	seen := make(map[StatusGuard]bool)
+	for _, guard := range sg.Guards {
+		if !IsValidStatusGuard(guard) {
+			return fmt.Errorf("invalid status guard '%s'", guard)
+		} else if seen[guard] {
+			return fmt.Errorf("duplicate status guard '%s' for status %s", guard, sg.Status)
+		}
+		seen[guard] = true
+	}
+
+	return nil
+}
+
+// StatusGuardsList holds the guards for each status that has any guards.
+type StatusGuardsList []StatusGuards
+
+// DefaultStatusGuards keeps the check that projects have reached the minimum
+// initial funding before they can be marked as FUNDED. Further guards (e.g.
+// requiring an approved evaluator before STARTED) are opt-in via the params.
+func DefaultStatusGuards() StatusGuardsList {
+	return StatusGuardsList{
+		NewStatusGuards(FundedStatus, MinimumFundingGuard),
+	}
+}
+
+// GetGuards returns the guards that are checked when moving to the status.
+func (l StatusGuardsList) GetGuards(status ProjectStatus) []StatusGuard {
+	for _, sg := range l {
+		if sg.Status == status {
+			return sg.Guards
+		}
+	}
+	return nil
+}
+
+func (l StatusGuardsList) Validate() error {
+	seen := make(map[ProjectStatus]bool)
+	for _, sg := range l {
+		if err := sg.Validate(); err != nil {
+			return err
+		} else if seen[sg.Status] {
+			return fmt.Errorf("duplicate status guards for status %s", sg.Status)
+		}
+		seen[sg.Status] = true
+	}
+	return nil
+}
+
+func (l StatusGuardsList) String() string {
+	str := ""
+	for _, sg := range l {
+		str += fmt.Sprintf("\n    %s: %v", sg.Status, sg.Guards)
+	}
+	return str
+}
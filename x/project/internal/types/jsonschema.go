@@ -0,0 +1,617 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// JsonSchema is a compiled JSON Schema. Only the subset of JSON Schema
+// (draft-07) that can be evaluated deterministically is supported, so that
+// all nodes reach the same verdict on a document. Schemas that use any other
+// validation keywords (e.g. $ref or format) are rejected when compiled.
+type JsonSchema struct {
+	types                []string
+	properties           map[string]*JsonSchema
+	required             []string
+	additionalProperties *JsonSchema
+	noAdditional         bool
+	items                *JsonSchema
+	minItems             *int
+	maxItems             *int
+	uniqueItems          bool
+	minLength            *int
+	maxLength            *int
+	pattern              *regexp.Regexp
+	patternSource        string
+	minimum              *big.Rat
+	maximum              *big.Rat
+	exclusiveMinimum     *big.Rat
+	exclusiveMaximum     *big.Rat
+	enum                 []interface{}
+	constValue           *interface{}
+	allOf                []*JsonSchema
+	anyOf                []*JsonSchema
+	oneOf                []*JsonSchema
+	not                  *JsonSchema
+	alwaysFalse          bool
+}
+
+var jsonSchemaTypes = map[string]bool{
+	"null": true, "boolean": true, "object": true, "array": true,
+	"number": true, "integer": true, "string": true,
+}
+
+// Keywords that do not affect validation and are therefore ignored.
+var jsonSchemaAnnotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "title": true,
+	"description": true, "default": true, "examples": true,
+}
+
+// CompileJsonSchema parses and compiles a JSON Schema.
+func CompileJsonSchema(bz json.RawMessage) (*JsonSchema, error) {
+	value, err := unmarshalJsonValue(bz)
+	if err != nil {
+		return nil, err
+	}
+	return compileJsonSchemaValue(value)
+}
+
+func compileJsonSchemaValue(value interface{}) (*JsonSchema, error) {
+	// Boolean schemas accept (true) or reject (false) any document
+	if b, ok := value.(bool); ok {
+		return &JsonSchema{alwaysFalse: !b}, nil
+	}
+
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("schema must be an object or a boolean")
+	}
+
+	// Keywords are compiled in sorted order so that errors are deterministic
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	s := &JsonSchema{}
+	for _, key := range keys {
+		if err := s.compileKeyword(key, obj[key]); err != nil {
+			return nil, fmt.Errorf("%s: %s", key, err.Error())
+		}
+	}
+	return s, nil
+}
+
+func (s *JsonSchema) compileKeyword(key string, value interface{}) (err error) {
+	switch key {
+	case "type":
+		switch v := value.(type) {
+		case string:
+			s.types = []string{v}
+		case []interface{}:
+			for _, t := range v {
+				str, ok := t.(string)
+				if !ok {
+					return fmt.Errorf("types must be strings")
+				}
+				s.types = append(s.types, str)
+			}
+		default:
+			return fmt.Errorf("must be a string or an array")
+		}
+		for _, t := range s.types {
+			if !jsonSchemaTypes[t] {
+				return fmt.Errorf("unknown type '%s'", t)
+			}
+		}
+	case "properties":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("must be an object")
+		}
+		s.properties = make(map[string]*JsonSchema)
+		for name, propSchema := range obj {
+			if s.properties[name], err = compileJsonSchemaValue(propSchema); err != nil {
+				return fmt.Errorf("%s: %s", name, err.Error())
+			}
+		}
+	case "required":
+		arr, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("must be an array")
+		}
+		for _, r := range arr {
+			str, ok := r.(string)
+			if !ok {
+				return fmt.Errorf("required properties must be strings")
+			}
+			s.required = append(s.required, str)
+		}
+	case "additionalProperties":
+		if b, ok := value.(bool); ok {
+			s.noAdditional = !b
+		} else if s.additionalProperties, err = compileJsonSchemaValue(value); err != nil {
+			return err
+		}
+	case "items":
+		s.items, err = compileJsonSchemaValue(value)
+	case "uniqueItems":
+		b, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("must be a boolean")
+		}
+		s.uniqueItems = b
+	case "minItems":
+		s.minItems, err = compileJsonSchemaCount(value)
+	case "maxItems":
+		s.maxItems, err = compileJsonSchemaCount(value)
+	case "minLength":
+		s.minLength, err = compileJsonSchemaCount(value)
+	case "maxLength":
+		s.maxLength, err = compileJsonSchemaCount(value)
+	case "pattern":
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("must be a string")
+		}
+		s.pattern, err = compileJsonSchemaPattern(str)
+		s.patternSource = str
+	case "minimum":
+		s.minimum, err = compileJsonSchemaNumber(value)
+	case "maximum":
+		s.maximum, err = compileJsonSchemaNumber(value)
+	case "exclusiveMinimum":
+		s.exclusiveMinimum, err = compileJsonSchemaNumber(value)
+	case "exclusiveMaximum":
+		s.exclusiveMaximum, err = compileJsonSchemaNumber(value)
+	case "enum":
+		arr, ok := value.([]interface{})
+		if !ok || len(arr) == 0 {
+			return fmt.Errorf("must be a non-empty array")
+		}
+		s.enum = arr
+	case "const":
+		s.constValue = &value
+	case "allOf":
+		s.allOf, err = compileJsonSchemaList(value)
+	case "anyOf":
+		s.anyOf, err = compileJsonSchemaList(value)
+	case "oneOf":
+		s.oneOf, err = compileJsonSchemaList(value)
+	case "not":
+		s.not, err = compileJsonSchemaValue(value)
+	default:
+		if !jsonSchemaAnnotations[key] {
+			return fmt.Errorf("keyword not supported")
+		}
+	}
+	return err
+}
+
+func compileJsonSchemaCount(value interface{}) (*int, error) {
+	n, err := compileJsonSchemaNumber(value)
+	if err != nil {
+		return nil, err
+	} else if !n.IsInt() || n.Sign() < 0 || !n.Num().IsInt64() {
+		return nil, fmt.Errorf("must be a non-negative integer")
+	}
+	count := int(n.Num().Int64())
+	return &count, nil
+}
+
+// ecmaWhitespace is the set of characters matched by \s in ECMA-262, as the
+// contents of an RE2 character class.
+const ecmaWhitespace = `\t\n\v\f\r \x{a0}\x{1680}\x{2000}-\x{200a}` +
+	`\x{2028}\x{2029}\x{202f}\x{205f}\x{3000}\x{feff}`
+
+// compileJsonSchemaPattern compiles a pattern, which JSON Schema defines as an
+// ECMA-262 regular expression, into an equivalent RE2 regular expression. The
+// constructs whose meaning differs between the two (\s, \S, . and \uXXXX) are
+// translated, and those that only exist in one of the two (e.g. lookarounds,
+// backreferences, flags, \A, \z, \p or POSIX classes) are rejected. Unlike
+// ECMA-262 without the u flag, strings are matched by code point rather than
+// by UTF-16 code unit.
+func compileJsonSchemaPattern(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	runes := []rune(pattern)
+	inClass := false
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		next := func(offset int) rune {
+			if i+offset < len(runes) {
+				return runes[i+offset]
+			}
+			return 0
+		}
+
+		switch {
+		case r == '\\':
+			i++
+			if i == len(runes) {
+				return nil, fmt.Errorf("trailing backslash")
+			}
+			switch e := runes[i]; e {
+			case 's':
+				if inClass {
+					b.WriteString(ecmaWhitespace)
+				} else {
+					b.WriteString("[" + ecmaWhitespace + "]")
+				}
+			case 'S':
+				if inClass {
+					return nil, fmt.Errorf("\\S is not supported in a character class")
+				}
+				b.WriteString("[^" + ecmaWhitespace + "]")
+			case 'u':
+				if i+4 >= len(runes) {
+					return nil, fmt.Errorf("invalid escape \\u")
+				}
+				hex := string(runes[i+1 : i+5])
+				if _, err := strconv.ParseUint(hex, 16, 16); err != nil {
+					return nil, fmt.Errorf("invalid escape \\u%s", hex)
+				}
+				b.WriteString(`\x{` + hex + `}`)
+				i += 4
+			case 'A', 'z', 'Q', 'E', 'p', 'P', 'C':
+				return nil, fmt.Errorf("escape \\%c is not supported", e)
+			case 'x':
+				if next(1) == '{' {
+					return nil, fmt.Errorf("escape \\x{...} is not supported")
+				}
+				b.WriteString(`\x`)
+			default:
+				if e >= '1' && e <= '9' {
+					return nil, fmt.Errorf("backreferences are not supported")
+				}
+				b.WriteRune('\\')
+				b.WriteRune(e)
+			}
+		case inClass:
+			if r == '[' && next(1) == ':' {
+				return nil, fmt.Errorf("POSIX character classes are not supported")
+			} else if r == ']' {
+				inClass = false
+			}
+			b.WriteRune(r)
+		case r == '[':
+			// An empty class ([] or [^]) has a different meaning in RE2
+			if next(1) == ']' || (next(1) == '^' && next(2) == ']') {
+				return nil, fmt.Errorf("empty character classes are not supported")
+			}
+			inClass = true
+			b.WriteRune(r)
+			if next(1) == '^' {
+				b.WriteRune('^')
+				i++
+			}
+		case r == '.':
+			// In ECMA-262, . does not match any line terminator
+			b.WriteString(`[^\n\r\x{2028}\x{2029}]`)
+		case r == '(' && next(1) == '?':
+			if next(2) != ':' {
+				return nil, fmt.Errorf("only non-capturing groups (?:...) are supported")
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	if inClass {
+		return nil, fmt.Errorf("unterminated character class")
+	}
+
+	return regexp.Compile(b.String())
+}
+
+const (
+	// maxJsonNumberDigits and maxJsonNumberExponent bound the numbers that are
+	// converted to a big.Rat, since the cost of the conversion (and of any
+	// comparisons) grows with the size of the number's value, which can be
+	// exponentially larger than the number as written (e.g. 1e1000000000).
+	maxJsonNumberDigits   = 100
+	maxJsonNumberExponent = 400
+)
+
+// parseJsonNumber converts a JSON number to a big.Rat, failing if it is not a
+// valid number or if it has more than maxJsonNumberDigits digits or an
+// exponent larger than maxJsonNumberExponent in absolute value.
+func parseJsonNumber(num json.Number) (*big.Rat, bool) {
+	str := num.String()
+	mantissa, exponent := str, ""
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		mantissa, exponent = str[:i], str[i+1:]
+	}
+
+	digits := 0
+	for _, c := range mantissa {
+		if c >= '0' && c <= '9' {
+			digits++
+		}
+	}
+	if digits > maxJsonNumberDigits {
+		return nil, false
+	}
+	if exponent != "" {
+		exp, err := strconv.ParseInt(exponent, 10, 32)
+		if err != nil || exp > maxJsonNumberExponent || exp < -maxJsonNumberExponent {
+			return nil, false
+		}
+	}
+
+	return new(big.Rat).SetString(str)
+}
+
+func compileJsonSchemaNumber(value interface{}) (*big.Rat, error) {
+	num, ok := value.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("must be a number")
+	}
+	r, ok := parseJsonNumber(num)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %s", num)
+	}
+	return r, nil
+}
+
+func compileJsonSchemaList(value interface{}) ([]*JsonSchema, error) {
+	arr, ok := value.([]interface{})
+	if !ok || len(arr) == 0 {
+		return nil, fmt.Errorf("must be a non-empty array")
+	}
+	schemas := make([]*JsonSchema, len(arr))
+	for i, v := range arr {
+		var err error
+		if schemas[i], err = compileJsonSchemaValue(v); err != nil {
+			return nil, err
+		}
+	}
+	return schemas, nil
+}
+
+// ValidateDocument returns an error describing the first violation of the
+// schema by the JSON document, if any.
+func (s *JsonSchema) ValidateDocument(bz json.RawMessage) error {
+	value, err := unmarshalJsonValue(bz)
+	if err != nil {
+		return err
+	}
+	return s.validate("", value)
+}
+
+func (s *JsonSchema) validate(path string, value interface{}) error {
+	fail := func(format string, args ...interface{}) error {
+		location := path
+		if location == "" {
+			location = "document"
+		}
+		return fmt.Errorf("%s: %s", location, fmt.Sprintf(format, args...))
+	}
+
+	if s.alwaysFalse {
+		return fail("not allowed")
+	}
+
+	if len(s.types) != 0 && !jsonValueHasAnyType(value, s.types) {
+		return fail("expected type %v", s.types)
+	}
+
+	if s.enum != nil {
+		found := false
+		for _, e := range s.enum {
+			if jsonValuesEqual(e, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fail("value not in enum")
+		}
+	}
+	if s.constValue != nil && !jsonValuesEqual(*s.constValue, value) {
+		return fail("value does not match const")
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, name := range s.required {
+			if _, found := v[name]; !found {
+				return fail("missing required property '%s'", name)
+			}
+		}
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			propPath := path + "/" + name
+			if propSchema, found := s.properties[name]; found {
+				if err := propSchema.validate(propPath, v[name]); err != nil {
+					return err
+				}
+			} else if s.noAdditional {
+				return fail("additional property '%s' not allowed", name)
+			} else if s.additionalProperties != nil {
+				if err := s.additionalProperties.validate(propPath, v[name]); err != nil {
+					return err
+				}
+			}
+		}
+	case []interface{}:
+		if s.minItems != nil && len(v) < *s.minItems {
+			return fail("expected at least %d items", *s.minItems)
+		} else if s.maxItems != nil && len(v) > *s.maxItems {
+			return fail("expected at most %d items", *s.maxItems)
+		}
+		if s.uniqueItems {
+			for i := range v {
+				for j := i + 1; j < len(v); j++ {
+					if jsonValuesEqual(v[i], v[j]) {
+						return fail("items %d and %d are not unique", i, j)
+					}
+				}
+			}
+		}
+		if s.items != nil {
+			for i, item := range v {
+				if err := s.items.validate(fmt.Sprintf("%s/%d", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+	case string:
+		length := utf8.RuneCountInString(v)
+		if s.minLength != nil && length < *s.minLength {
+			return fail("expected at least %d characters", *s.minLength)
+		} else if s.maxLength != nil && length > *s.maxLength {
+			return fail("expected at most %d characters", *s.maxLength)
+		} else if s.pattern != nil && !s.pattern.MatchString(v) {
+			return fail("does not match pattern %s", s.patternSource)
+		}
+	case json.Number:
+		if s.minimum == nil && s.maximum == nil &&
+			s.exclusiveMinimum == nil && s.exclusiveMaximum == nil {
+			break
+		}
+		n, ok := parseJsonNumber(v)
+		if !ok {
+			return fail("invalid number %s", v)
+		}
+		if s.minimum != nil && n.Cmp(s.minimum) < 0 {
+			return fail("expected at least %s", s.minimum.RatString())
+		} else if s.maximum != nil && n.Cmp(s.maximum) > 0 {
+			return fail("expected at most %s", s.maximum.RatString())
+		} else if s.exclusiveMinimum != nil && n.Cmp(s.exclusiveMinimum) <= 0 {
+			return fail("expected more than %s", s.exclusiveMinimum.RatString())
+		} else if s.exclusiveMaximum != nil && n.Cmp(s.exclusiveMaximum) >= 0 {
+			return fail("expected less than %s", s.exclusiveMaximum.RatString())
+		}
+	}
+
+	for _, sub := range s.allOf {
+		if err := sub.validate(path, value); err != nil {
+			return err
+		}
+	}
+	if s.anyOf != nil {
+		matched := false
+		for _, sub := range s.anyOf {
+			if sub.validate(path, value) == nil {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fail("does not match any of the anyOf schemas")
+		}
+	}
+	if s.oneOf != nil {
+		matches := 0
+		for _, sub := range s.oneOf {
+			if sub.validate(path, value) == nil {
+				matches++
+			}
+		}
+		if matches != 1 {
+			return fail("matches %d of the oneOf schemas instead of one", matches)
+		}
+	}
+	if s.not != nil && s.not.validate(path, value) == nil {
+		return fail("matches the schema in not")
+	}
+
+	return nil
+}
+
+func jsonValueHasAnyType(value interface{}, types []string) bool {
+	for _, t := range types {
+		switch v := value.(type) {
+		case nil:
+			if t == "null" {
+				return true
+			}
+		case bool:
+			if t == "boolean" {
+				return true
+			}
+		case map[string]interface{}:
+			if t == "object" {
+				return true
+			}
+		case []interface{}:
+			if t == "array" {
+				return true
+			}
+		case string:
+			if t == "string" {
+				return true
+			}
+		case json.Number:
+			if t == "number" {
+				return true
+			} else if t == "integer" {
+				if n, ok := parseJsonNumber(v); ok && n.IsInt() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// jsonValuesEqual compares two JSON values, treating numbers as equal if they
+// have the same value regardless of how they are written (e.g. 1 and 1.0).
+func jsonValuesEqual(a, b interface{}) bool {
+	switch av := a.(type) {
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if av == bv {
+			return true
+		}
+		ar, aok := parseJsonNumber(av)
+		br, bok := parseJsonNumber(bv)
+		return aok && bok && ar.Cmp(br) == 0
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonValuesEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for key, value := range av {
+			other, found := bv[key]
+			if !found || !jsonValuesEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// isJsonObject indicates whether the JSON document is an object.
+func isJsonObject(bz json.RawMessage) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(bz), []byte("{"))
+}
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonSchemaKeywords(t *testing.T) {
+	tests := []struct {
+		keyword  string
+		schema   string
+		document string
+		valid    bool
+	}{
+		{"boolean", `true`, `{"a":1}`, true},
+		{"boolean", `false`, `{"a":1}`, false},
+
+		{"type", `{"type":"string"}`, `"a"`, true},
+		{"type", `{"type":"string"}`, `1`, false},
+		{"type", `{"type":["null","boolean"]}`, `null`, true},
+		{"type", `{"type":["null","boolean"]}`, `false`, true},
+		{"type", `{"type":["null","boolean"]}`, `{}`, false},
+		{"type", `{"type":"integer"}`, `1.0`, true},
+		{"type", `{"type":"integer"}`, `1.5`, false},
+		{"type", `{"type":"number"}`, `1.5`, true},
+		{"type", `{"type":"array"}`, `[]`, true},
+		{"type", `{"type":"object"}`, `[]`, false},
+
+		{"properties", `{"properties":{"a":{"type":"string"}}}`, `{"a":"x"}`, true},
+		{"properties", `{"properties":{"a":{"type":"string"}}}`, `{"a":1}`, false},
+		{"properties", `{"properties":{"a":{"type":"string"}}}`, `{"b":1}`, true},
+
+		{"required", `{"required":["a"]}`, `{"a":null}`, true},
+		{"required", `{"required":["a"]}`, `{"b":1}`, false},
+		{"required", `{"required":["a"]}`, `"not an object"`, true},
+
+		{"additionalProperties", `{"properties":{"a":{}},"additionalProperties":false}`, `{"a":1}`, true},
+		{"additionalProperties", `{"properties":{"a":{}},"additionalProperties":false}`, `{"a":1,"b":2}`, false},
+		{"additionalProperties", `{"additionalProperties":{"type":"number"}}`, `{"b":2}`, true},
+		{"additionalProperties", `{"additionalProperties":{"type":"number"}}`, `{"b":"2"}`, false},
+
+		{"items", `{"items":{"type":"number"}}`, `[1,2]`, true},
+		{"items", `{"items":{"type":"number"}}`, `[1,"2"]`, false},
+		{"minItems", `{"minItems":2}`, `[1,2]`, true},
+		{"minItems", `{"minItems":2}`, `[1]`, false},
+		{"maxItems", `{"maxItems":1}`, `[1]`, true},
+		{"maxItems", `{"maxItems":1}`, `[1,2]`, false},
+		{"uniqueItems", `{"uniqueItems":true}`, `[1,2]`, true},
+		{"uniqueItems", `{"uniqueItems":true}`, `[1,1.0]`, false},
+		{"uniqueItems", `{"uniqueItems":true}`, `[{"a":[1]},{"a":[1]}]`, false},
+
+		{"minLength", `{"minLength":2}`, `"éé"`, true},
+		{"minLength", `{"minLength":2}`, `"é"`, false},
+		{"maxLength", `{"maxLength":1}`, `"é"`, true},
+		{"maxLength", `{"maxLength":1}`, `"ab"`, false},
+
+		{"pattern", `{"pattern":"^[0-9]+$"}`, `"123"`, true},
+		{"pattern", `{"pattern":"^[0-9]+$"}`, `"12a"`, false},
+		{"pattern", `{"pattern":"b"}`, `"abc"`, true},
+		{"pattern", `{"pattern":"^a.c$"}`, `"a\nc"`, false},
+		{"pattern", `{"pattern":"^a.c$"}`, `"a\u2028c"`, false},
+		{"pattern", `{"pattern":"^a.c$"}`, `"abc"`, true},
+		{"pattern", `{"pattern":"^\\s$"}`, `" "`, true},
+		{"pattern", `{"pattern":"^\\s$"}`, `"\u000b"`, true},
+		{"pattern", `{"pattern":"^[\\sa]+$"}`, `"a\u3000a"`, true},
+		{"pattern", `{"pattern":"^\\S+$"}`, `"a "`, false},
+		{"pattern", `{"pattern":"^\\u00e9$"}`, `"é"`, true},
+		{"pattern", `{"pattern":"^[^.]$"}`, `"."`, false},
+		{"pattern", `{"pattern":"^(?:ab)+$"}`, `"abab"`, true},
+
+		{"minimum", `{"minimum":1.5}`, `1.5`, true},
+		{"minimum", `{"minimum":1.5}`, `1.4`, false},
+
+		// Numbers too large to compare are rejected rather than expanded
+		{"minimum", `{"minimum":0}`, `1e400`, true},
+		{"minimum", `{"minimum":0}`, `1e1000000000`, false},
+		{"minimum", `{"minimum":0}`, `1e-1000000000`, false},
+		{"minimum", `{"minimum":0}`, `10000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000`, false},
+		{"type", `{"type":"integer"}`, `1e1000000000`, false},
+		{"type", `{"type":"number"}`, `1e1000000000`, true},
+		{"const", `{"const":1e1000000000}`, `1e1000000000`, true},
+		{"const", `{"const":1e1000000000}`, `1e999999999`, false},
+		{"maximum", `{"maximum":10}`, `10`, true},
+		{"maximum", `{"maximum":10}`, `10.000001`, false},
+		{"exclusiveMinimum", `{"exclusiveMinimum":0}`, `0.1`, true},
+		{"exclusiveMinimum", `{"exclusiveMinimum":0}`, `0`, false},
+		{"exclusiveMaximum", `{"exclusiveMaximum":1}`, `0.99`, true},
+		{"exclusiveMaximum", `{"exclusiveMaximum":1}`, `1`, false},
+
+		{"enum", `{"enum":["a",1,null]}`, `1.0`, true},
+		{"enum", `{"enum":["a",1,null]}`, `null`, true},
+		{"enum", `{"enum":["a",1,null]}`, `"b"`, false},
+		{"const", `{"const":{"a":[1,2]}}`, `{"a":[1,2]}`, true},
+		{"const", `{"const":{"a":[1,2]}}`, `{"a":[2,1]}`, false},
+
+		{"allOf", `{"allOf":[{"minimum":1},{"maximum":2}]}`, `2`, true},
+		{"allOf", `{"allOf":[{"minimum":1},{"maximum":2}]}`, `3`, false},
+		{"anyOf", `{"anyOf":[{"type":"string"},{"minimum":5}]}`, `5`, true},
+		{"anyOf", `{"anyOf":[{"type":"string"},{"minimum":5}]}`, `4`, false},
+		{"oneOf", `{"oneOf":[{"minimum":1},{"maximum":2}]}`, `3`, true},
+		{"oneOf", `{"oneOf":[{"minimum":1},{"maximum":2}]}`, `1.5`, false},
+		{"oneOf", `{"oneOf":[{"minimum":3},{"maximum":2}]}`, `2.5`, false},
+		{"not", `{"not":{"type":"null"}}`, `1`, true},
+		{"not", `{"not":{"type":"null"}}`, `null`, false},
+
+		{"annotations", `{"$schema":"x","title":"t","description":"d","default":1}`, `2`, true},
+	}
+
+	for _, tc := range tests {
+		schema, err := CompileJsonSchema(json.RawMessage(tc.schema))
+		require.Nil(t, err, "%s: %s", tc.keyword, tc.schema)
+		err = schema.ValidateDocument(json.RawMessage(tc.document))
+		if tc.valid {
+			require.Nil(t, err, "%s: %s with %s", tc.keyword, tc.schema, tc.document)
+		} else {
+			require.NotNil(t, err, "%s: %s with %s", tc.keyword, tc.schema, tc.document)
+		}
+	}
+}
+
+func TestJsonSchemaInvalidSchemas(t *testing.T) {
+	tests := []struct {
+		keyword string
+		schema  string
+	}{
+		{"schema", `"string"`},
+		{"unsupported", `{"$ref":"#/definitions/a"}`},
+		{"unsupported", `{"format":"email"}`},
+		{"type", `{"type":"float"}`},
+		{"type", `{"type":[1]}`},
+		{"properties", `{"properties":[]}`},
+		{"properties", `{"properties":{"a":{"format":"date"}}}`},
+		{"required", `{"required":[1]}`},
+		{"items", `{"items":1}`},
+		{"uniqueItems", `{"uniqueItems":"yes"}`},
+		{"minItems", `{"minItems":-1}`},
+		{"maxLength", `{"maxLength":1.5}`},
+		{"minimum", `{"minimum":"1"}`},
+		{"minimum", `{"minimum":1e401}`},
+		{"maximum", `{"maximum":1e-1000000000}`},
+		{"exclusiveMinimum", `{"exclusiveMinimum":10000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000}`},
+		{"enum", `{"enum":[]}`},
+		{"anyOf", `{"anyOf":[]}`},
+		{"not", `{"not":1}`},
+
+		// ECMA-262 constructs that RE2 does not support, and vice versa
+		{"pattern", `{"pattern":1}`},
+		{"pattern", `{"pattern":"(?=a)"}`},
+		{"pattern", `{"pattern":"(?<!a)b"}`},
+		{"pattern", `{"pattern":"(a)\\1"}`},
+		{"pattern", `{"pattern":"(?i)a"}`},
+		{"pattern", `{"pattern":"\\Aa\\z"}`},
+		{"pattern", `{"pattern":"\\pL"}`},
+		{"pattern", `{"pattern":"\\x{41}"}`},
+		{"pattern", `{"pattern":"[[:alpha:]]"}`},
+		{"pattern", `{"pattern":"[]a]"}`},
+		{"pattern", `{"pattern":"[^\\S]"}`},
+		{"pattern", `{"pattern":"\\u12"}`},
+		{"pattern", `{"pattern":"[a"}`},
+		{"pattern", `{"pattern":"a\\"}`},
+	}
+
+	for _, tc := range tests {
+		_, err := CompileJsonSchema(json.RawMessage(tc.schema))
+		require.NotNil(t, err, "%s: %s", tc.keyword, tc.schema)
+	}
+}
@@ -1,6 +1,9 @@
 package types
 
-import "github.com/ixofoundation/ixo-blockchain/x/did"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+)
 
 const (
 	ModuleName        = "project"
@@ -14,6 +17,17 @@ var (
 	ProjectKey    = []byte{0x01}
 	AccountKey    = []byte{0x02}
 	WithdrawalKey = []byte{0x03}
+	AgentKey      = []byte{0x04}
+	ClaimKey      = []byte{0x05}
+	TargetKey     = []byte{0x06}
+
+	WithdrawalSequenceKey    = []byte{0x07}
+	WithdrawalByRecipientKey = []byte{0x08}
+
+	ProjectDocVersionKey = []byte{0x09}
+
+	SchemaKey     = []byte{0x0A}
+	AgentStatsKey = []byte{0x0B}
 )
 
 func GetProjectPrefixKey(did did.Did) []byte {
@@ -27,3 +41,58 @@ func GetAccountPrefixKey(did did.Did) []byte {
 func GetWithdrawalPrefixKey(did did.Did) []byte {
 	return append(WithdrawalKey, []byte(did)...)
 }
+
+// GetAgentsPrefixKey returns the prefix of the agent keys of a project. The
+// separator prevents a project DID that is a prefix of another from matching.
+func GetAgentsPrefixKey(projectDid did.Did) []byte {
+	return append(append(AgentKey, []byte(projectDid)...), 0x00)
+}
+
+func GetAgentKey(projectDid, agentDid did.Did) []byte {
+	return append(GetAgentsPrefixKey(projectDid), []byte(agentDid)...)
+}
+
+func GetClaimsPrefixKey(projectDid did.Did) []byte {
+	return append(append(ClaimKey, []byte(projectDid)...), 0x00)
+}
+
+func GetClaimKey(projectDid did.Did, claimId string) []byte {
+	return append(GetClaimsPrefixKey(projectDid), []byte(claimId)...)
+}
+
+func GetTargetProgressesPrefixKey(projectDid did.Did) []byte {
+	return append(append(TargetKey, []byte(projectDid)...), 0x00)
+}
+
+func GetTargetProgressKey(projectDid did.Did, targetId string) []byte {
+	return append(GetTargetProgressesPrefixKey(projectDid), []byte(targetId)...)
+}
+
+func GetWithdrawalSequenceKey(projectDid did.Did) []byte {
+	return append(WithdrawalSequenceKey, []byte(projectDid)...)
+}
+
+func GetWithdrawalsByRecipientPrefixKey(recipientDid did.Did) []byte {
+	return append(append(WithdrawalByRecipientKey, []byte(recipientDid)...), 0x00)
+}
+
+func GetWithdrawalByRecipientKey(recipientDid, projectDid did.Did, sequence uint64) []byte {
+	key := append(GetWithdrawalsByRecipientPrefixKey(recipientDid), []byte(projectDid)...)
+	return append(append(key, 0x00), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+func GetProjectDocVersionsPrefixKey(projectDid did.Did) []byte {
+	return append(append(ProjectDocVersionKey, []byte(projectDid)...), 0x00)
+}
+
+func GetProjectDocVersionKey(projectDid did.Did, version uint64) []byte {
+	return append(GetProjectDocVersionsPrefixKey(projectDid), sdk.Uint64ToBigEndian(version)...)
+}
+
+func GetSchemaKey(schemaId string) []byte {
+	return append(SchemaKey, []byte(schemaId)...)
+}
+
+func GetAgentStatsKey(agentDid did.Did) []byte {
+	return append(AgentStatsKey, []byte(agentDid)...)
+}
@@ -2,11 +2,11 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/ixofoundation/ixo-blockchain/x/did"
 	"github.com/spf13/viper"
-	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -21,9 +21,9 @@ const (
 	TypeMsgCreateClaim         = "create-claim"
 	TypeMsgCreateEvaluation    = "create-evaluation"
 	TypeMsgWithdrawFunds       = "withdraw-funds"
-
-	MsgCreateProjectFee            = int64(1000000)
-	MsgCreateProjectTransactionFee = int64(10000)
+	TypeMsgUpdateProjectDoc    = "update-project-doc"
+	TypeMsgRegisterSchema      = "register-schema"
+	TypeMsgResolveClaimDispute = "resolve-claim-dispute"
 )
 
 var (
@@ -34,6 +34,8 @@ var (
 	_ ixo.IxoMsg = MsgCreateClaim{}
 	_ ixo.IxoMsg = MsgCreateEvaluation{}
 	_ ixo.IxoMsg = MsgWithdrawFunds{}
+	_ ixo.IxoMsg = MsgUpdateProjectDoc{}
+	_ ixo.IxoMsg = MsgRegisterSchema{}
 )
 
 type MsgCreateProject struct {
@@ -44,11 +46,13 @@ type MsgCreateProject struct {
 	Data       json.RawMessage `json:"data" yaml:"data"`
 }
 
-func (msg MsgCreateProject) ToStdSignMsg(fee int64) auth.StdSignMsg {
+// ToStdSignMsg builds the sign msg of a project creation tx paying the
+// specified fee, which has to be the ProjectCreationFee param. The gas limit
+// is left at zero so that the ProjectCreationGasLimit param applies.
+func (msg MsgCreateProject) ToStdSignMsg(fee sdk.Coins) auth.StdSignMsg {
 	chainID := viper.GetString(flags.FlagChainID)
 	accNum, accSeq := uint64(0), uint64(0)
-	stdFee := auth.NewStdFee(0, sdk.NewCoins(sdk.NewCoin(
-		ixo.IxoNativeToken, sdk.NewInt(fee))))
+	stdFee := auth.NewStdFee(0, fee)
 	memo := viper.GetString(flags.FlagMemo)
 
 	return auth.StdSignMsg{
@@ -73,26 +77,9 @@ func (msg MsgCreateProject) ValidateBasic() sdk.Error {
 		return err
 	}
 
-	// Check that data marshallable to map[string]json.RawMessage
-	var dataMap ProjectDataMap
-	err := json.Unmarshal(msg.Data, &dataMap)
-	if err != nil {
-		return sdk.ErrInternal(err.Error())
-	}
-
-	// Check that evaluatorPayPerClaim is present and is a string integer
-	evaluatorPayPerClaimBz, found := dataMap["evaluatorPayPerClaim"]
-	if !found {
-		return sdk.ErrInternal("missing evaluatorPayPerClaim in project doc")
-	}
-	var evaluatorPayPerClaimStr string
-	err = json.Unmarshal(evaluatorPayPerClaimBz, &evaluatorPayPerClaimStr)
-	if err != nil {
-		return sdk.ErrInternal(err.Error())
-	}
-	_, err = strconv.ParseInt(evaluatorPayPerClaimStr, 10, 64)
-	if err != nil {
-		return sdk.ErrInternal("evaluatorPayPerClaim should be an integer")
+	// Check that data is valid
+	if err := ValidateProjectData(msg.Data); err != nil {
+		return err
 	}
 
 	// Check that DIDs valid
@@ -142,7 +129,10 @@ func (msg MsgUpdateProjectStatus) ValidateBasic() sdk.Error {
 		return err
 	}
 
-	// TODO: perform some checks on the Data (of type UpdateProjectStatusDoc)
+	// Check that status is one that a project can be updated to
+	if !IsValidProjectStatus(msg.Data.Status) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("invalid project status '%s'", msg.Data.Status))
+	}
 
 	// Check that DIDs valid
 	if !did.IsValidDid(msg.ProjectDid) {
@@ -151,7 +141,7 @@ func (msg MsgUpdateProjectStatus) ValidateBasic() sdk.Error {
 		return did.ErrorInvalidDid(DefaultCodespace, "sender did is invalid")
 	}
 
-	// IsValidProgressionFrom checked by the handler
+	// IsValidProgressionFrom and status guards checked by the handler
 
 	return nil
 }
@@ -182,7 +172,10 @@ func (msg MsgCreateAgent) ValidateBasic() sdk.Error {
 		return err
 	}
 
-	// TODO: perform some checks on the Data (of type CreateAgentDoc)
+	// Check that role valid
+	if !IsValidAgentRole(msg.Data.Role) {
+		return ErrInvalidAgentRole(DefaultCodespace, msg.Data.Role)
+	}
 
 	// Check that DIDs valid
 	if !did.IsValidDid(msg.ProjectDid) {
@@ -230,7 +223,12 @@ func (msg MsgUpdateAgent) ValidateBasic() sdk.Error {
 		return err
 	}
 
-	// TODO: perform some checks on the Data (of type UpdateAgentDoc)
+	// Check that status and role (if any) valid
+	if !IsValidAgentStatus(msg.Data.Status) {
+		return ErrInvalidAgentStatus(DefaultCodespace, msg.Data.Status)
+	} else if msg.Data.Role != "" && !IsValidAgentRole(msg.Data.Role) {
+		return ErrInvalidAgentRole(DefaultCodespace, msg.Data.Role)
+	}
 
 	// Check that DIDs valid
 	if !did.IsValidDid(msg.ProjectDid) {
@@ -262,11 +260,16 @@ func (msg MsgUpdateAgent) String() string {
 	return string(b)
 }
 
+// MsgCreateClaim is signed by the project. The claimer (SenderDid) can also
+// sign the msg (see GetSenderProofBytes), in which case the claim counts
+// towards the claimer's statistics.
 type MsgCreateClaim struct {
 	TxHash     string         `json:"txHash" yaml:"txHash"`
 	SenderDid  did.Did        `json:"senderDid" yaml:"senderDid"`
 	ProjectDid did.Did        `json:"projectDid" yaml:"projectDid"`
 	Data       CreateClaimDoc `json:"data" yaml:"data"`
+	// SenderSignature (if any) is the sender's signature of the sender proof
+	SenderSignature []byte `json:"senderSignature,omitempty" yaml:"senderSignature"`
 }
 
 func (msg MsgCreateClaim) Type() string  { return TypeMsgCreateClaim }
@@ -280,7 +283,10 @@ func (msg MsgCreateClaim) ValidateBasic() sdk.Error {
 		return err
 	}
 
-	// TODO: perform some checks on the Data (of type CreateClaimDoc)
+	// Check that claim ID not empty
+	if valid, err := CheckNotEmpty(msg.Data.ClaimID, "ClaimID"); !valid {
+		return err
+	}
 
 	// Check that DIDs valid
 	if !did.IsValidDid(msg.ProjectDid) {
@@ -301,6 +307,13 @@ func (msg MsgCreateClaim) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
 
+// GetSenderProofBytes returns the bytes that the sender signs to show that it
+// made the claim, i.e. the sign bytes of the msg without the sender signature.
+func (msg MsgCreateClaim) GetSenderProofBytes() []byte {
+	msg.SenderSignature = nil
+	return msg.GetSignBytes()
+}
+
 func (msg MsgCreateClaim) String() string {
 	b, err := json.Marshal(msg)
 	if err != nil {
@@ -310,11 +323,16 @@ func (msg MsgCreateClaim) String() string {
 	return string(b)
 }
 
+// MsgCreateEvaluation is signed by the project. The evaluator (SenderDid)
+// can also sign the msg (see GetSenderProofBytes), in which case the
+// evaluation counts towards the evaluator's and claimer's statistics.
 type MsgCreateEvaluation struct {
 	TxHash     string              `json:"txHash" yaml:"txHash"`
 	SenderDid  did.Did             `json:"senderDid" yaml:"senderDid"`
 	ProjectDid did.Did             `json:"projectDid" yaml:"projectDid"`
 	Data       CreateEvaluationDoc `json:"data" yaml:"data"`
+	// SenderSignature (if any) is the sender's signature of the sender proof
+	SenderSignature []byte `json:"senderSignature,omitempty" yaml:"senderSignature"`
 }
 
 func (msg MsgCreateEvaluation) Type() string  { return TypeMsgCreateEvaluation }
@@ -328,7 +346,12 @@ func (msg MsgCreateEvaluation) ValidateBasic() sdk.Error {
 		return err
 	}
 
-	// TODO: perform some checks on the Data (of type CreateEvaluationDoc)
+	// Check that claim ID not empty and that status is a final status
+	if valid, err := CheckNotEmpty(msg.Data.ClaimID, "ClaimID"); !valid {
+		return err
+	} else if !IsValidEvaluationStatus(msg.Data.Status) {
+		return ErrInvalidClaimStatus(DefaultCodespace, msg.Data.Status)
+	}
 
 	// Check that DIDs valid
 	if !did.IsValidDid(msg.ProjectDid) {
@@ -349,6 +372,14 @@ func (msg MsgCreateEvaluation) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
 
+// GetSenderProofBytes returns the bytes that the sender signs to show that it
+// made the evaluation, i.e. the sign bytes of the msg without the sender
+// signature.
+func (msg MsgCreateEvaluation) GetSenderProofBytes() []byte {
+	msg.SenderSignature = nil
+	return msg.GetSignBytes()
+}
+
 func (msg MsgCreateEvaluation) String() string {
 	b, err := json.Marshal(msg)
 	if err != nil {
@@ -392,9 +423,13 @@ func (msg MsgWithdrawFunds) ValidateBasic() sdk.Error {
 		return sdk.ErrInternal("sender did must match recipient did")
 	}
 
-	// Check that amount is positive
+	// Check that amount is positive and that denom (if any) is valid
 	if !msg.Data.Amount.IsPositive() {
 		return sdk.ErrInternal("amount should be positive")
+	} else if msg.Data.Denom != "" {
+		if err := CheckCoinDenom(msg.Data.Denom); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -417,3 +452,165 @@ func (msg MsgWithdrawFunds) String() string {
 
 	return string(b)
 }
+
+type MsgUpdateProjectDoc struct {
+	TxHash     string               `json:"txHash" yaml:"txHash"`
+	SenderDid  did.Did              `json:"senderDid" yaml:"senderDid"`
+	ProjectDid did.Did              `json:"projectDid" yaml:"projectDid"`
+	Data       UpdateProjectDataDoc `json:"data" yaml:"data"`
+}
+
+func (msg MsgUpdateProjectDoc) Type() string  { return TypeMsgUpdateProjectDoc }
+func (msg MsgUpdateProjectDoc) Route() string { return RouterKey }
+
+func (msg MsgUpdateProjectDoc) ValidateBasic() sdk.Error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(msg.ProjectDid, "ProjectDid"); !valid {
+		return err
+	} else if valid, err := CheckNotEmpty(msg.SenderDid, "SenderDid"); !valid {
+		return err
+	}
+
+	// Check that mode is valid and that data is valid JSON. Data that
+	// replaces the project data is validated fully, whereas a patch is only
+	// validated by the handler once it has been applied.
+	if !IsValidProjectDataUpdateMode(msg.Data.Mode) {
+		return ErrInvalidProjectDataUpdateMode(DefaultCodespace, msg.Data.Mode)
+	} else if !json.Valid(msg.Data.Data) {
+		return sdk.ErrInternal("project data update is not valid JSON")
+	} else if msg.Data.Mode == ReplaceProjectData {
+		if err := ValidateProjectData(msg.Data.Data); err != nil {
+			return err
+		}
+	}
+
+	// Check that DIDs valid
+	if !did.IsValidDid(msg.ProjectDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "project did is invalid")
+	} else if !did.IsValidDid(msg.SenderDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "sender did is invalid")
+	}
+
+	return nil
+}
+
+func (msg MsgUpdateProjectDoc) GetSignerDid() did.Did { return msg.ProjectDid }
+func (msg MsgUpdateProjectDoc) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgUpdateProjectDoc) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUpdateProjectDoc) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(b)
+}
+
+type MsgRegisterSchema struct {
+	SenderDid did.Did           `json:"senderDid" yaml:"senderDid"`
+	Data      RegisterSchemaDoc `json:"data" yaml:"data"`
+}
+
+func (msg MsgRegisterSchema) Type() string  { return TypeMsgRegisterSchema }
+func (msg MsgRegisterSchema) Route() string { return RouterKey }
+
+func (msg MsgRegisterSchema) ValidateBasic() sdk.Error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(msg.SenderDid, "SenderDid"); !valid {
+		return err
+	}
+
+	// Check that schema ID is valid and that schema compiles
+	if err := ValidateSchemaId(msg.Data.SchemaId); err != nil {
+		return err
+	} else if !isJsonObject(msg.Data.Schema) {
+		return ErrInvalidSchema(DefaultCodespace, "schema must be a JSON object")
+	} else if _, err := CompileJsonSchema(msg.Data.Schema); err != nil {
+		return ErrInvalidSchema(DefaultCodespace, err.Error())
+	}
+
+	// Check that DIDs valid
+	if !did.IsValidDid(msg.SenderDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "sender did is invalid")
+	}
+
+	return nil
+}
+
+func (msg MsgRegisterSchema) GetSignerDid() did.Did { return msg.SenderDid }
+func (msg MsgRegisterSchema) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgRegisterSchema) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRegisterSchema) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(b)
+}
+
+// MsgResolveClaimDispute resolves a dispute by the claimer of a rejected claim
+// over its evaluation, by either upholding the evaluation (the claimer loses)
+// or not (the evaluator loses). It is signed by the project.
+type MsgResolveClaimDispute struct {
+	TxHash     string                 `json:"txHash" yaml:"txHash"`
+	SenderDid  did.Did                `json:"senderDid" yaml:"senderDid"`
+	ProjectDid did.Did                `json:"projectDid" yaml:"projectDid"`
+	Data       ResolveClaimDisputeDoc `json:"data" yaml:"data"`
+}
+
+func (msg MsgResolveClaimDispute) Type() string  { return TypeMsgResolveClaimDispute }
+func (msg MsgResolveClaimDispute) Route() string { return RouterKey }
+
+func (msg MsgResolveClaimDispute) ValidateBasic() sdk.Error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(msg.ProjectDid, "ProjectDid"); !valid {
+		return err
+	} else if valid, err := CheckNotEmpty(msg.SenderDid, "SenderDid"); !valid {
+		return err
+	}
+
+	// Check that claim ID not empty
+	if valid, err := CheckNotEmpty(msg.Data.ClaimID, "ClaimID"); !valid {
+		return err
+	}
+
+	// Check that DIDs valid
+	if !did.IsValidDid(msg.ProjectDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "project did is invalid")
+	} else if !did.IsValidDid(msg.SenderDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "sender did is invalid")
+	}
+
+	return nil
+}
+
+func (msg MsgResolveClaimDispute) GetSignerDid() did.Did { return msg.ProjectDid }
+func (msg MsgResolveClaimDispute) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{nil} // not used in signature verification in ixo AnteHandler
+}
+
+func (msg MsgResolveClaimDispute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgResolveClaimDispute) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(b)
+}
@@ -5,18 +5,32 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/ixo"
 )
 
 // Parameter store keys
 var (
-	KeyIxoDid                       = []byte("IxoDid")
-	KeyProjectMinimumInitialFunding = []byte("ProjectMinimumInitialFunding")
+	KeyIxoDid                        = []byte("IxoDid")
+	KeyProjectMinimumInitialFunding  = []byte("ProjectMinimumInitialFunding")
+	KeyStatusGuards                  = []byte("StatusGuards")
+	KeySchemaAdminDid                = []byte("SchemaAdminDid")
+	KeyProjectCreationFee            = []byte("ProjectCreationFee")
+	KeyProjectCreationTransactionFee = []byte("ProjectCreationTransactionFee")
+	KeyProjectCreationGasLimit       = []byte("ProjectCreationGasLimit")
 )
 
 // project parameters
 type Params struct {
-	IxoDid                       did.Did `json:"ixo_did" yaml:"ixo_did"`
-	ProjectMinimumInitialFunding sdk.Int `json:"project_minimum_initial_funding" yaml:"project_minimum_initial_funding"`
+	IxoDid                       did.Did          `json:"ixo_did" yaml:"ixo_did"`
+	ProjectMinimumInitialFunding sdk.Int          `json:"project_minimum_initial_funding" yaml:"project_minimum_initial_funding"`
+	StatusGuards                 StatusGuardsList `json:"status_guards" yaml:"status_guards"`
+	SchemaAdminDid               did.Did          `json:"schema_admin_did" yaml:"schema_admin_did"`
+	// The fee paid by the sender of a MsgCreateProject. The transaction fee
+	// part goes to the fee collector and the rest funds the project account.
+	ProjectCreationFee            sdk.Coins `json:"project_creation_fee" yaml:"project_creation_fee"`
+	ProjectCreationTransactionFee sdk.Coins `json:"project_creation_transaction_fee" yaml:"project_creation_transaction_fee"`
+	// The gas limit of a MsgCreateProject tx that does not specify its own.
+	ProjectCreationGasLimit uint64 `json:"project_creation_gas_limit" yaml:"project_creation_gas_limit"`
 }
 
 // ParamTable for project module.
@@ -24,10 +38,18 @@ func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(projectMinimumInitialFunding sdk.Int, ixoDid did.Did) Params {
+func NewParams(projectMinimumInitialFunding sdk.Int, ixoDid did.Did,
+	statusGuards StatusGuardsList, schemaAdminDid did.Did,
+	projectCreationFee, projectCreationTransactionFee sdk.Coins,
+	projectCreationGasLimit uint64) Params {
 	return Params{
-		IxoDid:                       ixoDid,
-		ProjectMinimumInitialFunding: projectMinimumInitialFunding,
+		IxoDid:                        ixoDid,
+		ProjectMinimumInitialFunding:  projectMinimumInitialFunding,
+		StatusGuards:                  statusGuards,
+		SchemaAdminDid:                schemaAdminDid,
+		ProjectCreationFee:            projectCreationFee,
+		ProjectCreationTransactionFee: projectCreationTransactionFee,
+		ProjectCreationGasLimit:       projectCreationGasLimit,
 	}
 
 }
@@ -37,6 +59,13 @@ func DefaultParams() Params {
 	return Params{
 		IxoDid:                       did.Did(""),  // blank
 		ProjectMinimumInitialFunding: sdk.OneInt(), // 1
+		StatusGuards:                 DefaultStatusGuards(),
+		SchemaAdminDid:               did.Did(""), // blank (schema registration disabled)
+		ProjectCreationFee: sdk.NewCoins(sdk.NewCoin(
+			ixo.IxoNativeToken, sdk.NewInt(1000000))), // 1 IXO
+		ProjectCreationTransactionFee: sdk.NewCoins(sdk.NewCoin(
+			ixo.IxoNativeToken, sdk.NewInt(10000))), // 0.01 IXO
+		ProjectCreationGasLimit: 1000000,
 	}
 }
 
@@ -48,6 +77,25 @@ func ValidateParams(params Params) error {
 	if params.ProjectMinimumInitialFunding.LT(sdk.ZeroInt()) {
 		return fmt.Errorf("project parameter ProjectMinimumInitialFunding should be positive, is %s ", params.ProjectMinimumInitialFunding.String())
 	}
+	if err := params.StatusGuards.Validate(); err != nil {
+		return err
+	}
+	if !params.ProjectCreationFee.IsValid() {
+		return fmt.Errorf("project creation fee %s is invalid", params.ProjectCreationFee)
+	}
+	if !params.ProjectCreationTransactionFee.IsValid() {
+		return fmt.Errorf("project creation transaction fee %s is invalid", params.ProjectCreationTransactionFee)
+	}
+	if _, hasNeg := params.ProjectCreationFee.SafeSub(params.ProjectCreationTransactionFee); hasNeg {
+		return fmt.Errorf("project creation transaction fee %s exceeds project creation fee %s",
+			params.ProjectCreationTransactionFee, params.ProjectCreationFee)
+	}
+	if params.ProjectCreationGasLimit == 0 {
+		return fmt.Errorf("project creation gas limit should be positive")
+	}
+	if len(params.SchemaAdminDid) != 0 && !did.IsValidDid(params.SchemaAdminDid) {
+		return fmt.Errorf("schema admin did %s is invalid", params.SchemaAdminDid)
+	}
 	return nil
 }
 
@@ -55,8 +103,14 @@ func (p Params) String() string {
 	return fmt.Sprintf(`Project Params:
   Ixo Did: %s
   Project Minimum Initial Funding: %s
+  Status Guards: %s
+  Schema Admin Did: %s
+  Project Creation Fee: %s
+  Project Creation Transaction Fee: %s
+  Project Creation Gas Limit: %d
 
-`, p.ProjectMinimumInitialFunding, p.IxoDid)
+`, p.IxoDid, p.ProjectMinimumInitialFunding, p.StatusGuards, p.SchemaAdminDid,
+		p.ProjectCreationFee, p.ProjectCreationTransactionFee, p.ProjectCreationGasLimit)
 }
 
 // Implements params.ParamSet
@@ -64,5 +118,10 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		{KeyIxoDid, &p.IxoDid},
 		{KeyProjectMinimumInitialFunding, &p.ProjectMinimumInitialFunding},
+		{KeyStatusGuards, &p.StatusGuards},
+		{KeySchemaAdminDid, &p.SchemaAdminDid},
+		{KeyProjectCreationFee, &p.ProjectCreationFee},
+		{KeyProjectCreationTransactionFee, &p.ProjectCreationTransactionFee},
+		{KeyProjectCreationGasLimit, &p.ProjectCreationGasLimit},
 	}
 }
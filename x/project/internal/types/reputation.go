@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+)
+
+// AgentStats are the statistics of an agent's activity across all projects,
+// which project owners can use to vet agents before approving them.
+type AgentStats struct {
+	AgentDid             did.Did `json:"agent_did" yaml:"agent_did"`
+	ClaimsSubmitted      uint64  `json:"claims_submitted" yaml:"claims_submitted"`
+	ClaimsApproved       uint64  `json:"claims_approved" yaml:"claims_approved"`
+	ClaimsRejected       uint64  `json:"claims_rejected" yaml:"claims_rejected"`
+	EvaluationsPerformed uint64  `json:"evaluations_performed" yaml:"evaluations_performed"`
+	// DisputesLost is the number of disputes over the agent's claims or
+	// evaluations that were resolved against the agent.
+	DisputesLost uint64 `json:"disputes_lost" yaml:"disputes_lost"`
+}
+
+func NewAgentStats(agentDid did.Did) AgentStats {
+	return AgentStats{AgentDid: agentDid}
+}
+
+// ClaimsPending is the number of the agent's claims not yet evaluated.
+func (s AgentStats) ClaimsPending() uint64 {
+	return s.ClaimsSubmitted - s.ClaimsApproved - s.ClaimsRejected
+}
+
+// ClaimApprovalRate is the fraction of the agent's evaluated claims that were
+// approved, which is zero if none of the agent's claims were evaluated.
+func (s AgentStats) ClaimApprovalRate() sdk.Dec {
+	evaluated := s.ClaimsApproved + s.ClaimsRejected
+	if evaluated == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDec(int64(s.ClaimsApproved)).QuoInt64(int64(evaluated))
+}
+
+func (s AgentStats) Validate() sdk.Error {
+	if !did.IsValidDid(s.AgentDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "agent did is invalid")
+	} else if s.ClaimsApproved+s.ClaimsRejected > s.ClaimsSubmitted {
+		return sdk.ErrInternal("agent has more evaluated claims than submitted claims")
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+)
+
+// ProjectSchema is a JSON Schema registered by the schema admin (see the
+// SchemaAdminDid param) that project data can declare conformance to, by
+// setting the "schemaId" field of the project data to the schema's ID.
+// Schemas cannot be changed once registered, so that a project's data never
+// stops conforming to the schema that it was validated against.
+type ProjectSchema struct {
+	Id         string          `json:"id" yaml:"id"`
+	Schema     json.RawMessage `json:"schema" yaml:"schema"`
+	CreatorDid did.Did         `json:"creatorDid" yaml:"creatorDid"`
+	Height     int64           `json:"height" yaml:"height"`
+	Time       time.Time       `json:"time" yaml:"time"`
+}
+
+func NewProjectSchema(ctx sdk.Context, id string, schema json.RawMessage,
+	creatorDid did.Did) ProjectSchema {
+	return ProjectSchema{
+		Id:         id,
+		Schema:     schema,
+		CreatorDid: creatorDid,
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime(),
+	}
+}
+
+func (s ProjectSchema) Validate() sdk.Error {
+	if err := ValidateSchemaId(s.Id); err != nil {
+		return err
+	} else if !did.IsValidDid(s.CreatorDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "creator did is invalid")
+	} else if _, err := s.Compile(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s ProjectSchema) Compile() (*JsonSchema, sdk.Error) {
+	if !isJsonObject(s.Schema) {
+		return nil, ErrInvalidSchema(DefaultCodespace, "schema must be a JSON object")
+	}
+	compiled, err := CompileJsonSchema(s.Schema)
+	if err != nil {
+		return nil, ErrInvalidSchema(DefaultCodespace, err.Error())
+	}
+	return compiled, nil
+}
+
+// ValidateProjectData checks that the project data conforms to the schema.
+func (s ProjectSchema) ValidateProjectData(data json.RawMessage) sdk.Error {
+	compiled, err := s.Compile()
+	if err != nil {
+		return err
+	}
+	if err := compiled.ValidateDocument(data); err != nil {
+		return ErrProjectDataDoesNotMatchSchema(DefaultCodespace, s.Id, err.Error())
+	}
+	return nil
+}
+
+func ValidateSchemaId(id string) sdk.Error {
+	if strings.TrimSpace(id) == "" {
+		return ErrInvalidSchema(DefaultCodespace, "schema id is empty")
+	} else if strings.ContainsAny(id, "/\x00") {
+		return ErrInvalidSchema(DefaultCodespace, fmt.Sprintf(
+			"schema id '%s' contains invalid characters", id))
+	}
+	return nil
+}
+
+// GetSchemaIdFromDataMap returns the ID of the schema declared in the
+// "schemaId" field of the project data, or an empty ID if there is none.
+func GetSchemaIdFromDataMap(dataMap ProjectDataMap) (string, sdk.Error) {
+	schemaIdBz, found := dataMap["schemaId"]
+	if !found {
+		return "", nil
+	}
+
+	var schemaId string
+	if err := json.Unmarshal(schemaIdBz, &schemaId); err != nil {
+		return "", ErrInvalidSchema(DefaultCodespace, "schemaId should be a string")
+	} else if err := ValidateSchemaId(schemaId); err != nil {
+		return "", err
+	}
+
+	return schemaId, nil
+}
+
+// GetSchemaIdFromData is GetSchemaIdFromDataMap for project data that has
+// not yet been unmarshalled.
+func GetSchemaIdFromData(data json.RawMessage) (string, sdk.Error) {
+	var dataMap ProjectDataMap
+	if err := json.Unmarshal(data, &dataMap); err != nil {
+		return "", sdk.ErrInternal(err.Error())
+	}
+	return GetSchemaIdFromDataMap(dataMap)
+}
+
+type RegisterSchemaDoc struct {
+	SchemaId string          `json:"schemaId" yaml:"schemaId"`
+	Schema   json.RawMessage `json:"schema" yaml:"schema"`
+}
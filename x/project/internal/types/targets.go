@@ -0,0 +1,147 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+)
+
+// TargetAction is what happens when a project's target is reached, in
+// addition to the target_reached event that is always emitted.
+type TargetAction string
+
+const (
+	NoTargetAction            TargetAction = ""
+	UpdateStatusTargetAction  TargetAction = "update_status"
+	EffectPaymentTargetAction TargetAction = "effect_payment"
+)
+
+// Target is an outcome target declared in the "targets" field of the project
+// data, e.g. 1000 approved claims of a particular claim template. An empty
+// claim template ID means that claims of any template count towards the goal.
+type Target struct {
+	Id                      string        `json:"id" yaml:"id"`
+	ClaimTemplateId         string        `json:"claimTemplateId" yaml:"claimTemplateId"`
+	Goal                    uint64        `json:"goal" yaml:"goal"`
+	Action                  TargetAction  `json:"action" yaml:"action"`
+	ActionStatus            ProjectStatus `json:"actionStatus" yaml:"actionStatus"`
+	ActionPaymentContractId string        `json:"actionPaymentContractId" yaml:"actionPaymentContractId"`
+}
+
+func (t Target) CountsClaim(claim Claim) bool {
+	return t.ClaimTemplateId == "" || t.ClaimTemplateId == claim.TemplateId
+}
+
+func (t Target) Validate() sdk.Error {
+	if strings.TrimSpace(t.Id) == "" {
+		return ErrInvalidTarget(DefaultCodespace, "target id is empty")
+	} else if t.Goal == 0 {
+		return ErrInvalidTarget(DefaultCodespace, "target goal must be positive")
+	}
+
+	switch t.Action {
+	case NoTargetAction:
+	case UpdateStatusTargetAction:
+		if !t.ActionStatus.IsValidProgressionFromAny() {
+			return ErrInvalidTarget(DefaultCodespace, "target action status invalid")
+		}
+	case EffectPaymentTargetAction:
+		if strings.TrimSpace(t.ActionPaymentContractId) == "" {
+			return ErrInvalidTarget(DefaultCodespace, "target action payment contract id is empty")
+		}
+	default:
+		return ErrInvalidTarget(DefaultCodespace, fmt.Sprintf(
+			"target action '%s' invalid", t.Action))
+	}
+
+	return nil
+}
+
+type Targets []Target
+
+func (ts Targets) Validate() sdk.Error {
+	ids := make(map[string]bool)
+	for _, t := range ts {
+		if err := t.Validate(); err != nil {
+			return err
+		} else if ids[t.Id] {
+			return ErrInvalidTarget(DefaultCodespace, fmt.Sprintf(
+				"duplicate target id %s", t.Id))
+		}
+		ids[t.Id] = true
+	}
+	return nil
+}
+
+// GetTargetsFromDataMap returns the targets in the "targets" field of the
+// project data (if any) after validating them.
+func GetTargetsFromDataMap(dataMap ProjectDataMap) (Targets, sdk.Error) {
+	targetsBz, found := dataMap["targets"]
+	if !found {
+		return nil, nil
+	}
+
+	var targets Targets
+	if err := json.Unmarshal(targetsBz, &targets); err != nil {
+		return nil, ErrInvalidTarget(DefaultCodespace, err.Error())
+	} else if err := targets.Validate(); err != nil {
+		return nil, err
+	}
+
+	return targets, nil
+}
+
+// TargetProgress holds the live counters of the evaluated claims that count
+// towards a project's target. The target is reached when the number of
+// approved claims reaches the target's goal, which happens at most once.
+type TargetProgress struct {
+	ProjectDid    did.Did   `json:"project_did" yaml:"project_did"`
+	TargetId      string    `json:"target_id" yaml:"target_id"`
+	Approved      uint64    `json:"approved" yaml:"approved"`
+	Rejected      uint64    `json:"rejected" yaml:"rejected"`
+	Reached       bool      `json:"reached" yaml:"reached"`
+	ReachedHeight int64     `json:"reached_height" yaml:"reached_height"`
+	ReachedTime   time.Time `json:"reached_time" yaml:"reached_time"`
+	ActionError   string    `json:"action_error" yaml:"action_error"`
+}
+
+func NewTargetProgress(projectDid did.Did, targetId string) TargetProgress {
+	return TargetProgress{
+		ProjectDid: projectDid,
+		TargetId:   targetId,
+	}
+}
+
+// AddEvaluation counts the evaluated claim and returns true if this caused
+// the target to be reached.
+func (p *TargetProgress) AddEvaluation(ctx sdk.Context, target Target, claim Claim) bool {
+	switch claim.Status {
+	case ApprovedClaim:
+		p.Approved += 1
+	case RejectedClaim:
+		p.Rejected += 1
+	}
+
+	if p.Reached || p.Approved < target.Goal {
+		return false
+	}
+
+	p.Reached = true
+	p.ReachedHeight = ctx.BlockHeight()
+	p.ReachedTime = ctx.BlockTime()
+	return true
+}
+
+func (p TargetProgress) Validate() sdk.Error {
+	if !did.IsValidDid(p.ProjectDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "project did is invalid")
+	} else if strings.TrimSpace(p.TargetId) == "" {
+		return ErrInvalidTarget(DefaultCodespace, "target id is empty")
+	}
+
+	return nil
+}
@@ -1,11 +1,14 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ixofoundation/ixo-blockchain/x/did"
 	"github.com/ixofoundation/ixo-blockchain/x/did/exported"
-	"strconv"
+	"github.com/ixofoundation/ixo-blockchain/x/ixo"
+	"time"
 )
 
 var (
@@ -26,12 +29,17 @@ func (id InternalAccountID) ToAddressKey(projectDid did.Did) string {
 }
 
 type StoredProjectDoc interface {
-	GetEvaluatorPay() int64
+	GetEvaluatorPay() (sdk.Coin, sdk.Error)
+	GetFunding() (ProjectFunding, sdk.Error)
+	GetTargets() (Targets, sdk.Error)
 	GetProjectDid() did.Did
 	GetSenderDid() did.Did
 	GetPubKey() string
 	GetStatus() ProjectStatus
 	SetStatus(status ProjectStatus)
+	GetTxHash() string
+	GetData() json.RawMessage
+	SetData(data json.RawMessage)
 }
 
 const (
@@ -42,21 +50,46 @@ const (
 	StartedStatus  ProjectStatus = "STARTED"
 	StoppedStatus  ProjectStatus = "STOPPED"
 	PaidoutStatus  ProjectStatus = "PAIDOUT"
+
+	// A suspended project does not accept claims or evaluations until it is
+	// started again. A cancelled project is final; its creator can withdraw
+	// the remaining funds as a refund, as for a paid out project.
+	SuspendedStatus ProjectStatus = "SUSPENDED"
+	CancelledStatus ProjectStatus = "CANCELLED"
 )
 
 var StateTransitions = initStateTransitions()
 
 func initStateTransitions() ProjectStatusTransitionMap {
 	return ProjectStatusTransitionMap{
-		NullStatus:     {CreatedProject},
-		CreatedProject: {PendingStatus},
-		PendingStatus:  {CreatedProject, FundedStatus},
-		FundedStatus:   {StartedStatus},
-		StartedStatus:  {StoppedStatus},
-		StoppedStatus:  {PaidoutStatus},
+		NullStatus:      {CreatedProject},
+		CreatedProject:  {PendingStatus, CancelledStatus},
+		PendingStatus:   {CreatedProject, FundedStatus, CancelledStatus},
+		FundedStatus:    {StartedStatus, CancelledStatus},
+		StartedStatus:   {StoppedStatus, SuspendedStatus},
+		SuspendedStatus: {StartedStatus, StoppedStatus, CancelledStatus},
+		StoppedStatus:   {PaidoutStatus},
 	}
 }
 
+// IsValidProjectStatus indicates whether the status is one that a project
+// can be updated to.
+func IsValidProjectStatus(status ProjectStatus) bool {
+	return status != NullStatus && status.IsValidProgressionFromAny()
+}
+
+// IsPaidOut indicates whether the project's fees have been paid out, after
+// which funds can be withdrawn from the project.
+func (status ProjectStatus) IsPaidOut() bool {
+	return status == PaidoutStatus || status == CancelledStatus
+}
+
+// AcceptsClaims indicates whether claims and evaluations can be submitted
+// to a project in this status.
+func (status ProjectStatus) AcceptsClaims() bool {
+	return status != SuspendedStatus && status != CancelledStatus
+}
+
 func (next ProjectStatus) IsValidProgressionFrom(prev ProjectStatus) bool {
 	validStatuses := StateTransitions[prev]
 	for _, v := range validStatuses {
@@ -67,11 +100,57 @@ func (next ProjectStatus) IsValidProgressionFrom(prev ProjectStatus) bool {
 	return false
 }
 
+// IsValidProgressionFromAny indicates whether a project can progress to the
+// status from at least one other status.
+func (next ProjectStatus) IsValidProgressionFromAny() bool {
+	for prev := range StateTransitions {
+		if next.IsValidProgressionFrom(prev) {
+			return true
+		}
+	}
+	return false
+}
+
 type WithdrawalInfo struct {
-	ActionID     string   `json:"actionID" yaml:"actionID"`
-	ProjectDid   did.Did  `json:"projectDid" yaml:"projectDid"`
-	RecipientDid did.Did  `json:"recipientDid" yaml:"recipientDid"`
-	Amount       sdk.Coin `json:"amount" yaml:"amount"`
+	ActionID     string    `json:"actionID" yaml:"actionID"`
+	ProjectDid   did.Did   `json:"projectDid" yaml:"projectDid"`
+	RecipientDid did.Did   `json:"recipientDid" yaml:"recipientDid"`
+	Amount       sdk.Coin  `json:"amount" yaml:"amount"`
+	Sequence     uint64    `json:"sequence" yaml:"sequence"`
+	Height       int64     `json:"height" yaml:"height"`
+	Time         time.Time `json:"time" yaml:"time"`
+}
+
+// NewWithdrawalInfo creates the record of a project's withdrawal with the
+// specified sequence number, effected in the current block.
+func NewWithdrawalInfo(ctx sdk.Context, projectDid, recipientDid did.Did,
+	amount sdk.Coin, sequence uint64) WithdrawalInfo {
+	return WithdrawalInfo{
+		ActionID:     GetWithdrawalActionId(projectDid, sequence),
+		ProjectDid:   projectDid,
+		RecipientDid: recipientDid,
+		Amount:       amount,
+		Sequence:     sequence,
+		Height:       ctx.BlockHeight(),
+		Time:         ctx.BlockTime(),
+	}
+}
+
+// GetWithdrawalActionId returns the action ID used to reconcile a project's
+// withdrawal with the Ethereum side, which is a 32-byte (bytes32) hex string.
+// Sequences are per project, so the action ID is the SHA-256 hash of the
+// project DID, a zero byte and the big-endian sequence number, which makes it
+// unique across projects.
+func GetWithdrawalActionId(projectDid did.Did, sequence uint64) string {
+	bz := append([]byte(projectDid), 0x00)
+	bz = append(bz, sdk.Uint64ToBigEndian(sequence)...)
+	actionId := sha256.Sum256(bz)
+	return "0x" + hex.EncodeToString(actionId[:])
+}
+
+type UpdateProjectDataDoc struct {
+	Mode ProjectDataUpdateMode `json:"mode" yaml:"mode"`
+	Data json.RawMessage       `json:"data" yaml:"data"`
 }
 
 type UpdateProjectStatusDoc struct {
@@ -105,6 +184,9 @@ func (pd ProjectDoc) GetSenderDid() did.Did           { return pd.SenderDid }
 func (pd ProjectDoc) GetPubKey() string               { return pd.PubKey }
 func (pd ProjectDoc) GetStatus() ProjectStatus        { return pd.Status }
 func (pd *ProjectDoc) SetStatus(status ProjectStatus) { pd.Status = status }
+func (pd ProjectDoc) GetTxHash() string               { return pd.TxHash }
+func (pd ProjectDoc) GetData() json.RawMessage        { return pd.Data }
+func (pd *ProjectDoc) SetData(data json.RawMessage)   { pd.Data = data }
 func (pd ProjectDoc) GetProjectData() ProjectDataMap {
 	var dataMap ProjectDataMap
 	err := json.Unmarshal(pd.Data, &dataMap)
@@ -114,24 +196,21 @@ func (pd ProjectDoc) GetProjectData() ProjectDataMap {
 	return dataMap
 }
 
-func (pd ProjectDoc) GetEvaluatorPay() int64 {
-	evaluatorPayPerClaimBz, found := pd.GetProjectData()["evaluatorPayPerClaim"]
-	if !found {
-		panic("evaluatorPayPerClaim not found")
-	}
+func (pd ProjectDoc) GetTargets() (Targets, sdk.Error) {
+	return GetTargetsFromDataMap(pd.GetProjectData())
+}
 
-	var evaluatorPayPerClaimStr string
-	err := json.Unmarshal(evaluatorPayPerClaimBz, &evaluatorPayPerClaimStr)
-	if err != nil {
-		panic(err)
-	}
+func (pd ProjectDoc) GetFunding() (ProjectFunding, sdk.Error) {
+	return GetProjectFundingFromDataMap(pd.GetProjectData())
+}
 
-	i, err := strconv.ParseInt(evaluatorPayPerClaimStr, 10, 64)
+func (pd ProjectDoc) GetEvaluatorPay() (sdk.Coin, sdk.Error) {
+	dataMap := pd.GetProjectData()
+	funding, err := GetProjectFundingFromDataMap(dataMap)
 	if err != nil {
-		panic(err)
+		return sdk.Coin{}, err
 	}
-
-	return i
+	return GetEvaluatorPayFromDataMap(dataMap, funding)
 }
 
 type CreateAgentDoc struct {
@@ -154,7 +233,8 @@ type UpdateAgentDoc struct {
 }
 
 type CreateClaimDoc struct {
-	ClaimID string `json:"claimID" yaml:"claimID"`
+	ClaimID         string `json:"claimID" yaml:"claimID"`
+	ClaimTemplateID string `json:"claimTemplateID,omitempty" yaml:"claimTemplateID"`
 }
 
 type ClaimStatus string
@@ -170,9 +250,25 @@ type CreateEvaluationDoc struct {
 	Status  ClaimStatus `json:"status" yaml:"status"`
 }
 
+type ResolveClaimDisputeDoc struct {
+	ClaimID          string `json:"claimID" yaml:"claimID"`
+	EvaluationUpheld bool   `json:"evaluationUpheld" yaml:"evaluationUpheld"`
+}
+
 type WithdrawFundsDoc struct {
 	ProjectDid   did.Did `json:"projectDid" yaml:"projectDid"`
 	RecipientDid did.Did `json:"recipientDid" yaml:"recipientDid"`
 	Amount       sdk.Int `json:"amount" yaml:"amount"`
 	IsRefund     bool    `json:"isRefund" yaml:"isRefund"`
+	Denom        string  `json:"denom,omitempty" yaml:"denom"`
+}
+
+// GetAmount returns the amount to be withdrawn as a coin. Withdrawals that do
+// not specify a denom are in IXO.
+func (wd WithdrawFundsDoc) GetAmount() sdk.Coin {
+	denom := wd.Denom
+	if denom == "" {
+		denom = ixo.IxoNativeToken
+	}
+	return sdk.NewCoin(denom, wd.Amount)
 }
@@ -27,6 +27,15 @@ func NewMsgUpdateProjectStatus(senderDid did.Did, updateProjectStatusDoc UpdateP
 	}
 }
 
+func NewMsgUpdateProjectDoc(senderDid did.Did, updateProjectDataDoc UpdateProjectDataDoc, projectDid did.IxoDid) MsgUpdateProjectDoc {
+	return MsgUpdateProjectDoc{
+		TxHash:     "",
+		SenderDid:  senderDid,
+		ProjectDid: projectDid.Did,
+		Data:       updateProjectDataDoc,
+	}
+}
+
 func NewMsgCreateAgent(txHash string, senderDid did.Did, createAgentDoc CreateAgentDoc, projectDid did.IxoDid) MsgCreateAgent {
 	return MsgCreateAgent{
 		ProjectDid: projectDid.Did,
@@ -63,6 +72,16 @@ func NewMsgCreateEvaluation(txHash string, senderDid did.Did, createEvaluationDo
 	}
 }
 
+func NewMsgResolveClaimDispute(txHash string, senderDid did.Did,
+	resolveClaimDisputeDoc ResolveClaimDisputeDoc, projectDid did.IxoDid) MsgResolveClaimDispute {
+	return MsgResolveClaimDispute{
+		ProjectDid: projectDid.Did,
+		TxHash:     txHash,
+		SenderDid:  senderDid,
+		Data:       resolveClaimDisputeDoc,
+	}
+}
+
 func NewMsgWithdrawFunds(senderDid did.Did, data WithdrawFundsDoc) MsgWithdrawFunds {
 	return MsgWithdrawFunds{
 		SenderDid: senderDid,
@@ -70,6 +89,13 @@ func NewMsgWithdrawFunds(senderDid did.Did, data WithdrawFundsDoc) MsgWithdrawFu
 	}
 }
 
+func NewMsgRegisterSchema(senderDid did.Did, data RegisterSchemaDoc) MsgRegisterSchema {
+	return MsgRegisterSchema{
+		SenderDid: senderDid,
+		Data:      data,
+	}
+}
+
 func CheckNotEmpty(value string, name string) (valid bool, err sdk.Error) {
 	if strings.TrimSpace(value) == "" {
 		return false, sdk.ErrUnknownRequest(name + " is empty.")
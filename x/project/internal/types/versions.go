@@ -0,0 +1,222 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+)
+
+type ProjectDataUpdateMode string
+
+const (
+	// ReplaceProjectData replaces the project data with the update's data.
+	ReplaceProjectData ProjectDataUpdateMode = "replace"
+	// MergePatchProjectData applies the update's data to the project data as
+	// a JSON merge patch (RFC 7386), in which null values remove fields.
+	MergePatchProjectData ProjectDataUpdateMode = "merge_patch"
+)
+
+func IsValidProjectDataUpdateMode(mode ProjectDataUpdateMode) bool {
+	return mode == ReplaceProjectData || mode == MergePatchProjectData
+}
+
+// LockedProjectDataFields are the fields of the project data that cannot be
+// changed once the project has started, given that agents and funders rely
+// on them when deciding to take part in the project.
+var LockedProjectDataFields = []string{
+	"evaluatorPayPerClaim",
+	"fundingDenoms",
+	"feesInFundingDenom",
+	"targets",
+}
+
+// HasStarted indicates whether a project in this status has been started,
+// after which the LockedProjectDataFields cannot be changed. A cancelled
+// project is treated as started, since it can be cancelled while suspended.
+func (status ProjectStatus) HasStarted() bool {
+	switch status {
+	case StartedStatus, SuspendedStatus, StoppedStatus, PaidoutStatus, CancelledStatus:
+		return true
+	default:
+		return false
+	}
+}
+
+// ProjectDocVersion is a version of a project's data. The first version is
+// the data that the project was created with and the latest version is the
+// project's current data.
+type ProjectDocVersion struct {
+	ProjectDid did.Did         `json:"projectDid" yaml:"projectDid"`
+	Version    uint64          `json:"version" yaml:"version"`
+	Data       json.RawMessage `json:"data" yaml:"data"`
+	TxHash     string          `json:"txHash" yaml:"txHash"`
+	Height     int64           `json:"height" yaml:"height"`
+	Time       time.Time       `json:"time" yaml:"time"`
+}
+
+func NewProjectDocVersion(ctx sdk.Context, projectDid did.Did, version uint64,
+	data json.RawMessage, txHash string) ProjectDocVersion {
+	return ProjectDocVersion{
+		ProjectDid: projectDid,
+		Version:    version,
+		Data:       data,
+		TxHash:     txHash,
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime(),
+	}
+}
+
+func (v ProjectDocVersion) Validate() sdk.Error {
+	if !did.IsValidDid(v.ProjectDid) {
+		return did.ErrorInvalidDid(DefaultCodespace, "project did is invalid")
+	} else if v.Version == 0 {
+		return sdk.ErrInternal("project doc version must be positive")
+	}
+	return nil
+}
+
+// ApplyProjectDataUpdate returns the project data that results from applying
+// the update's data to the current data in the specified mode.
+func ApplyProjectDataUpdate(current json.RawMessage, mode ProjectDataUpdateMode,
+	update json.RawMessage) (json.RawMessage, sdk.Error) {
+	switch mode {
+	case ReplaceProjectData:
+		return update, nil
+	case MergePatchProjectData:
+		patched, err := MergePatch(current, update)
+		if err != nil {
+			return nil, sdk.ErrInternal(err.Error())
+		}
+		return patched, nil
+	default:
+		return nil, ErrInvalidProjectDataUpdateMode(DefaultCodespace, mode)
+	}
+}
+
+// MergePatch applies a JSON merge patch (RFC 7386) to the target document.
+// Numbers are preserved as they appear in the documents rather than being
+// converted to floats.
+func MergePatch(target, patch json.RawMessage) (json.RawMessage, error) {
+	targetValue, err := unmarshalJsonValue(target)
+	if err != nil {
+		return nil, err
+	}
+	patchValue, err := unmarshalJsonValue(patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(mergePatchValue(targetValue, patchValue))
+}
+
+func mergePatchValue(target, patch interface{}) interface{} {
+	patchMap, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetMap, ok := target.(map[string]interface{})
+	if !ok {
+		targetMap = make(map[string]interface{})
+	}
+
+	for key, value := range patchMap {
+		if value == nil {
+			delete(targetMap, key)
+		} else {
+			targetMap[key] = mergePatchValue(targetMap[key], value)
+		}
+	}
+
+	return targetMap
+}
+
+func unmarshalJsonValue(bz json.RawMessage) (interface{}, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// CheckLockedFieldsUnchanged returns an error if any of the
+// LockedProjectDataFields differ between the two versions of project data,
+// including a field being added or removed.
+func CheckLockedFieldsUnchanged(before, after json.RawMessage) sdk.Error {
+	var beforeMap, afterMap map[string]json.RawMessage
+	if err := json.Unmarshal(before, &beforeMap); err != nil {
+		return sdk.ErrInternal(err.Error())
+	} else if err := json.Unmarshal(after, &afterMap); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+
+	for _, field := range LockedProjectDataFields {
+		beforeBz, beforeFound := beforeMap[field]
+		afterBz, afterFound := afterMap[field]
+		if beforeFound != afterFound {
+			return ErrLockedProjectDataField(DefaultCodespace, field)
+		} else if !beforeFound {
+			continue
+		}
+
+		beforeValue, err := unmarshalJsonValue(beforeBz)
+		if err != nil {
+			return sdk.ErrInternal(err.Error())
+		}
+		afterValue, err := unmarshalJsonValue(afterBz)
+		if err != nil {
+			return sdk.ErrInternal(err.Error())
+		}
+		if !reflect.DeepEqual(beforeValue, afterValue) {
+			return ErrLockedProjectDataField(DefaultCodespace, field)
+		}
+	}
+
+	return nil
+}
+
+// ValidateProjectData checks that the project data is a JSON object with a
+// valid evaluatorPayPerClaim and valid funding, targets and schema ID (if
+// any). Conformance to the declared schema is checked by the handler, given
+// that schemas are only known to the keeper.
+func ValidateProjectData(data json.RawMessage) sdk.Error {
+	// Check that data marshallable to map[string]json.RawMessage
+	var dataMap ProjectDataMap
+	err := json.Unmarshal(data, &dataMap)
+	if err != nil {
+		return sdk.ErrInternal(err.Error())
+	} else if dataMap == nil {
+		return sdk.ErrInternal("project data should be an object")
+	}
+
+	// Check that funding denoms (if any) are valid and that evaluatorPayPerClaim
+	// is present and is either an integer or a coin in a funding denom
+	funding, err2 := GetProjectFundingFromDataMap(dataMap)
+	if err2 != nil {
+		return err2
+	} else if _, err2 := GetEvaluatorPayFromDataMap(dataMap, funding); err2 != nil {
+		return err2
+	}
+
+	// Check that targets (if any) are valid
+	if _, err := GetTargetsFromDataMap(dataMap); err != nil {
+		return err
+	}
+
+	// Check that schema ID (if any) is valid
+	if _, err := GetSchemaIdFromDataMap(dataMap); err != nil {
+		return err
+	}
+
+	return nil
+}
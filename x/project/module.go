@@ -64,10 +64,13 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		cli.GetCmdCreateProject(cdc),
 		cli.GetCmdCreateAgent(cdc),
 		cli.GetCmdUpdateProjectStatus(cdc),
+		cli.GetCmdUpdateProjectDoc(cdc),
 		cli.GetCmdUpdateAgent(cdc),
 		cli.GetCmdCreateClaim(cdc),
 		cli.GetCmdCreateEvaluation(cdc),
+		cli.GetCmdResolveClaimDispute(cdc),
 		cli.GetCmdWithdrawFunds(cdc),
+		cli.GetCmdRegisterSchema(cdc),
 	)...)
 
 	return projectTxCmd
@@ -86,6 +89,15 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		cli.GetCmdProjectDoc(cdc),
 		cli.GetCmdProjectAccounts(cdc),
 		cli.GetCmdProjectTxs(cdc),
+		cli.GetCmdProjectAgents(cdc),
+		cli.GetCmdProjectClaims(cdc),
+		cli.GetCmdProjectTargets(cdc),
+		cli.GetCmdRecipientWithdrawals(cdc),
+		cli.GetCmdProjectDocVersions(cdc),
+		cli.GetCmdProjectDocVersion(cdc),
+		cli.GetCmdSchemas(cdc),
+		cli.GetCmdSchema(cdc),
+		cli.GetCmdAgentStats(cdc),
 		cli.GetParamsRequestHandler(cdc),
 	)...)
 
@@ -46,7 +46,8 @@ func handleMsgSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgSend) sdk.Resu
 
 func handleMsgOracleTransfer(ctx sdk.Context, k keeper.Keeper, msg types.MsgOracleTransfer) sdk.Result {
 
-	if err := k.OracleTransfer(ctx, msg.FromDid, msg.ToDidOrAddr, msg.OracleDid, msg.Amount); err != nil {
+	if err := k.OracleTransfer(ctx, msg.FromDid, msg.ToDidOrAddr,
+		msg.OracleDid, msg.Amount, msg.Proof); err != nil {
 		return err.Result()
 	}
 
@@ -62,7 +63,8 @@ func handleMsgOracleTransfer(ctx sdk.Context, k keeper.Keeper, msg types.MsgOrac
 
 func handleMsgOracleMint(ctx sdk.Context, k keeper.Keeper, msg types.MsgOracleMint) sdk.Result {
 
-	if err := k.OracleMint(ctx, msg.OracleDid, msg.ToDidOrAddr, msg.Amount); err != nil {
+	if err := k.OracleMint(ctx, msg.OracleDid, msg.ToDidOrAddr,
+		msg.Amount, msg.Proof); err != nil {
 		return err.Result()
 	}
 
@@ -78,7 +80,8 @@ func handleMsgOracleMint(ctx sdk.Context, k keeper.Keeper, msg types.MsgOracleMi
 
 func handleMsgOracleBurn(ctx sdk.Context, k keeper.Keeper, msg types.MsgOracleBurn) sdk.Result {
 
-	if err := k.OracleBurn(ctx, msg.OracleDid, msg.FromDid, msg.Amount); err != nil {
+	if err := k.OracleBurn(ctx, msg.OracleDid, msg.FromDid,
+		msg.Amount, msg.Proof); err != nil {
 		return err.Result()
 	}
 
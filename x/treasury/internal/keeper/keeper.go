@@ -67,15 +67,20 @@ func (k Keeper) Send(ctx sdk.Context, fromDid did.Did, toDidOrAddr string, amoun
 }
 
 func (k Keeper) OracleTransfer(ctx sdk.Context, fromDid did.Did,
-	toDidOrAddr string, oracleDid did.Did, amount sdk.Coins) sdk.Error {
+	toDidOrAddr string, oracleDid did.Did, amount sdk.Coins, proof string) sdk.Error {
 
 	// Check if oracle exists
 	if !k.oraclesKeeper.OracleExists(ctx, oracleDid) {
 		return sdk.ErrInternal("oracle specified is not a registered oracle")
 	}
 
-	// Confirm that oracle has the required capabilities
+	// Confirm that oracle is not suspended
 	oracle := k.oraclesKeeper.MustGetOracle(ctx, oracleDid)
+	if !oracle.IsActive() {
+		return oracles.ErrOracleSuspended(oracles.DefaultCodespace, oracleDid)
+	}
+
+	// Confirm that oracle has the required capabilities
 	for _, c := range amount {
 		if !oracle.Capabilities.Includes(c.Denom) {
 			return sdk.ErrInternal(fmt.Sprintf(
@@ -90,11 +95,31 @@ func (k Keeper) OracleTransfer(ctx sdk.Context, fromDid did.Did,
 		}
 	}
 
-	// Perform send
-	return k.Send(ctx, fromDid, toDidOrAddr, amount)
+	// Consume the oracle's allowance, checking that it is within its limits
+	if err := k.oraclesKeeper.ConsumeOracleAllowance(
+		ctx, oracleDid, oracles.TransferCap, amount); err != nil {
+		return err
+	}
+
+	// Verify the proof using the oracle's proof scheme, preventing its replay
+	statement := oracles.NewProofStatement(
+		oracleDid, oracles.TransferCap, fromDid, toDidOrAddr, amount)
+	verified, err := k.oraclesKeeper.VerifyOracleProof(ctx, statement, proof)
+	if err != nil {
+		return err
+	}
+
+	// Perform send, and only then record the proof as used
+	if err := k.Send(ctx, fromDid, toDidOrAddr, amount); err != nil {
+		return err
+	}
+	k.oraclesKeeper.UseOracleProof(ctx, oracleDid, verified)
+
+	return nil
 }
 
-func (k Keeper) OracleMint(ctx sdk.Context, oracleDid did.Did, toDidOrAddr string, amount sdk.Coins) sdk.Error {
+func (k Keeper) OracleMint(ctx sdk.Context, oracleDid did.Did, toDidOrAddr string,
+	amount sdk.Coins, proof string) sdk.Error {
 	// Get to address
 	var toAddress sdk.AccAddress
 	if did.IsValidDid(toDidOrAddr) {
@@ -116,8 +141,13 @@ func (k Keeper) OracleMint(ctx sdk.Context, oracleDid did.Did, toDidOrAddr strin
 		return sdk.ErrInternal("oracle specified is not a registered oracle")
 	}
 
-	// Confirm that oracle has the required capabilities
+	// Confirm that oracle is not suspended
 	oracle := k.oraclesKeeper.MustGetOracle(ctx, oracleDid)
+	if !oracle.IsActive() {
+		return oracles.ErrOracleSuspended(oracles.DefaultCodespace, oracleDid)
+	}
+
+	// Confirm that oracle has the required capabilities
 	for _, c := range amount {
 		if !oracle.Capabilities.Includes(c.Denom) {
 			return sdk.ErrInternal(fmt.Sprintf(
@@ -132,8 +162,22 @@ func (k Keeper) OracleMint(ctx sdk.Context, oracleDid did.Did, toDidOrAddr strin
 		}
 	}
 
+	// Consume the oracle's allowance, checking that it is within its limits
+	if err := k.oraclesKeeper.ConsumeOracleAllowance(
+		ctx, oracleDid, oracles.MintCap, amount); err != nil {
+		return err
+	}
+
+	// Verify the proof using the oracle's proof scheme, preventing its replay
+	statement := oracles.NewProofStatement(
+		oracleDid, oracles.MintCap, "", toDidOrAddr, amount)
+	verified, err := k.oraclesKeeper.VerifyOracleProof(ctx, statement, proof)
+	if err != nil {
+		return err
+	}
+
 	// Mint coins to module account
-	err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, amount)
+	err = k.supplyKeeper.MintCoins(ctx, types.ModuleName, amount)
 	if err != nil {
 		return err
 	}
@@ -145,10 +189,14 @@ func (k Keeper) OracleMint(ctx sdk.Context, oracleDid did.Did, toDidOrAddr strin
 		return err
 	}
 
+	// Record the proof as used now that the mint has been performed
+	k.oraclesKeeper.UseOracleProof(ctx, oracleDid, verified)
+
 	return nil
 }
 
-func (k Keeper) OracleBurn(ctx sdk.Context, oracleDid, fromDid did.Did, amount sdk.Coins) sdk.Error {
+func (k Keeper) OracleBurn(ctx sdk.Context, oracleDid, fromDid did.Did,
+	amount sdk.Coins, proof string) sdk.Error {
 	// Get from address
 	fromDidDoc, err := k.didKeeper.GetDidDoc(ctx, fromDid)
 	if err != nil {
@@ -161,8 +209,13 @@ func (k Keeper) OracleBurn(ctx sdk.Context, oracleDid, fromDid did.Did, amount s
 		return sdk.ErrInternal("oracle specified is not a registered oracle")
 	}
 
-	// Confirm that oracle has the required capabilities
+	// Confirm that oracle is not suspended
 	oracle := k.oraclesKeeper.MustGetOracle(ctx, oracleDid)
+	if !oracle.IsActive() {
+		return oracles.ErrOracleSuspended(oracles.DefaultCodespace, oracleDid)
+	}
+
+	// Confirm that oracle has the required capabilities
 	for _, c := range amount {
 		if !oracle.Capabilities.Includes(c.Denom) {
 			return sdk.ErrInternal(fmt.Sprintf(
@@ -177,6 +230,20 @@ func (k Keeper) OracleBurn(ctx sdk.Context, oracleDid, fromDid did.Did, amount s
 		}
 	}
 
+	// Consume the oracle's allowance, checking that it is within its limits
+	if err := k.oraclesKeeper.ConsumeOracleAllowance(
+		ctx, oracleDid, oracles.BurnCap, amount); err != nil {
+		return err
+	}
+
+	// Verify the proof using the oracle's proof scheme, preventing its replay
+	statement := oracles.NewProofStatement(
+		oracleDid, oracles.BurnCap, fromDid, "", amount)
+	verified, err := k.oraclesKeeper.VerifyOracleProof(ctx, statement, proof)
+	if err != nil {
+		return err
+	}
+
 	// Take tokens to burn from account
 	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx,
 		fromAddress, types.ModuleName, amount)
@@ -190,5 +257,8 @@ func (k Keeper) OracleBurn(ctx sdk.Context, oracleDid, fromDid did.Did, amount s
 		return err
 	}
 
+	// Record the proof as used now that the burn has been performed
+	k.oraclesKeeper.UseOracleProof(ctx, oracleDid, verified)
+
 	return nil
 }
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/ixofoundation/ixo-blockchain/x/did"
+	"github.com/ixofoundation/ixo-blockchain/x/oracles"
+)
+
+func newTestPrivKey() ed25519.PrivKeyEd25519 {
+	// Some keys encode to fewer than 44 base58 characters and are not valid
+	privKey := ed25519.GenPrivKey()
+	for !did.IsValidPubKey(base58.Encode(privKey[32:])) {
+		privKey = ed25519.GenPrivKey()
+	}
+	return privKey
+}
+
+func TestKeeperOracleBurnRecordsProofOnlyOnSuccess(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+
+	oracleDid := "did:ixo:U7GK8p8rVhJMKhBVRCJJ8c"
+	fromDid := "did:ixo:4XJLBfGtWSGKSz4BeRxdun"
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uixo", 10))
+
+	// Oracle with the capability to burn uixo, proving operations by ed25519
+	oracleKey := newTestPrivKey()
+	caps, err := oracles.ParseOracleTokenCaps("uixo:burn")
+	require.Nil(t, err)
+	oracle := oracles.NewOracle(oracleDid, caps)
+	oracle.ProofScheme = oracles.ProofSchemeEd25519
+	oracle.ProofKey = base58.Encode(oracleKey[32:])
+	k.oraclesKeeper.SetOracle(ctx, oracle)
+
+	fromKey := newTestPrivKey()
+	fromDidDoc := did.NewBaseDidDoc(fromDid, base58.Encode(fromKey[32:]))
+	k.didKeeper.AddDidDoc(ctx, fromDidDoc)
+
+	statement := oracles.NewProofStatement(oracleDid, oracles.BurnCap, fromDid, "", amount)
+	statement.Nonce = "1"
+	statement.ChainId = testChainId
+	sig, err := oracleKey.Sign(statement.GetSignBytes())
+	require.Nil(t, err)
+	proof := "1:" + base64.StdEncoding.EncodeToString(sig)
+
+	// The burn fails due to insufficient funds, so the proof is not used up
+	require.NotNil(t, k.OracleBurn(ctx, oracleDid, fromDid, amount, proof))
+	require.Len(t, k.oraclesKeeper.GetAllUsedProofs(ctx), 0)
+
+	// Once funded, the same proof backs the burn
+	fund := func() {
+		_, err := k.bankKeeper.AddCoins(ctx, fromDidDoc.Address(), amount)
+		require.Nil(t, err)
+		supply := k.supplyKeeper.GetSupply(ctx)
+		k.supplyKeeper.SetSupply(ctx, supply.Inflate(amount))
+	}
+	fund()
+	require.Nil(t, k.OracleBurn(ctx, oracleDid, fromDid, amount, proof))
+	require.True(t, k.bankKeeper.GetCoins(ctx, fromDidDoc.Address()).IsZero())
+	require.Len(t, k.oraclesKeeper.GetAllUsedProofs(ctx), 1)
+
+	// The proof cannot be replayed
+	fund()
+	require.NotNil(t, k.OracleBurn(ctx, oracleDid, fromDid, amount, proof))
+	require.Equal(t, amount, k.bankKeeper.GetCoins(ctx, fromDidDoc.Address()))
+}